@@ -13,6 +13,7 @@ import (
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zio/anyio"
 	"github.com/brimdata/zed/zio/zngio"
+	"github.com/brimdata/zed/zson"
 )
 
 type Flags struct {
@@ -27,7 +28,7 @@ func (f *Flags) Options() anyio.ReaderOpts {
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet, validate bool) {
-	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,arrows,csv,json,line,parquet,vng,zeek,zjson,zng,zson]")
+	fs.StringVar(&f.Format, "i", "auto", "format of input data [auto,arrows,avro,cef,csv,json,leef,line,parquet,syslog,vng,zeek,zjson,zng,zson]")
 	f.CSV.Delim = ','
 	fs.Func("csv.delim", `CSV field delimiter (default ",")`, func(s string) error {
 		if len(s) != 1 {
@@ -37,6 +38,11 @@ func (f *Flags) SetFlags(fs *flag.FlagSet, validate bool) {
 		return nil
 
 	})
+	fs.StringVar(&f.CSV.Comment, "csv.comment", "", "ignore CSV lines beginning with this prefix")
+	fs.StringVar(&f.CSV.Header, "csv.header", "", "CSV field names separated by the delimiter (input has no header line)")
+	fs.IntVar(&f.CSV.Skip, "csv.skip", 0, "number of lines to skip at start of CSV input")
+	fs.BoolVar(&f.CSV.StringsOnly, "csv.strings", false, "read all CSV fields as strings")
+	fs.StringVar(&f.CSV.Type, "csv.type", "", "ZSON record type of CSV fields (input has no header line)")
 	fs.BoolVar(&f.ZNG.Validate, "zng.validate", validate, "validate format when reading ZNG")
 	fs.IntVar(&f.ZNG.Threads, "zng.threads", 0, "number of ZNG read threads (0=GOMAXPROCS)")
 	f.ReadMax = auto.NewBytes(zngio.MaxSize)
@@ -55,6 +61,14 @@ func (f *Flags) Init() error {
 	if f.ZNG.Size < 0 {
 		return errors.New("target read buffer size must be greater than zero")
 	}
+	if f.CSV.Skip < 0 {
+		return errors.New("CSV skip count must not be negative")
+	}
+	if f.CSV.Type != "" {
+		if _, err := zson.ParseType(zed.NewContext(), f.CSV.Type); err != nil {
+			return fmt.Errorf("invalid CSV type: %w", err)
+		}
+	}
 	return nil
 }
 
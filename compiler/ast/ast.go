@@ -175,6 +175,14 @@ type OverExpr struct {
 	Scope  *Sequential `json:"scope"`
 }
 
+// A Lambda is an anonymous function that may appear only as an argument
+// to a higher-order function such as map().
+type Lambda struct {
+	Kind   string   `json:"kind" unpack:""`
+	Params []string `json:"params"`
+	Expr   Expr     `json:"expr"`
+}
+
 func (*UnaryExpr) ExprAST()   {}
 func (*BinaryExpr) ExprAST()  {}
 func (*Conditional) ExprAST() {}
@@ -195,6 +203,7 @@ func (*SetExpr) ExprAST()    {}
 func (*MapExpr) ExprAST()    {}
 
 func (*OverExpr) ExprAST() {}
+func (*Lambda) ExprAST()   {}
 
 func (*SQLExpr) ExprAST() {}
 
@@ -211,8 +220,26 @@ type FuncDecl struct {
 	Expr   Expr     `json:"expr"`
 }
 
-func (*ConstDecl) DeclAST() {}
-func (*FuncDecl) DeclAST()  {}
+// OpDecl declares a user-defined operator whose body is expanded in place
+// of each invocation with its parameters bound to the invocation's arguments.
+type OpDecl struct {
+	Kind   string      `json:"kind" unpack:""`
+	Name   string      `json:"name"`
+	Params []string    `json:"params"`
+	Body   *Sequential `json:"body"`
+}
+
+// ImportDecl brings the declarations in the source file at Path into scope.
+// The compiler replaces it with those declarations when the query is parsed.
+type ImportDecl struct {
+	Kind string `json:"kind" unpack:""`
+	Path string `json:"path"`
+}
+
+func (*ConstDecl) DeclAST()  {}
+func (*FuncDecl) DeclAST()   {}
+func (*OpDecl) DeclAST()     {}
+func (*ImportDecl) DeclAST() {}
 
 // ----------------------------------------------------------------------------
 // Operators
@@ -376,6 +403,18 @@ type (
 		Kind   string  `json:"kind" unpack:""`
 		Trunks []Trunk `json:"trunks"`
 	}
+	// A Window operator computes each aggregation in Aggs over a frame of
+	// values surrounding each input value and adds the results to that
+	// value.  The frame is taken from the partition of values with the same
+	// Keys after ordering the input by Sort (if not nil).  A nil Frame
+	// means all preceding values through the current one.
+	Window struct {
+		Kind  string       `json:"kind" unpack:""`
+		Aggs  []Assignment `json:"aggs"`
+		Keys  []Expr       `json:"keys"`
+		Sort  *Sort        `json:"sort"`
+		Frame *WindowFrame `json:"frame"`
+	}
 )
 
 // Source structure
@@ -453,6 +492,21 @@ type SQLJoin struct {
 	Alias    Expr   `json:"alias"`
 }
 
+type WindowFrame struct {
+	Lower WindowBound `json:"lower"`
+	Upper WindowBound `json:"upper"`
+}
+
+// A WindowBound is one end of a window frame and is Count values before
+// ("preceding") or after ("following") the current value or the current
+// value itself ("current").  When Unbounded is true, the bound extends to
+// the start or end of the partition.
+type WindowBound struct {
+	Type      string `json:"type"`
+	Count     int    `json:"count"`
+	Unbounded bool   `json:"unbounded"`
+}
+
 type Assignment struct {
 	Kind string `json:"kind" unpack:""`
 	LHS  Expr   `json:"lhs"`
@@ -494,6 +548,7 @@ func (*Let) OpAST()          {}
 func (*Search) OpAST()       {}
 func (*Where) OpAST()        {}
 func (*Yield) OpAST()        {}
+func (*Window) OpAST()       {}
 
 func (*SQLExpr) OpAST() {}
 
@@ -508,8 +563,9 @@ func (seq *Sequential) Prepend(front Op) {
 // upon a function of the record, e.g., count() counts up records without
 // looking into them.
 type Agg struct {
-	Kind  string `json:"kind" unpack:""`
-	Name  string `json:"name"`
-	Expr  Expr   `json:"expr"`
-	Where Expr   `json:"where"`
+	Kind   string `json:"kind" unpack:""`
+	Name   string `json:"name"`
+	Expr   Expr   `json:"expr"`
+	Where  Expr   `json:"where"`
+	Params []Expr `json:"params,omitempty"`
 }
@@ -20,6 +20,9 @@ type (
 		Name  string `json:"name"`
 		Expr  Expr   `json:"expr"`
 		Where Expr   `json:"where"`
+		// Params are constant parameters following Expr, e.g.,
+		// the quantile of quantile(x, 0.9).
+		Params []Expr `json:"params,omitempty"`
 	}
 	ArrayExpr struct {
 		Kind  string       `json:"kind" unpack:""`
@@ -58,6 +61,14 @@ type (
 		Params []string `json:"params"`
 		Expr   Expr     `json:"expr"`
 	}
+	// A Lambda binds its parameters to the variables in consecutive
+	// slots beginning at Slot.
+	Lambda struct {
+		Kind   string   `json:"kind" unpack:""`
+		Params []string `json:"params"`
+		Slot   int      `json:"slot"`
+		Expr   Expr     `json:"expr"`
+	}
 	Literal struct {
 		Kind  string `json:"kind" unpack:""`
 		Value string `json:"value"`
@@ -120,6 +131,7 @@ func (*Call) ExprDAG()         {}
 func (*Conditional) ExprDAG()  {}
 func (*Dot) ExprDAG()          {}
 func (*Func) ExprDAG()         {}
+func (*Lambda) ExprDAG()       {}
 func (*Literal) ExprDAG()      {}
 func (*MapExpr) ExprDAG()      {}
 func (*OverExpr) ExprDAG()     {}
@@ -49,11 +49,12 @@ type (
 		Count int    `json:"count"`
 	}
 	Join struct {
-		Kind     string       `json:"kind" unpack:""`
-		Style    string       `json:"style"`
-		LeftKey  Expr         `json:"left_key"`
-		RightKey Expr         `json:"right_key"`
-		Args     []Assignment `json:"args"`
+		Kind         string       `json:"kind" unpack:""`
+		Style        string       `json:"style"`
+		LeftKey      Expr         `json:"left_key"`
+		RightKey     Expr         `json:"right_key"`
+		Args         []Assignment `json:"args"`
+		InputSortDir int          `json:"input_sort_dir,omitempty"`
 	}
 	Merge struct {
 		Kind  string      `json:"kind" unpack:""`
@@ -104,6 +105,16 @@ type (
 		Expr  Expr   `json:"expr"`
 		Cases []Case `json:"cases"`
 	}
+	// A Window computes each aggregation in Aggs over the values in the
+	// partition for Keys whose offset from the current value is between
+	// Lower and Upper inclusive.  A nil Lower or Upper is unbounded.
+	Window struct {
+		Kind  string       `json:"kind" unpack:""`
+		Aggs  []Assignment `json:"aggs"`
+		Keys  []Expr       `json:"keys"`
+		Lower *int         `json:"lower"`
+		Upper *int         `json:"upper"`
+	}
 	Tail struct {
 		Kind  string `json:"kind" unpack:""`
 		Count int    `json:"count"`
@@ -151,13 +162,19 @@ type (
 	// operators into each scan scheduler for each source when the runtime
 	// is built.  When computation is distribtued over the network, the
 	// optimized pushdown is naturally carried in the serialized DAG via
-	// each Trunk.
+	// each Trunk.  For sources other than pools, Projection lists the
+	// fields needed downstream of the scan and StatsPruner is a predicate
+	// over the minimum and maximum values of the fields in a block of
+	// data (e.g., a Parquet row group) that is true when the block cannot
+	// match the pushdown predicate.
 	Trunk struct {
-		Kind      string      `json:"kind" unpack:""`
-		Source    Source      `json:"source"`
-		Seq       *Sequential `json:"seq"`
-		Pushdown  Op          `json:"pushdown"`
-		KeyPruner Expr        `json:"key_pruner"`
+		Kind        string      `json:"kind" unpack:""`
+		Source      Source      `json:"source"`
+		Seq         *Sequential `json:"seq"`
+		Pushdown    Op          `json:"pushdown"`
+		KeyPruner   Expr        `json:"key_pruner"`
+		Projection  field.List  `json:"projection"`
+		StatsPruner Expr        `json:"stats_pruner"`
 	}
 
 	// Leaf sources
@@ -271,6 +288,7 @@ func (*Over) OpNode()       {}
 func (*Let) OpNode()        {}
 func (*Yield) OpNode()      {}
 func (*Merge) OpNode()      {}
+func (*Window) OpNode()     {}
 
 // NewFilter returns a filter node for e.
 func NewFilter(e Expr) *Filter {
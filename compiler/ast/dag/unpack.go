@@ -26,6 +26,7 @@ var unpacker = unpack.New(
 	Head{},
 	HTTP{},
 	Join{},
+	Lambda{},
 	Literal{},
 	MapExpr{},
 	Merge{},
@@ -56,6 +57,7 @@ var unpacker = unpack.New(
 	Uniq{},
 	Var{},
 	VectorValue{},
+	Window{},
 	Yield{},
 )
 
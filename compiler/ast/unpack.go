@@ -30,6 +30,7 @@ var unpacker = unpack.New(
 	File{},
 	From{},
 	FuncDecl{},
+	OpDecl{},
 	Fuse{},
 	Summarize{},
 	Grep{},
@@ -37,6 +38,7 @@ var unpacker = unpack.New(
 	HTTP{},
 	ID{},
 	astzed.ImpliedValue{},
+	ImportDecl{},
 	Join{},
 	Layout{},
 	Let{},
@@ -47,6 +49,7 @@ var unpacker = unpack.New(
 	MapExpr{},
 	Shape{},
 	OverExpr{},
+	Lambda{},
 	Parallel{},
 	Pass{},
 	Pool{},
@@ -87,6 +90,7 @@ var unpacker = unpack.New(
 	Uniq{},
 	VectorValue{},
 	Where{},
+	Window{},
 	Yield{},
 )
 
@@ -0,0 +1,197 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/brimdata/zed/compiler/ast"
+	"github.com/brimdata/zed/compiler/parser"
+	"golang.org/x/exp/slices"
+)
+
+// SearchPath returns the directories searched for the files named in
+// import statements.  It is taken from the ZED_PATH environment variable,
+// which is a list of directories separated by the OS-specific path list
+// separator.
+func SearchPath() []string {
+	return filepath.SplitList(os.Getenv("ZED_PATH"))
+}
+
+// importer replaces the import statements at the top level of a query and
+// of the libraries it imports with the declarations in the imported
+// libraries.  A library imported more than once is included only once.
+type importer struct {
+	path []string
+	// stack holds the absolute paths of the libraries being imported so
+	// import cycles can be detected.  chain holds the paths as they appear
+	// in the import statements for error messages.
+	stack []string
+	chain []string
+	done  map[string]bool
+	// defs maps each symbol declared at the top level to the library that
+	// declared it.
+	defs map[string]library
+}
+
+// library identifies an imported library by its absolute path and by its
+// path as it was imported.  The zero value identifies the query.
+type library struct {
+	path string
+	name string
+}
+
+func (l library) String() string {
+	if l.path == "" {
+		return "the query"
+	}
+	return fmt.Sprintf("%q", l.name)
+}
+
+func resolveImports(seq *ast.Sequential, dir string, path []string) error {
+	i := &importer{
+		path: path,
+		done: make(map[string]bool),
+		defs: make(map[string]library),
+	}
+	decls, err := i.decls(seq.Decls, dir, library{})
+	if err != nil {
+		return err
+	}
+	seq.Decls = decls
+	return nil
+}
+
+// decls returns decls with each import statement replaced by the
+// declarations of the library it imports.  dir is the directory of lib,
+// which contains decls.
+func (i *importer) decls(decls []ast.Decl, dir string, lib library) ([]ast.Decl, error) {
+	out := make([]ast.Decl, 0, len(decls))
+	for _, d := range decls {
+		imp, ok := d.(*ast.ImportDecl)
+		if !ok {
+			if err := i.define(d, lib); err != nil {
+				return nil, err
+			}
+			out = append(out, d)
+			continue
+		}
+		decls, err := i.load(imp.Path, dir)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, decls...)
+	}
+	return out, nil
+}
+
+func (i *importer) load(name, dir string) ([]ast.Decl, error) {
+	filename, path, err := i.lookup(name, dir)
+	if err != nil {
+		return nil, err
+	}
+	for k, p := range i.stack {
+		if p == path {
+			chain := append(slices.Clone(i.chain[k:]), name)
+			return nil, fmt.Errorf("import cycle: %s", quoteAll(chain, " -> "))
+		}
+	}
+	if i.done[path] {
+		return nil, nil
+	}
+	parsed, err := parser.ParseZedLibrary(filename)
+	if err != nil {
+		return nil, err
+	}
+	o, err := ast.UnpackMapAsOp(parsed)
+	if err != nil {
+		return nil, err
+	}
+	i.stack = append(i.stack, path)
+	i.chain = append(i.chain, name)
+	decls, err := i.decls(o.(*ast.Sequential).Decls, filepath.Dir(path), library{path, name})
+	i.stack = i.stack[:len(i.stack)-1]
+	i.chain = i.chain[:len(i.chain)-1]
+	if err != nil {
+		return nil, err
+	}
+	i.done[path] = true
+	return decls, nil
+}
+
+// lookup returns the file name and absolute path of the library name
+// imported by a library in dir.  name is looked up in dir and then in each
+// directory of the search path.  To keep imports within those directories,
+// name must be relative and may not contain "..".
+func (i *importer) lookup(name, dir string) (string, string, error) {
+	if !isLocal(name) {
+		return "", "", fmt.Errorf("import %q: path must be relative and may not contain \"..\"", name)
+	}
+	dirs := append([]string{dir}, i.path...)
+	for _, dir := range dirs {
+		path := filepath.Join(dir, name)
+		info, err := os.Stat(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return "", "", fmt.Errorf("import %q: %w", name, err)
+		}
+		if info.IsDir() {
+			return "", "", fmt.Errorf("import %q: %s is a directory", name, path)
+		}
+		abs, err := filepath.Abs(path)
+		return path, abs, err
+	}
+	return "", "", fmt.Errorf("import %q: file not found in %s", name, strings.Join(dirs, string(filepath.ListSeparator)))
+}
+
+// isLocal returns true if name is a relative path with no ".." elements.
+func isLocal(name string) bool {
+	if name == "" || filepath.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return false
+	}
+	for _, elem := range strings.Split(filepath.ToSlash(name), "/") {
+		if elem == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// define records the symbol declared by d in lib and returns an error if a
+// different library or the query has declared it.  Redefinitions within a
+// library or the query are left to semantic analysis.
+func (i *importer) define(d ast.Decl, lib library) error {
+	var name string
+	switch d := d.(type) {
+	case *ast.ConstDecl:
+		name = d.Name
+	case *ast.FuncDecl:
+		name = d.Name
+	case *ast.OpDecl:
+		name = d.Name
+	default:
+		return nil
+	}
+	prev, ok := i.defs[name]
+	if !ok {
+		i.defs[name] = lib
+		return nil
+	}
+	if prev.path == lib.path {
+		return nil
+	}
+	return fmt.Errorf("symbol %q declared in both %s and %s", name, prev, lib)
+}
+
+func quoteAll(names []string, sep string) string {
+	var quoted []string
+	for _, name := range names {
+		quoted = append(quoted, fmt.Sprintf("%q", name))
+	}
+	return strings.Join(quoted, sep)
+}
@@ -3,6 +3,7 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 
 	"github.com/brimdata/zed/compiler/ast"
 	"github.com/brimdata/zed/compiler/ast/dag"
@@ -143,6 +144,10 @@ func (j *Job) Parallelize(n int) error {
 	return j.optimizer.Parallelize(n)
 }
 
+// Parse concatenates the source files in filenames followed by src and parses
+// the resulting program.  Import statements are left unresolved, so a query
+// containing them fails semantic analysis.  Parse is safe to use on queries
+// from remote clients.
 func Parse(src string, filenames ...string) (ast.Op, error) {
 	parsed, err := parser.ParseZed(filenames, src)
 	if err != nil {
@@ -151,6 +156,28 @@ func Parse(src string, filenames ...string) (ast.Op, error) {
 	return ast.UnpackMapAsOp(parsed)
 }
 
+// ParseWithImports is like Parse but also resolves the program's imports
+// from the local file system.  Relative imports in the program are looked up
+// in the directory of the first file in filenames (or the current directory
+// if filenames is empty) and then in SearchPath.  Since it reads local
+// files, ParseWithImports must not be used on queries from remote clients.
+func ParseWithImports(src string, filenames ...string) (ast.Op, error) {
+	o, err := Parse(src, filenames...)
+	if err != nil {
+		return nil, err
+	}
+	if seq, ok := o.(*ast.Sequential); ok {
+		dir := "."
+		if len(filenames) > 0 {
+			dir = filepath.Dir(filenames[0])
+		}
+		if err := resolveImports(seq, dir, SearchPath()); err != nil {
+			return nil, err
+		}
+	}
+	return o, nil
+}
+
 // MustParse is like Parse but panics if an error is encountered.
 func MustParse(query string) ast.Op {
 	o, err := (*anyCompiler)(nil).Parse(query)
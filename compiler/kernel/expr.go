@@ -335,6 +335,11 @@ func (b *Builder) compileCall(call dag.Call) (expr.Evaluator, error) {
 	if isShaperFunc(call.Name) {
 		return b.compileShaper(call)
 	}
+	if n := len(call.Args); n > 0 {
+		if lambda, ok := call.Args[n-1].(*dag.Lambda); ok {
+			return b.compileLambdaCall(call, lambda)
+		}
+	}
 	var path field.Path
 	// First check if call is to a user defined function, otherwise check for
 	// builtin function.
@@ -358,6 +363,29 @@ func (b *Builder) compileCall(call dag.Call) (expr.Evaluator, error) {
 	return expr.NewCall(b.zctx(), fn, exprs), nil
 }
 
+// compileLambdaCall compiles a call to a higher-order function whose last
+// argument is lambda.
+func (b *Builder) compileLambdaCall(call dag.Call, lambda *dag.Lambda) (expr.Evaluator, error) {
+	exprs, err := b.compileExprs(call.Args[:len(call.Args)-1])
+	if err != nil {
+		return nil, fmt.Errorf("%s(): bad argument: %w", call.Name, err)
+	}
+	body, err := b.compileExpr(lambda.Expr)
+	if err != nil {
+		return nil, err
+	}
+	fn := expr.NewLambda(lambda.Slot, len(lambda.Params), body)
+	switch {
+	case call.Name == "map" && len(exprs) == 1:
+		return expr.NewMapArray(b.zctx(), exprs[0], fn), nil
+	case call.Name == "filter" && len(exprs) == 1:
+		return expr.NewFilterArray(b.zctx(), exprs[0], fn), nil
+	case call.Name == "reduce" && len(exprs) == 2:
+		return expr.NewReduceArray(b.zctx(), exprs[0], exprs[1], fn), nil
+	}
+	return nil, fmt.Errorf("%s(): lambda expression not allowed", call.Name)
+}
+
 func (b *Builder) compileExprs(in []dag.Expr) ([]expr.Evaluator, error) {
 	var exprs []expr.Evaluator
 	for _, e := range in {
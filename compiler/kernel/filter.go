@@ -2,31 +2,48 @@ package kernel
 
 import (
 	"github.com/brimdata/zed/compiler/ast/dag"
+	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/runtime/expr"
 	"github.com/brimdata/zed/zbuf"
 )
 
 type Filter struct {
-	pushdown dag.Expr
-	builder  *Builder
+	pushdown    dag.Expr
+	projection  field.List
+	statsPruner dag.Expr
+	builder     *Builder
 }
 
-var _ zbuf.Filter = (*Filter)(nil)
+var _ zbuf.Pushdown = (*Filter)(nil)
 
 func (f *Filter) AsEvaluator() (expr.Evaluator, error) {
-	if f == nil {
+	if f == nil || f.pushdown == nil {
 		return nil, nil
 	}
 	return f.builder.compileExpr(f.pushdown)
 }
 
 func (f *Filter) AsBufferFilter() (*expr.BufferFilter, error) {
-	if f == nil {
+	if f == nil || f.pushdown == nil {
 		return nil, nil
 	}
 	return CompileBufferFilter(f.builder.pctx.Zctx, f.pushdown)
 }
 
+func (f *Filter) Projection() field.List {
+	if f == nil {
+		return nil
+	}
+	return f.projection
+}
+
+func (f *Filter) AsStatsPruner() (expr.Evaluator, error) {
+	if f == nil || f.statsPruner == nil {
+		return nil, nil
+	}
+	return f.builder.compileExpr(f.statsPruner)
+}
+
 type DeleteFilter struct {
 	*Filter
 }
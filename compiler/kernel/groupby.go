@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/compiler/ast/dag"
 	"github.com/brimdata/zed/order"
 	"github.com/brimdata/zed/pkg/field"
@@ -69,5 +70,13 @@ func (b *Builder) compileAgg(agg *dag.Agg) (*expr.Aggregator, error) {
 			return nil, err
 		}
 	}
-	return expr.NewAggregator(name, arg, where)
+	var params []*zed.Value
+	for _, e := range agg.Params {
+		val, err := b.evalAtCompileTime(e)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, val)
+	}
+	return expr.NewAggregator(name, arg, where, params...)
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/brimdata/zed/runtime/op/top"
 	"github.com/brimdata/zed/runtime/op/traverse"
 	"github.com/brimdata/zed/runtime/op/uniq"
+	"github.com/brimdata/zed/runtime/op/window"
 	"github.com/brimdata/zed/runtime/op/yield"
 	"github.com/brimdata/zed/zbuf"
 	"github.com/brimdata/zed/zio"
@@ -98,6 +99,16 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 	switch v := o.(type) {
 	case *dag.Summarize:
 		return b.compileGroupBy(parent, v)
+	case *dag.Window:
+		keys, err := b.compileExprs(v.Keys)
+		if err != nil {
+			return nil, err
+		}
+		names, aggs, err := b.compileAggAssignments(v.Aggs)
+		if err != nil {
+			return nil, err
+		}
+		return window.New(b.pctx, parent, keys, names, aggs, v.Lower, v.Upper)
 	case *dag.Cut:
 		assignments, err := b.compileAssignments(v.Args)
 		if err != nil {
@@ -472,12 +483,18 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 			return nil, err
 		}
 		leftParent, rightParent := parents[0], parents[1]
-		var anti, inner bool
+		var leftKeyPath field.Path
+		if this, ok := o.LeftKey.(*dag.This); ok {
+			leftKeyPath = this.Path
+		}
+		var anti, inner, full bool
 		switch o.Style {
 		case "anti":
 			anti = true
 		case "inner":
 			inner = true
+		case "full":
+			full = true
 		case "left":
 		case "right":
 			leftKey, rightKey = rightKey, leftKey
@@ -485,7 +502,16 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 		default:
 			return nil, fmt.Errorf("unknown kind of join: '%s'", o.Style)
 		}
-		join, err := join.New(b.pctx, anti, inner, leftParent, rightParent, leftKey, rightKey, lhs, rhs)
+		if o.InputSortDir == 0 {
+			// The optimizer couldn't prove that the inputs are
+			// sorted by their join keys so use a hash join.
+			join, err := join.NewHash(b.pctx, anti, inner, full, leftParent, rightParent, leftKey, rightKey, leftKeyPath, lhs, rhs)
+			if err != nil {
+				return nil, err
+			}
+			return []zbuf.Puller{join}, nil
+		}
+		join, err := join.New(b.pctx, anti, inner, full, leftParent, rightParent, leftKey, rightKey, leftKeyPath, lhs, rhs)
 		if err != nil {
 			return nil, err
 		}
@@ -698,13 +724,16 @@ func (b *Builder) compileRange(src dag.Source, exprLower, exprUpper dag.Expr) (e
 
 func (b *Builder) PushdownOf(trunk *dag.Trunk) (*Filter, error) {
 	if trunk.Pushdown == nil {
-		return nil, nil
+		if trunk.Projection == nil {
+			return nil, nil
+		}
+		return &Filter{projection: trunk.Projection, builder: b}, nil
 	}
 	f, ok := trunk.Pushdown.(*dag.Filter)
 	if !ok {
 		return nil, errors.New("non-filter pushdown operator not yet supported")
 	}
-	return &Filter{f.Expr, b}, nil
+	return &Filter{f.Expr, trunk.Projection, trunk.StatsPruner, b}, nil
 }
 
 func (b *Builder) evalAtCompileTime(in dag.Expr) (val *zed.Value, err error) {
@@ -17,6 +17,32 @@ import (
 // on the layout argument.  This is clumsy and needs to change.
 // See issue #2658.
 func (o *Optimizer) analyzeOp(op dag.Op, layout order.Layout) (order.Layout, error) {
+	switch op := op.(type) {
+	case *dag.Sequential:
+		if op == nil {
+			return layout, nil
+		}
+		for _, op := range op.Ops {
+			var err error
+			layout, err = o.analyzeOp(op, layout)
+			if err != nil {
+				return order.Nil, err
+			}
+		}
+		return layout, nil
+	case *dag.Sort:
+		// A sort establishes an order regardless of its input order.
+		// XXX Only single sort keys.  See issue #2657.
+		if len(op.Args) != 1 {
+			return order.Nil, nil
+		}
+		newKey := fieldOf(op.Args[0])
+		if newKey == nil {
+			// Not a field
+			return order.Nil, nil
+		}
+		return order.NewLayout(op.Order, field.List{newKey}), nil
+	}
 	// We should handle secondary keys at some point.
 	// See issue #2657.
 	key := layout.Primary()
@@ -52,26 +78,13 @@ func (o *Optimizer) analyzeOp(op dag.Op, layout order.Layout) (order.Layout, err
 			}
 		}
 		return layout, nil
-	case *dag.Sequential:
-		for _, op := range op.Ops {
-			var err error
-			layout, err = o.analyzeOp(op, layout)
-			if err != nil {
-				return order.Nil, err
+	case *dag.Window:
+		for _, assignment := range op.Aggs {
+			if fieldOf(assignment.LHS).Equal(key) {
+				return order.Nil, nil
 			}
 		}
 		return layout, nil
-	case *dag.Sort:
-		// XXX Only single sort keys.  See issue #2657.
-		if len(op.Args) != 1 {
-			return order.Nil, nil
-		}
-		newKey := fieldOf(op.Args[0])
-		if newKey == nil {
-			// Not a field
-			return order.Nil, nil
-		}
-		return order.NewLayout(op.Order, field.List{key}), nil
 	case *dag.From:
 		var egress order.Layout
 		for k := range op.Trunks {
@@ -96,6 +109,45 @@ func (o *Optimizer) analyzeOp(op dag.Op, layout order.Layout) (order.Layout, err
 	}
 }
 
+// analyzeJoin sets join.InputSortDir when the inputs produced by upstream
+// are known to be sorted in ascending order by their respective join keys so
+// that the join may be computed as a merge join instead of a hash join.
+func (o *Optimizer) analyzeJoin(join *dag.Join, upstream dag.Op, parent order.Layout) error {
+	var layouts []order.Layout
+	switch upstream := upstream.(type) {
+	case *dag.From:
+		for k := range upstream.Trunks {
+			trunk := &upstream.Trunks[k]
+			l, err := o.layoutOfSource(trunk.Source, parent)
+			if err != nil {
+				return err
+			}
+			l, err = o.analyzeOp(trunk.Seq, l)
+			if err != nil {
+				return err
+			}
+			layouts = append(layouts, l)
+		}
+	case *dag.Parallel:
+		for _, op := range upstream.Ops {
+			l, err := o.analyzeOp(op, parent)
+			if err != nil {
+				return err
+			}
+			layouts = append(layouts, l)
+		}
+	}
+	if len(layouts) == 2 && sortedBy(layouts[0], join.LeftKey) && sortedBy(layouts[1], join.RightKey) {
+		join.InputSortDir = orderAsDirection(order.Asc)
+	}
+	return nil
+}
+
+func sortedBy(layout order.Layout, e dag.Expr) bool {
+	key := fieldOf(e)
+	return key != nil && layout.Order == order.Asc && key.Equal(layout.Primary())
+}
+
 // summarizeOrderAndAssign determines whether its first groupby key is the
 // same as the scan order or an order-preserving function thereof, and if so,
 // sets ast.Summarize.InputSortDir to the propagated scan order.  It returns
@@ -304,3 +356,90 @@ func fieldsOf(e dag.Expr) (field.List, bool) {
 		return nil, false
 	}
 }
+
+// exprFields returns the fields of "this" referenced by e.  Unlike fieldsOf,
+// it fails for an expression that might depend on fields it does not name,
+// like a search or a reference to "this" itself, so the result may be used
+// to project away every other field.
+func exprFields(e dag.Expr) (field.List, bool) {
+	switch e := e.(type) {
+	case nil, *dag.Literal, *dag.Var:
+		return nil, true
+	case *dag.This:
+		if len(e.Path) == 0 {
+			return nil, false
+		}
+		return field.List{e.Path}, true
+	case *dag.Dot:
+		return exprFields(e.LHS)
+	case *dag.UnaryExpr:
+		return exprFields(e.Operand)
+	case *dag.BinaryExpr:
+		return exprsFields(e.LHS, e.RHS)
+	case *dag.Conditional:
+		return exprsFields(e.Cond, e.Then, e.Else)
+	case *dag.Call:
+		switch e.Name {
+		case "every", "is", "nest_dotted":
+			// These functions may refer to a field implicitly.
+			return nil, false
+		}
+		return exprsFields(e.Args...)
+	case *dag.RegexpMatch:
+		return exprFields(e.Expr)
+	case *dag.RegexpSearch:
+		return exprFields(e.Expr)
+	case *dag.RecordExpr:
+		var exprs []dag.Expr
+		for _, elem := range e.Elems {
+			switch elem := elem.(type) {
+			case *dag.Field:
+				exprs = append(exprs, elem.Value)
+			case *dag.Spread:
+				exprs = append(exprs, elem.Expr)
+			default:
+				return nil, false
+			}
+		}
+		return exprsFields(exprs...)
+	case *dag.ArrayExpr:
+		return vectorFields(e.Elems)
+	case *dag.SetExpr:
+		return vectorFields(e.Elems)
+	case *dag.MapExpr:
+		var exprs []dag.Expr
+		for _, entry := range e.Entries {
+			exprs = append(exprs, entry.Key, entry.Value)
+		}
+		return exprsFields(exprs...)
+	default:
+		return nil, false
+	}
+}
+
+func exprsFields(exprs ...dag.Expr) (field.List, bool) {
+	var fields field.List
+	for _, e := range exprs {
+		list, ok := exprFields(e)
+		if !ok {
+			return nil, false
+		}
+		fields = append(fields, list...)
+	}
+	return fields, true
+}
+
+func vectorFields(elems []dag.VectorElem) (field.List, bool) {
+	var exprs []dag.Expr
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case *dag.Spread:
+			exprs = append(exprs, elem.Expr)
+		case *dag.VectorValue:
+			exprs = append(exprs, elem.Expr)
+		default:
+			return nil, false
+		}
+	}
+	return exprsFields(exprs...)
+}
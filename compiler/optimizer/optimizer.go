@@ -3,6 +3,7 @@ package optimizer
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/brimdata/zed/compiler/ast/dag"
 	"github.com/brimdata/zed/compiler/data"
@@ -121,6 +122,18 @@ func (o *Optimizer) OptimizeScan() error {
 				}
 			}
 		}
+		// For sources other than pools, tell the scanner which fields
+		// are needed and how to skip blocks of data using statistics
+		// about their values so that columnar formats like Parquet
+		// can avoid decoding unneeded columns and row groups.
+		if _, ok := trunk.Source.(*dag.Pool); !ok {
+			trunk.Projection = projectionOf(trunk)
+			if pushdown, ok := trunk.Pushdown.(*dag.Filter); ok {
+				if p := newStatsPruner(pushdown.Expr); p != nil {
+					trunk.StatsPruner = p
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -178,7 +191,15 @@ func (o *Optimizer) propagateScanOrder(op dag.Op, parent order.Layout) (order.La
 		if op == nil {
 			return parent, nil
 		}
-		for _, op := range op.Ops {
+		seq := op
+		var upstream order.Layout
+		for k, op := range seq.Ops {
+			if join, ok := op.(*dag.Join); ok && k > 0 {
+				if err := o.analyzeJoin(join, seq.Ops[k-1], upstream); err != nil {
+					return order.Nil, err
+				}
+			}
+			upstream = parent
 			var err error
 			parent, err = o.propagateScanOrder(op, parent)
 			if err != nil {
@@ -342,6 +363,48 @@ func pushDown(trunk *dag.Trunk) {
 	trunk.Pushdown = filter
 }
 
+// projectionOf returns the fields of the input to the trunk that are needed
+// by its pushdown predicate and its sequence of operators, or nil if they
+// cannot be determined.  The fields can be determined only when the sequence
+// comprises zero or more filters followed by a cut or yield since those
+// operators discard every field they do not reference.
+func projectionOf(trunk *dag.Trunk) field.List {
+	if trunk.Seq == nil {
+		return nil
+	}
+	var exprs []dag.Expr
+	if filter, ok := trunk.Pushdown.(*dag.Filter); ok {
+		exprs = append(exprs, filter.Expr)
+	}
+	for _, op := range trunk.Seq.Ops {
+		switch op := op.(type) {
+		case *dag.Filter:
+			exprs = append(exprs, op.Expr)
+			continue
+		case *dag.Cut:
+			for _, a := range op.Args {
+				exprs = append(exprs, a.RHS)
+			}
+		case *dag.Yield:
+			exprs = append(exprs, op.Exprs...)
+		default:
+			return nil
+		}
+		list, ok := exprsFields(exprs...)
+		if !ok {
+			return nil
+		}
+		var fields field.List
+		for _, f := range list {
+			if !fields.Has(f) {
+				fields = append(fields, f)
+			}
+		}
+		return fields
+	}
+	return nil
+}
+
 // newRangePruner returns a new predicate based on the input predicate pred
 // that when applied to an input value (i.e., "this") with fields from/to, returns
 // true if comparisons in pred against literal values can for certain rule out
@@ -361,6 +424,36 @@ func newRangePruner(pred dag.Expr, fld field.Path, o order.Which) *dag.BinaryExp
 // from/to value range.  If a pruning decision cannot be reliably determined then
 // the return value is nil.
 func buildRangePruner(pred dag.Expr, fld field.Path, min, max *dag.This) *dag.BinaryExpr {
+	return buildPruner(pred, func(op string, this *dag.This, literal *dag.Literal) *dag.BinaryExpr {
+		if !fld.Equal(this.Path) {
+			return nil
+		}
+		return rangePrunerPred(op, literal, min, max)
+	})
+}
+
+// newStatsPruner returns a predicate that when applied to a value of the form
+// {min:{...},max:{...}}, holding the minimum and maximum values of the fields
+// in a block of values, returns true if comparisons in pred against literal
+// values can for certain rule out that pred would be true for any value in the
+// block.  A field missing from min or max never rules out a block, and neither
+// does a comparison with null since the minimum and maximum ignore nulls.
+func newStatsPruner(pred dag.Expr) *dag.BinaryExpr {
+	return buildPruner(pred, func(op string, this *dag.This, literal *dag.Literal) *dag.BinaryExpr {
+		if len(this.Path) == 0 || strings.HasPrefix(literal.Value, "null") {
+			return nil
+		}
+		min := &dag.This{Kind: "This", Path: append(field.New("min"), this.Path...)}
+		max := &dag.This{Kind: "This", Path: append(field.New("max"), this.Path...)}
+		has := &dag.Call{Kind: "Call", Name: "has", Args: []dag.Expr{min, max}}
+		return dag.NewBinaryExpr("and", has, rangePrunerPred(op, literal, min, max))
+	})
+}
+
+// buildPruner walks the "and" and "or" operators in pred and calls leaf for
+// each comparison of a field with a literal value to build a predicate that
+// rules out pred.  leaf returns nil if it cannot rule out the comparison.
+func buildPruner(pred dag.Expr, leaf func(op string, this *dag.This, literal *dag.Literal) *dag.BinaryExpr) *dag.BinaryExpr {
 	e, ok := pred.(*dag.BinaryExpr)
 	if !ok {
 		// If this isn't a binary predicate composed of comparison operators, we
@@ -375,8 +468,8 @@ func buildRangePruner(pred dag.Expr, fld field.Path, min, max *dag.This) *dag.Bi
 		// For an "and", if we know either side is prunable, then we can prune
 		// because both conditions are required.  So we "or" together the result
 		// when both sub-expressions are valid.
-		lhs := buildRangePruner(e.LHS, fld, min, max)
-		rhs := buildRangePruner(e.RHS, fld, min, max)
+		lhs := buildPruner(e.LHS, leaf)
+		rhs := buildPruner(e.RHS, leaf)
 		if lhs == nil {
 			return rhs
 		}
@@ -388,20 +481,20 @@ func buildRangePruner(pred dag.Expr, fld field.Path, min, max *dag.This) *dag.Bi
 		// For an "or", if we know both sides are prunable, then we can prune
 		// because either condition is required.  So we "and" together the result
 		// when both sub-expressions are valid.
-		lhs := buildRangePruner(e.LHS, fld, min, max)
-		rhs := buildRangePruner(e.RHS, fld, min, max)
+		lhs := buildPruner(e.LHS, leaf)
+		rhs := buildPruner(e.RHS, leaf)
 		if lhs == nil || rhs == nil {
 			return nil
 		}
 		return dag.NewBinaryExpr("and", lhs, rhs)
 	case "==", "<", "<=", ">", ">=":
 		this, literal, op := literalComparison(e)
-		if this == nil || !fld.Equal(this.Path) {
+		if this == nil {
 			return nil
 		}
 		// At this point, we know we can definitely run a pruning decision based
 		// on the literal value we found, the comparison op, and the lower/upper bounds.
-		return rangePrunerPred(op, literal, min, max)
+		return leaf(op, this, literal)
 	default:
 		return nil
 	}
@@ -228,11 +228,13 @@ func replicateTrunk(from *dag.From, trunk *dag.Trunk, replicas int) {
 			pd = copyOp(pd)
 		}
 		replica := dag.Trunk{
-			Kind:      "Trunk",
-			Source:    src,
-			Seq:       newSeq,
-			Pushdown:  pd,
-			KeyPruner: trunk.KeyPruner,
+			Kind:        "Trunk",
+			Source:      src,
+			Seq:         newSeq,
+			Pushdown:    pd,
+			KeyPruner:   trunk.KeyPruner,
+			Projection:  trunk.Projection,
+			StatsPruner: trunk.StatsPruner,
 		}
 		from.Trunks = append(from.Trunks, replica)
 	}
@@ -305,7 +307,7 @@ func (o *Optimizer) splittablePath(ops []dag.Op, layout order.Layout) (int, orde
 		// function can be parallelized... need to think through
 		// what the meaning is here exactly.  This is all still a bit
 		// of a heuristic.  See #2660 and #2661.
-		case *dag.Summarize, *dag.Sort, *dag.Parallel, *dag.Head, *dag.Tail, *dag.Uniq, *dag.Fuse, *dag.Sequential, *dag.Join:
+		case *dag.Summarize, *dag.Sort, *dag.Parallel, *dag.Head, *dag.Tail, *dag.Uniq, *dag.Fuse, *dag.Sequential, *dag.Join, *dag.Window:
 			return k, layout, nil
 		default:
 			next, err := o.analyzeOp(op, layout)
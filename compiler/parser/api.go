@@ -20,6 +20,26 @@ func ParseZed(filenames []string, src string) (interface{}, error) {
 	return p, nil
 }
 
+// ParseZedLibrary parses the source file filename, which may contain only
+// declarations, and returns a Sequential with no operators.  If Parse fails,
+// it returns an error giving the location of the failure.  Unlike the errors
+// from ParseZed, the error does not include any source text so that the
+// contents of a file that is not a Zed library are not revealed.
+func ParseZedLibrary(filename string) (interface{}, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	p, err := Parse(filename, b, Entrypoint("Library"))
+	if err != nil {
+		if e, ok := ImproveError(err, string(b), []SourceInfo{{filename, 0, len(b)}}).(*Error); ok {
+			return nil, fmt.Errorf("error parsing Zed in %s at line %d, column %d", filename, e.lineNum+1, e.column+1)
+		}
+		return nil, fmt.Errorf("error parsing Zed in %s", filename)
+	}
+	return p, nil
+}
+
 // SourceInfo holds source file offsets.
 type SourceInfo struct {
 	filename string
@@ -53,41 +53,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "Sequential",
+			name: "Library",
 			pos:  position{line: 9, col: 1, offset: 80},
 			expr: &actionExpr{
-				pos: position{line: 10, col: 5, offset: 95},
+				pos: position{line: 10, col: 5, offset: 92},
+				run: (*parser).callonLibrary1,
+				expr: &seqExpr{
+					pos: position{line: 10, col: 5, offset: 92},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 10, col: 5, offset: 92},
+							label: "decls",
+							expr: &ruleRefExpr{
+								pos:  position{line: 10, col: 11, offset: 98},
+								name: "Decls",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 10, col: 17, offset: 104},
+							name: "__",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 10, col: 20, offset: 107},
+							name: "EOF",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Sequential",
+			pos:  position{line: 14, col: 1, offset: 223},
+			expr: &actionExpr{
+				pos: position{line: 15, col: 5, offset: 238},
 				run: (*parser).callonSequential1,
 				expr: &seqExpr{
-					pos: position{line: 10, col: 5, offset: 95},
+					pos: position{line: 15, col: 5, offset: 238},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 10, col: 5, offset: 95},
+							pos:   position{line: 15, col: 5, offset: 238},
 							label: "decls",
 							expr: &ruleRefExpr{
-								pos:  position{line: 10, col: 11, offset: 101},
+								pos:  position{line: 15, col: 11, offset: 244},
 								name: "Decls",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 10, col: 17, offset: 107},
+							pos:  position{line: 15, col: 17, offset: 250},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 10, col: 20, offset: 110},
+							pos:   position{line: 15, col: 20, offset: 253},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 10, col: 26, offset: 116},
+								pos:  position{line: 15, col: 26, offset: 259},
 								name: "Operation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 10, col: 36, offset: 126},
+							pos:   position{line: 15, col: 36, offset: 269},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 10, col: 41, offset: 131},
+								pos: position{line: 15, col: 41, offset: 274},
 								expr: &ruleRefExpr{
-									pos:  position{line: 10, col: 41, offset: 131},
+									pos:  position{line: 15, col: 41, offset: 274},
 									name: "SequentialTail",
 								},
 							},
@@ -98,30 +127,30 @@ var g = &grammar{
 		},
 		{
 			name: "SequentialTail",
-			pos:  position{line: 14, col: 1, offset: 300},
+			pos:  position{line: 19, col: 1, offset: 443},
 			expr: &actionExpr{
-				pos: position{line: 14, col: 18, offset: 317},
+				pos: position{line: 19, col: 18, offset: 460},
 				run: (*parser).callonSequentialTail1,
 				expr: &seqExpr{
-					pos: position{line: 14, col: 18, offset: 317},
+					pos: position{line: 19, col: 18, offset: 460},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 14, col: 18, offset: 317},
+							pos:  position{line: 19, col: 18, offset: 460},
 							name: "__",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 14, col: 21, offset: 320},
+							pos:  position{line: 19, col: 21, offset: 463},
 							name: "Pipe",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 14, col: 26, offset: 325},
+							pos:  position{line: 19, col: 26, offset: 468},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 14, col: 29, offset: 328},
+							pos:   position{line: 19, col: 29, offset: 471},
 							label: "p",
 							expr: &ruleRefExpr{
-								pos:  position{line: 14, col: 31, offset: 330},
+								pos:  position{line: 19, col: 31, offset: 473},
 								name: "Operation",
 							},
 						},
@@ -131,22 +160,22 @@ var g = &grammar{
 		},
 		{
 			name: "Decls",
-			pos:  position{line: 16, col: 1, offset: 359},
+			pos:  position{line: 21, col: 1, offset: 502},
 			expr: &choiceExpr{
-				pos: position{line: 17, col: 5, offset: 369},
+				pos: position{line: 22, col: 5, offset: 512},
 				alternatives: []interface{}{
 					&oneOrMoreExpr{
-						pos: position{line: 17, col: 5, offset: 369},
+						pos: position{line: 22, col: 5, offset: 512},
 						expr: &ruleRefExpr{
-							pos:  position{line: 17, col: 5, offset: 369},
+							pos:  position{line: 22, col: 5, offset: 512},
 							name: "Decl",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 18, col: 5, offset: 379},
+						pos: position{line: 23, col: 5, offset: 522},
 						run: (*parser).callonDecls4,
 						expr: &ruleRefExpr{
-							pos:  position{line: 18, col: 5, offset: 379},
+							pos:  position{line: 23, col: 5, offset: 522},
 							name: "__",
 						},
 					},
@@ -155,31 +184,39 @@ var g = &grammar{
 		},
 		{
 			name: "Decl",
-			pos:  position{line: 20, col: 1, offset: 415},
+			pos:  position{line: 25, col: 1, offset: 558},
 			expr: &actionExpr{
-				pos: position{line: 21, col: 5, offset: 424},
+				pos: position{line: 26, col: 5, offset: 567},
 				run: (*parser).callonDecl1,
 				expr: &seqExpr{
-					pos: position{line: 21, col: 5, offset: 424},
+					pos: position{line: 26, col: 5, offset: 567},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 21, col: 5, offset: 424},
+							pos:  position{line: 26, col: 5, offset: 567},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 21, col: 8, offset: 427},
+							pos:   position{line: 26, col: 8, offset: 570},
 							label: "v",
 							expr: &choiceExpr{
-								pos: position{line: 21, col: 11, offset: 430},
+								pos: position{line: 26, col: 11, offset: 573},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 21, col: 11, offset: 430},
+										pos:  position{line: 26, col: 11, offset: 573},
 										name: "ConstDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 21, col: 23, offset: 442},
+										pos:  position{line: 26, col: 23, offset: 585},
 										name: "FuncDecl",
 									},
+									&ruleRefExpr{
+										pos:  position{line: 26, col: 34, offset: 596},
+										name: "OpDecl",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 26, col: 43, offset: 605},
+										name: "ImportDecl",
+									},
 								},
 							},
 						},
@@ -189,51 +226,51 @@ var g = &grammar{
 		},
 		{
 			name: "ConstDecl",
-			pos:  position{line: 23, col: 1, offset: 471},
+			pos:  position{line: 28, col: 1, offset: 636},
 			expr: &choiceExpr{
-				pos: position{line: 24, col: 5, offset: 485},
+				pos: position{line: 29, col: 5, offset: 650},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 24, col: 5, offset: 485},
+						pos: position{line: 29, col: 5, offset: 650},
 						run: (*parser).callonConstDecl2,
 						expr: &seqExpr{
-							pos: position{line: 24, col: 5, offset: 485},
+							pos: position{line: 29, col: 5, offset: 650},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 24, col: 5, offset: 485},
+									pos:        position{line: 29, col: 5, offset: 650},
 									val:        "const",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 24, col: 13, offset: 493},
+									pos:  position{line: 29, col: 13, offset: 658},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 24, col: 15, offset: 495},
+									pos:   position{line: 29, col: 15, offset: 660},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 24, col: 18, offset: 498},
+										pos:  position{line: 29, col: 18, offset: 663},
 										name: "IdentifierName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 24, col: 33, offset: 513},
+									pos:  position{line: 29, col: 33, offset: 678},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 24, col: 36, offset: 516},
+									pos:        position{line: 29, col: 36, offset: 681},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 24, col: 40, offset: 520},
+									pos:  position{line: 29, col: 40, offset: 685},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 24, col: 43, offset: 523},
+									pos:   position{line: 29, col: 43, offset: 688},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 24, col: 48, offset: 528},
+										pos:  position{line: 29, col: 48, offset: 693},
 										name: "Expr",
 									},
 								},
@@ -241,55 +278,55 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 27, col: 5, offset: 630},
+						pos: position{line: 32, col: 5, offset: 795},
 						run: (*parser).callonConstDecl13,
 						expr: &seqExpr{
-							pos: position{line: 27, col: 5, offset: 630},
+							pos: position{line: 32, col: 5, offset: 795},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 27, col: 5, offset: 630},
+									pos:        position{line: 32, col: 5, offset: 795},
 									val:        "type",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 27, col: 12, offset: 637},
+									pos:  position{line: 32, col: 12, offset: 802},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 27, col: 14, offset: 639},
+									pos:   position{line: 32, col: 14, offset: 804},
 									label: "id",
 									expr: &choiceExpr{
-										pos: position{line: 27, col: 18, offset: 643},
+										pos: position{line: 32, col: 18, offset: 808},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 27, col: 18, offset: 643},
+												pos:  position{line: 32, col: 18, offset: 808},
 												name: "IdentifierName",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 27, col: 35, offset: 660},
+												pos:  position{line: 32, col: 35, offset: 825},
 												name: "QuotedString",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 27, col: 49, offset: 674},
+									pos:  position{line: 32, col: 49, offset: 839},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 27, col: 52, offset: 677},
+									pos:        position{line: 32, col: 52, offset: 842},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 27, col: 56, offset: 681},
+									pos:  position{line: 32, col: 56, offset: 846},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 27, col: 59, offset: 684},
+									pos:   position{line: 32, col: 59, offset: 849},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 27, col: 63, offset: 688},
+										pos:  position{line: 32, col: 63, offset: 853},
 										name: "Type",
 									},
 								},
@@ -301,96 +338,198 @@ var g = &grammar{
 		},
 		{
 			name: "FuncDecl",
-			pos:  position{line: 38, col: 1, offset: 946},
+			pos:  position{line: 43, col: 1, offset: 1111},
 			expr: &actionExpr{
-				pos: position{line: 39, col: 5, offset: 959},
+				pos: position{line: 44, col: 5, offset: 1124},
 				run: (*parser).callonFuncDecl1,
 				expr: &seqExpr{
-					pos: position{line: 39, col: 5, offset: 959},
+					pos: position{line: 44, col: 5, offset: 1124},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 39, col: 5, offset: 959},
+							pos:        position{line: 44, col: 5, offset: 1124},
 							val:        "func",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 12, offset: 966},
+							pos:  position{line: 44, col: 12, offset: 1131},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 14, offset: 968},
+							pos:   position{line: 44, col: 14, offset: 1133},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 17, offset: 971},
+								pos:  position{line: 44, col: 17, offset: 1136},
 								name: "IdentifierName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 32, offset: 986},
+							pos:  position{line: 44, col: 32, offset: 1151},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 35, offset: 989},
+							pos:        position{line: 44, col: 35, offset: 1154},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 39, offset: 993},
+							pos:  position{line: 44, col: 39, offset: 1158},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 42, offset: 996},
+							pos:   position{line: 44, col: 42, offset: 1161},
 							label: "params",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 49, offset: 1003},
+								pos:  position{line: 44, col: 49, offset: 1168},
 								name: "IdentifierNames",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 65, offset: 1019},
+							pos:  position{line: 44, col: 65, offset: 1184},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 68, offset: 1022},
+							pos:        position{line: 44, col: 68, offset: 1187},
 							val:        ")",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 72, offset: 1026},
+							pos:  position{line: 44, col: 72, offset: 1191},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 75, offset: 1029},
+							pos:        position{line: 44, col: 75, offset: 1194},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 79, offset: 1033},
+							pos:  position{line: 44, col: 79, offset: 1198},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 82, offset: 1036},
+							pos:        position{line: 44, col: 82, offset: 1201},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 86, offset: 1040},
+							pos:  position{line: 44, col: 86, offset: 1205},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 89, offset: 1043},
+							pos:   position{line: 44, col: 89, offset: 1208},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 94, offset: 1048},
+								pos:  position{line: 44, col: 94, offset: 1213},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 99, offset: 1053},
+							pos:  position{line: 44, col: 99, offset: 1218},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 44, col: 102, offset: 1221},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OpDecl",
+			pos:  position{line: 57, col: 1, offset: 1401},
+			expr: &actionExpr{
+				pos: position{line: 58, col: 5, offset: 1412},
+				run: (*parser).callonOpDecl1,
+				expr: &seqExpr{
+					pos: position{line: 58, col: 5, offset: 1412},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 58, col: 5, offset: 1412},
+							val:        "op",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 58, col: 10, offset: 1417},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 58, col: 12, offset: 1419},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 58, col: 15, offset: 1422},
+								name: "IdentifierName",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 58, col: 30, offset: 1437},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 58, col: 33, offset: 1440},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 58, col: 37, offset: 1444},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 58, col: 40, offset: 1447},
+							label: "params",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 58, col: 47, offset: 1454},
+								expr: &ruleRefExpr{
+									pos:  position{line: 58, col: 47, offset: 1454},
+									name: "IdentifierNames",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 58, col: 64, offset: 1471},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 58, col: 67, offset: 1474},
+							val:        ")",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 58, col: 71, offset: 1478},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 58, col: 74, offset: 1481},
+							val:        ":",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 58, col: 78, offset: 1485},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 58, col: 81, offset: 1488},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 58, col: 85, offset: 1492},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 58, col: 88, offset: 1495},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 58, col: 93, offset: 1500},
+								name: "Sequential",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 58, col: 104, offset: 1511},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 102, offset: 1056},
+							pos:        position{line: 58, col: 107, offset: 1514},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -398,49 +537,79 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "ImportDecl",
+			pos:  position{line: 74, col: 1, offset: 1758},
+			expr: &actionExpr{
+				pos: position{line: 75, col: 5, offset: 1773},
+				run: (*parser).callonImportDecl1,
+				expr: &seqExpr{
+					pos: position{line: 75, col: 5, offset: 1773},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 75, col: 5, offset: 1773},
+							val:        "import",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 75, col: 14, offset: 1782},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 75, col: 16, offset: 1784},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 75, col: 21, offset: 1789},
+								name: "QuotedString",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Operation",
-			pos:  position{line: 52, col: 1, offset: 1236},
+			pos:  position{line: 79, col: 1, offset: 1886},
 			expr: &choiceExpr{
-				pos: position{line: 53, col: 5, offset: 1250},
+				pos: position{line: 80, col: 5, offset: 1900},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 53, col: 5, offset: 1250},
+						pos: position{line: 80, col: 5, offset: 1900},
 						run: (*parser).callonOperation2,
 						expr: &seqExpr{
-							pos: position{line: 53, col: 5, offset: 1250},
+							pos: position{line: 80, col: 5, offset: 1900},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 53, col: 5, offset: 1250},
+									pos:        position{line: 80, col: 5, offset: 1900},
 									val:        "fork",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 53, col: 12, offset: 1257},
+									pos:  position{line: 80, col: 12, offset: 1907},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 53, col: 15, offset: 1260},
+									pos:        position{line: 80, col: 15, offset: 1910},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 53, col: 19, offset: 1264},
+									pos:   position{line: 80, col: 19, offset: 1914},
 									label: "ops",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 53, col: 23, offset: 1268},
+										pos: position{line: 80, col: 23, offset: 1918},
 										expr: &ruleRefExpr{
-											pos:  position{line: 53, col: 23, offset: 1268},
+											pos:  position{line: 80, col: 23, offset: 1918},
 											name: "Leg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 53, col: 28, offset: 1273},
+									pos:  position{line: 80, col: 28, offset: 1923},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 53, col: 31, offset: 1276},
+									pos:        position{line: 80, col: 31, offset: 1926},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -448,54 +617,54 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 56, col: 5, offset: 1365},
+						pos: position{line: 83, col: 5, offset: 2015},
 						run: (*parser).callonOperation12,
 						expr: &seqExpr{
-							pos: position{line: 56, col: 5, offset: 1365},
+							pos: position{line: 83, col: 5, offset: 2015},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 56, col: 5, offset: 1365},
+									pos:        position{line: 83, col: 5, offset: 2015},
 									val:        "switch",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 56, col: 14, offset: 1374},
+									pos:  position{line: 83, col: 14, offset: 2024},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 56, col: 16, offset: 1376},
+									pos:   position{line: 83, col: 16, offset: 2026},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 56, col: 21, offset: 1381},
+										pos:  position{line: 83, col: 21, offset: 2031},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 56, col: 26, offset: 1386},
+									pos:  position{line: 83, col: 26, offset: 2036},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 56, col: 28, offset: 1388},
+									pos:        position{line: 83, col: 28, offset: 2038},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 56, col: 32, offset: 1392},
+									pos:   position{line: 83, col: 32, offset: 2042},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 56, col: 38, offset: 1398},
+										pos: position{line: 83, col: 38, offset: 2048},
 										expr: &ruleRefExpr{
-											pos:  position{line: 56, col: 38, offset: 1398},
+											pos:  position{line: 83, col: 38, offset: 2048},
 											name: "SwitchLeg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 56, col: 49, offset: 1409},
+									pos:  position{line: 83, col: 49, offset: 2059},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 56, col: 52, offset: 1412},
+									pos:        position{line: 83, col: 52, offset: 2062},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -503,42 +672,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 59, col: 5, offset: 1517},
+						pos: position{line: 86, col: 5, offset: 2167},
 						run: (*parser).callonOperation25,
 						expr: &seqExpr{
-							pos: position{line: 59, col: 5, offset: 1517},
+							pos: position{line: 86, col: 5, offset: 2167},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 59, col: 5, offset: 1517},
+									pos:        position{line: 86, col: 5, offset: 2167},
 									val:        "switch",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 59, col: 14, offset: 1526},
+									pos:  position{line: 86, col: 14, offset: 2176},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 59, col: 17, offset: 1529},
+									pos:        position{line: 86, col: 17, offset: 2179},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 59, col: 21, offset: 1533},
+									pos:   position{line: 86, col: 21, offset: 2183},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 59, col: 27, offset: 1539},
+										pos: position{line: 86, col: 27, offset: 2189},
 										expr: &ruleRefExpr{
-											pos:  position{line: 59, col: 27, offset: 1539},
+											pos:  position{line: 86, col: 27, offset: 2189},
 											name: "SwitchLeg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 59, col: 38, offset: 1550},
+									pos:  position{line: 86, col: 38, offset: 2200},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 59, col: 41, offset: 1553},
+									pos:        position{line: 86, col: 41, offset: 2203},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -546,42 +715,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 62, col: 5, offset: 1657},
+						pos: position{line: 89, col: 5, offset: 2307},
 						run: (*parser).callonOperation35,
 						expr: &seqExpr{
-							pos: position{line: 62, col: 5, offset: 1657},
+							pos: position{line: 89, col: 5, offset: 2307},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 62, col: 5, offset: 1657},
+									pos:        position{line: 89, col: 5, offset: 2307},
 									val:        "from",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 62, col: 12, offset: 1664},
+									pos:  position{line: 89, col: 12, offset: 2314},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 62, col: 15, offset: 1667},
+									pos:        position{line: 89, col: 15, offset: 2317},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 62, col: 19, offset: 1671},
+									pos:   position{line: 89, col: 19, offset: 2321},
 									label: "trunks",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 62, col: 26, offset: 1678},
+										pos: position{line: 89, col: 26, offset: 2328},
 										expr: &ruleRefExpr{
-											pos:  position{line: 62, col: 26, offset: 1678},
+											pos:  position{line: 89, col: 26, offset: 2328},
 											name: "FromLeg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 62, col: 35, offset: 1687},
+									pos:  position{line: 89, col: 35, offset: 2337},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 62, col: 38, offset: 1690},
+									pos:        position{line: 89, col: 38, offset: 2340},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -589,31 +758,31 @@ var g = &grammar{
 						},
 					},
 					&labeledExpr{
-						pos:   position{line: 65, col: 5, offset: 1781},
+						pos:   position{line: 92, col: 5, offset: 2431},
 						label: "op",
 						expr: &ruleRefExpr{
-							pos:  position{line: 65, col: 8, offset: 1784},
+							pos:  position{line: 92, col: 8, offset: 2434},
 							name: "Operator",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 66, col: 5, offset: 1797},
+						pos: position{line: 93, col: 5, offset: 2447},
 						run: (*parser).callonOperation47,
 						expr: &seqExpr{
-							pos: position{line: 66, col: 5, offset: 1797},
+							pos: position{line: 93, col: 5, offset: 2447},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 66, col: 5, offset: 1797},
+									pos:   position{line: 93, col: 5, offset: 2447},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 66, col: 7, offset: 1799},
+										pos:  position{line: 93, col: 7, offset: 2449},
 										name: "OpAssignment",
 									},
 								},
 								&andExpr{
-									pos: position{line: 66, col: 20, offset: 1812},
+									pos: position{line: 93, col: 20, offset: 2462},
 									expr: &ruleRefExpr{
-										pos:  position{line: 66, col: 21, offset: 1813},
+										pos:  position{line: 93, col: 21, offset: 2463},
 										name: "EndOfOp",
 									},
 								},
@@ -621,39 +790,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 67, col: 5, offset: 1843},
+						pos: position{line: 94, col: 5, offset: 2493},
 						run: (*parser).callonOperation53,
 						expr: &seqExpr{
-							pos: position{line: 67, col: 5, offset: 1843},
+							pos: position{line: 94, col: 5, offset: 2493},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 67, col: 5, offset: 1843},
+									pos: position{line: 94, col: 5, offset: 2493},
 									expr: &seqExpr{
-										pos: position{line: 67, col: 7, offset: 1845},
+										pos: position{line: 94, col: 7, offset: 2495},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 67, col: 7, offset: 1845},
+												pos:  position{line: 94, col: 7, offset: 2495},
 												name: "Function",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 67, col: 16, offset: 1854},
+												pos:  position{line: 94, col: 16, offset: 2504},
 												name: "EndOfOp",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 67, col: 25, offset: 1863},
+									pos:   position{line: 94, col: 25, offset: 2513},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 27, offset: 1865},
+										pos:  position{line: 94, col: 27, offset: 2515},
 										name: "Aggregation",
 									},
 								},
 								&andExpr{
-									pos: position{line: 67, col: 39, offset: 1877},
+									pos: position{line: 94, col: 39, offset: 2527},
 									expr: &ruleRefExpr{
-										pos:  position{line: 67, col: 40, offset: 1878},
+										pos:  position{line: 94, col: 40, offset: 2528},
 										name: "EndOfOp",
 									},
 								},
@@ -661,25 +830,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 68, col: 5, offset: 1909},
+						pos: position{line: 95, col: 5, offset: 2559},
 						run: (*parser).callonOperation63,
 						expr: &seqExpr{
-							pos: position{line: 68, col: 5, offset: 1909},
+							pos: position{line: 95, col: 5, offset: 2559},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 68, col: 5, offset: 1909},
+									pos:        position{line: 95, col: 5, offset: 2559},
 									val:        "search",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 68, col: 14, offset: 1918},
+									pos:  position{line: 95, col: 14, offset: 2568},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 68, col: 16, offset: 1920},
+									pos:   position{line: 95, col: 16, offset: 2570},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 68, col: 21, offset: 1925},
+										pos:  position{line: 95, col: 21, offset: 2575},
 										name: "SearchBoolean",
 									},
 								},
@@ -687,37 +856,37 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 71, col: 5, offset: 2024},
+						pos: position{line: 98, col: 5, offset: 2674},
 						run: (*parser).callonOperation69,
 						expr: &labeledExpr{
-							pos:   position{line: 71, col: 5, offset: 2024},
+							pos:   position{line: 98, col: 5, offset: 2674},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 71, col: 10, offset: 2029},
+								pos:  position{line: 98, col: 10, offset: 2679},
 								name: "SearchBoolean",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 74, col: 5, offset: 2128},
+						pos: position{line: 101, col: 5, offset: 2778},
 						run: (*parser).callonOperation72,
 						expr: &labeledExpr{
-							pos:   position{line: 74, col: 5, offset: 2128},
+							pos:   position{line: 101, col: 5, offset: 2778},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 74, col: 10, offset: 2133},
+								pos:  position{line: 101, col: 10, offset: 2783},
 								name: "Cast",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 77, col: 5, offset: 2221},
+						pos: position{line: 104, col: 5, offset: 2871},
 						run: (*parser).callonOperation75,
 						expr: &labeledExpr{
-							pos:   position{line: 77, col: 5, offset: 2221},
+							pos:   position{line: 104, col: 5, offset: 2871},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 77, col: 10, offset: 2226},
+								pos:  position{line: 104, col: 10, offset: 2876},
 								name: "Expr",
 							},
 						},
@@ -727,37 +896,37 @@ var g = &grammar{
 		},
 		{
 			name: "EndOfOp",
-			pos:  position{line: 81, col: 1, offset: 2313},
+			pos:  position{line: 108, col: 1, offset: 2963},
 			expr: &seqExpr{
-				pos: position{line: 81, col: 11, offset: 2323},
+				pos: position{line: 108, col: 11, offset: 2973},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 81, col: 11, offset: 2323},
+						pos:  position{line: 108, col: 11, offset: 2973},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 81, col: 15, offset: 2327},
+						pos: position{line: 108, col: 15, offset: 2977},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 81, col: 15, offset: 2327},
+								pos:  position{line: 108, col: 15, offset: 2977},
 								name: "Pipe",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 81, col: 22, offset: 2334},
+								pos:  position{line: 108, col: 22, offset: 2984},
 								name: "SearchKeywordGuard",
 							},
 							&litMatcher{
-								pos:        position{line: 81, col: 43, offset: 2355},
+								pos:        position{line: 108, col: 43, offset: 3005},
 								val:        "=>",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 81, col: 50, offset: 2362},
+								pos:        position{line: 108, col: 50, offset: 3012},
 								val:        ")",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 81, col: 56, offset: 2368},
+								pos:  position{line: 108, col: 56, offset: 3018},
 								name: "EOF",
 							},
 						},
@@ -767,27 +936,27 @@ var g = &grammar{
 		},
 		{
 			name: "Pipe",
-			pos:  position{line: 82, col: 1, offset: 2373},
+			pos:  position{line: 109, col: 1, offset: 3023},
 			expr: &seqExpr{
-				pos: position{line: 82, col: 8, offset: 2380},
+				pos: position{line: 109, col: 8, offset: 3030},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 82, col: 8, offset: 2380},
+						pos:        position{line: 109, col: 8, offset: 3030},
 						val:        "|",
 						ignoreCase: false,
 					},
 					&notExpr{
-						pos: position{line: 82, col: 12, offset: 2384},
+						pos: position{line: 109, col: 12, offset: 3034},
 						expr: &choiceExpr{
-							pos: position{line: 82, col: 14, offset: 2386},
+							pos: position{line: 109, col: 14, offset: 3036},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 82, col: 14, offset: 2386},
+									pos:        position{line: 109, col: 14, offset: 3036},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 82, col: 20, offset: 2392},
+									pos:        position{line: 109, col: 20, offset: 3042},
 									val:        "[",
 									ignoreCase: false,
 								},
@@ -799,31 +968,31 @@ var g = &grammar{
 		},
 		{
 			name: "Leg",
-			pos:  position{line: 84, col: 1, offset: 2398},
+			pos:  position{line: 111, col: 1, offset: 3048},
 			expr: &actionExpr{
-				pos: position{line: 85, col: 5, offset: 2406},
+				pos: position{line: 112, col: 5, offset: 3056},
 				run: (*parser).callonLeg1,
 				expr: &seqExpr{
-					pos: position{line: 85, col: 5, offset: 2406},
+					pos: position{line: 112, col: 5, offset: 3056},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 85, col: 5, offset: 2406},
+							pos:  position{line: 112, col: 5, offset: 3056},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 85, col: 8, offset: 2409},
+							pos:        position{line: 112, col: 8, offset: 3059},
 							val:        "=>",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 85, col: 13, offset: 2414},
+							pos:  position{line: 112, col: 13, offset: 3064},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 85, col: 16, offset: 2417},
+							pos:   position{line: 112, col: 16, offset: 3067},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 85, col: 18, offset: 2419},
+								pos:  position{line: 112, col: 18, offset: 3069},
 								name: "Sequential",
 							},
 						},
@@ -833,43 +1002,43 @@ var g = &grammar{
 		},
 		{
 			name: "SwitchLeg",
-			pos:  position{line: 87, col: 1, offset: 2449},
+			pos:  position{line: 114, col: 1, offset: 3099},
 			expr: &actionExpr{
-				pos: position{line: 88, col: 5, offset: 2463},
+				pos: position{line: 115, col: 5, offset: 3113},
 				run: (*parser).callonSwitchLeg1,
 				expr: &seqExpr{
-					pos: position{line: 88, col: 5, offset: 2463},
+					pos: position{line: 115, col: 5, offset: 3113},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 88, col: 5, offset: 2463},
+							pos:  position{line: 115, col: 5, offset: 3113},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 88, col: 8, offset: 2466},
+							pos:   position{line: 115, col: 8, offset: 3116},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 88, col: 13, offset: 2471},
+								pos:  position{line: 115, col: 13, offset: 3121},
 								name: "Case",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 88, col: 18, offset: 2476},
+							pos:  position{line: 115, col: 18, offset: 3126},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 88, col: 21, offset: 2479},
+							pos:        position{line: 115, col: 21, offset: 3129},
 							val:        "=>",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 88, col: 26, offset: 2484},
+							pos:  position{line: 115, col: 26, offset: 3134},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 88, col: 29, offset: 2487},
+							pos:   position{line: 115, col: 29, offset: 3137},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 88, col: 32, offset: 2490},
+								pos:  position{line: 115, col: 32, offset: 3140},
 								name: "Sequential",
 							},
 						},
@@ -879,30 +1048,30 @@ var g = &grammar{
 		},
 		{
 			name: "Case",
-			pos:  position{line: 92, col: 1, offset: 2575},
+			pos:  position{line: 119, col: 1, offset: 3225},
 			expr: &choiceExpr{
-				pos: position{line: 93, col: 5, offset: 2584},
+				pos: position{line: 120, col: 5, offset: 3234},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 93, col: 5, offset: 2584},
+						pos: position{line: 120, col: 5, offset: 3234},
 						run: (*parser).callonCase2,
 						expr: &seqExpr{
-							pos: position{line: 93, col: 5, offset: 2584},
+							pos: position{line: 120, col: 5, offset: 3234},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 93, col: 5, offset: 2584},
+									pos:        position{line: 120, col: 5, offset: 3234},
 									val:        "case",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 93, col: 12, offset: 2591},
+									pos:  position{line: 120, col: 12, offset: 3241},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 93, col: 14, offset: 2593},
+									pos:   position{line: 120, col: 14, offset: 3243},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 93, col: 19, offset: 2598},
+										pos:  position{line: 120, col: 19, offset: 3248},
 										name: "Expr",
 									},
 								},
@@ -910,10 +1079,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 94, col: 5, offset: 2628},
+						pos: position{line: 121, col: 5, offset: 3278},
 						run: (*parser).callonCase8,
 						expr: &litMatcher{
-							pos:        position{line: 94, col: 5, offset: 2628},
+							pos:        position{line: 121, col: 5, offset: 3278},
 							val:        "default",
 							ignoreCase: false,
 						},
@@ -923,48 +1092,48 @@ var g = &grammar{
 		},
 		{
 			name: "FromLeg",
-			pos:  position{line: 96, col: 1, offset: 2659},
+			pos:  position{line: 123, col: 1, offset: 3309},
 			expr: &actionExpr{
-				pos: position{line: 97, col: 5, offset: 2671},
+				pos: position{line: 124, col: 5, offset: 3321},
 				run: (*parser).callonFromLeg1,
 				expr: &seqExpr{
-					pos: position{line: 97, col: 5, offset: 2671},
+					pos: position{line: 124, col: 5, offset: 3321},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 97, col: 5, offset: 2671},
+							pos:  position{line: 124, col: 5, offset: 3321},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 97, col: 8, offset: 2674},
+							pos:   position{line: 124, col: 8, offset: 3324},
 							label: "source",
 							expr: &ruleRefExpr{
-								pos:  position{line: 97, col: 15, offset: 2681},
+								pos:  position{line: 124, col: 15, offset: 3331},
 								name: "FromSource",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 97, col: 26, offset: 2692},
+							pos:   position{line: 124, col: 26, offset: 3342},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 97, col: 30, offset: 2696},
+								pos: position{line: 124, col: 30, offset: 3346},
 								expr: &seqExpr{
-									pos: position{line: 97, col: 31, offset: 2697},
+									pos: position{line: 124, col: 31, offset: 3347},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 97, col: 31, offset: 2697},
+											pos:  position{line: 124, col: 31, offset: 3347},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 97, col: 34, offset: 2700},
+											pos:        position{line: 124, col: 34, offset: 3350},
 											val:        "=>",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 97, col: 39, offset: 2705},
+											pos:  position{line: 124, col: 39, offset: 3355},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 97, col: 43, offset: 2709},
+											pos:  position{line: 124, col: 43, offset: 3359},
 											name: "Sequential",
 										},
 									},
@@ -977,24 +1146,24 @@ var g = &grammar{
 		},
 		{
 			name: "FromSource",
-			pos:  position{line: 105, col: 1, offset: 2907},
+			pos:  position{line: 132, col: 1, offset: 3557},
 			expr: &choiceExpr{
-				pos: position{line: 106, col: 5, offset: 2922},
+				pos: position{line: 133, col: 5, offset: 3572},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 106, col: 5, offset: 2922},
+						pos:  position{line: 133, col: 5, offset: 3572},
 						name: "File",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 107, col: 5, offset: 2931},
+						pos:  position{line: 134, col: 5, offset: 3581},
 						name: "Get",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 108, col: 5, offset: 2939},
+						pos:  position{line: 135, col: 5, offset: 3589},
 						name: "Pool",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 109, col: 5, offset: 2948},
+						pos:  position{line: 136, col: 5, offset: 3598},
 						name: "PassOp",
 					},
 				},
@@ -1002,59 +1171,59 @@ var g = &grammar{
 		},
 		{
 			name: "ExprGuard",
-			pos:  position{line: 111, col: 1, offset: 2956},
+			pos:  position{line: 138, col: 1, offset: 3606},
 			expr: &seqExpr{
-				pos: position{line: 111, col: 13, offset: 2968},
+				pos: position{line: 138, col: 13, offset: 3618},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 111, col: 13, offset: 2968},
+						pos:  position{line: 138, col: 13, offset: 3618},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 111, col: 17, offset: 2972},
+						pos: position{line: 138, col: 17, offset: 3622},
 						alternatives: []interface{}{
 							&seqExpr{
-								pos: position{line: 111, col: 18, offset: 2973},
+								pos: position{line: 138, col: 18, offset: 3623},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 111, col: 18, offset: 2973},
+										pos: position{line: 138, col: 18, offset: 3623},
 										expr: &litMatcher{
-											pos:        position{line: 111, col: 19, offset: 2974},
+											pos:        position{line: 138, col: 19, offset: 3624},
 											val:        "=>",
 											ignoreCase: false,
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 111, col: 24, offset: 2979},
+										pos:  position{line: 138, col: 24, offset: 3629},
 										name: "Comparator",
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 111, col: 38, offset: 2993},
+								pos:  position{line: 138, col: 38, offset: 3643},
 								name: "AdditiveOperator",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 111, col: 57, offset: 3012},
+								pos:  position{line: 138, col: 57, offset: 3662},
 								name: "MultiplicativeOperator",
 							},
 							&litMatcher{
-								pos:        position{line: 111, col: 82, offset: 3037},
+								pos:        position{line: 138, col: 82, offset: 3687},
 								val:        ":",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 111, col: 88, offset: 3043},
+								pos:        position{line: 138, col: 88, offset: 3693},
 								val:        "(",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 111, col: 94, offset: 3049},
+								pos:        position{line: 138, col: 94, offset: 3699},
 								val:        "[",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 111, col: 100, offset: 3055},
+								pos:        position{line: 138, col: 100, offset: 3705},
 								val:        "~",
 								ignoreCase: false,
 							},
@@ -1065,57 +1234,57 @@ var g = &grammar{
 		},
 		{
 			name: "Comparator",
-			pos:  position{line: 113, col: 1, offset: 3061},
+			pos:  position{line: 140, col: 1, offset: 3711},
 			expr: &actionExpr{
-				pos: position{line: 113, col: 14, offset: 3074},
+				pos: position{line: 140, col: 14, offset: 3724},
 				run: (*parser).callonComparator1,
 				expr: &choiceExpr{
-					pos: position{line: 113, col: 15, offset: 3075},
+					pos: position{line: 140, col: 15, offset: 3725},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 113, col: 15, offset: 3075},
+							pos:        position{line: 140, col: 15, offset: 3725},
 							val:        "==",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 22, offset: 3082},
+							pos:        position{line: 140, col: 22, offset: 3732},
 							val:        "!=",
 							ignoreCase: false,
 						},
 						&seqExpr{
-							pos: position{line: 113, col: 30, offset: 3090},
+							pos: position{line: 140, col: 30, offset: 3740},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 113, col: 30, offset: 3090},
+									pos:        position{line: 140, col: 30, offset: 3740},
 									val:        "in",
 									ignoreCase: false,
 								},
 								&notExpr{
-									pos: position{line: 113, col: 35, offset: 3095},
+									pos: position{line: 140, col: 35, offset: 3745},
 									expr: &ruleRefExpr{
-										pos:  position{line: 113, col: 36, offset: 3096},
+										pos:  position{line: 140, col: 36, offset: 3746},
 										name: "IdentifierRest",
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 54, offset: 3114},
+							pos:        position{line: 140, col: 54, offset: 3764},
 							val:        "<=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 61, offset: 3121},
+							pos:        position{line: 140, col: 61, offset: 3771},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 67, offset: 3127},
+							pos:        position{line: 140, col: 67, offset: 3777},
 							val:        ">=",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 113, col: 74, offset: 3134},
+							pos:        position{line: 140, col: 74, offset: 3784},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -1125,28 +1294,28 @@ var g = &grammar{
 		},
 		{
 			name: "SearchBoolean",
-			pos:  position{line: 115, col: 1, offset: 3171},
+			pos:  position{line: 142, col: 1, offset: 3821},
 			expr: &actionExpr{
-				pos: position{line: 116, col: 5, offset: 3189},
+				pos: position{line: 143, col: 5, offset: 3839},
 				run: (*parser).callonSearchBoolean1,
 				expr: &seqExpr{
-					pos: position{line: 116, col: 5, offset: 3189},
+					pos: position{line: 143, col: 5, offset: 3839},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 116, col: 5, offset: 3189},
+							pos:   position{line: 143, col: 5, offset: 3839},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 116, col: 11, offset: 3195},
+								pos:  position{line: 143, col: 11, offset: 3845},
 								name: "SearchAnd",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 116, col: 21, offset: 3205},
+							pos:   position{line: 143, col: 21, offset: 3855},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 116, col: 26, offset: 3210},
+								pos: position{line: 143, col: 26, offset: 3860},
 								expr: &ruleRefExpr{
-									pos:  position{line: 116, col: 26, offset: 3210},
+									pos:  position{line: 143, col: 26, offset: 3860},
 									name: "SearchOrTerm",
 								},
 							},
@@ -1157,30 +1326,30 @@ var g = &grammar{
 		},
 		{
 			name: "SearchOrTerm",
-			pos:  position{line: 120, col: 1, offset: 3284},
+			pos:  position{line: 147, col: 1, offset: 3934},
 			expr: &actionExpr{
-				pos: position{line: 120, col: 16, offset: 3299},
+				pos: position{line: 147, col: 16, offset: 3949},
 				run: (*parser).callonSearchOrTerm1,
 				expr: &seqExpr{
-					pos: position{line: 120, col: 16, offset: 3299},
+					pos: position{line: 147, col: 16, offset: 3949},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 16, offset: 3299},
+							pos:  position{line: 147, col: 16, offset: 3949},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 18, offset: 3301},
+							pos:  position{line: 147, col: 18, offset: 3951},
 							name: "OrToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 26, offset: 3309},
+							pos:  position{line: 147, col: 26, offset: 3959},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 120, col: 28, offset: 3311},
+							pos:   position{line: 147, col: 28, offset: 3961},
 							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 120, col: 30, offset: 3313},
+								pos:  position{line: 147, col: 30, offset: 3963},
 								name: "SearchAnd",
 							},
 						},
@@ -1190,73 +1359,73 @@ var g = &grammar{
 		},
 		{
 			name: "SearchAnd",
-			pos:  position{line: 122, col: 1, offset: 3363},
+			pos:  position{line: 149, col: 1, offset: 4013},
 			expr: &actionExpr{
-				pos: position{line: 123, col: 5, offset: 3377},
+				pos: position{line: 150, col: 5, offset: 4027},
 				run: (*parser).callonSearchAnd1,
 				expr: &seqExpr{
-					pos: position{line: 123, col: 5, offset: 3377},
+					pos: position{line: 150, col: 5, offset: 4027},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 123, col: 5, offset: 3377},
+							pos:   position{line: 150, col: 5, offset: 4027},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 123, col: 11, offset: 3383},
+								pos:  position{line: 150, col: 11, offset: 4033},
 								name: "SearchFactor",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 124, col: 5, offset: 3400},
+							pos:   position{line: 151, col: 5, offset: 4050},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 124, col: 10, offset: 3405},
+								pos: position{line: 151, col: 10, offset: 4055},
 								expr: &actionExpr{
-									pos: position{line: 124, col: 11, offset: 3406},
+									pos: position{line: 151, col: 11, offset: 4056},
 									run: (*parser).callonSearchAnd7,
 									expr: &seqExpr{
-										pos: position{line: 124, col: 11, offset: 3406},
+										pos: position{line: 151, col: 11, offset: 4056},
 										exprs: []interface{}{
 											&zeroOrOneExpr{
-												pos: position{line: 124, col: 11, offset: 3406},
+												pos: position{line: 151, col: 11, offset: 4056},
 												expr: &seqExpr{
-													pos: position{line: 124, col: 12, offset: 3407},
+													pos: position{line: 151, col: 12, offset: 4057},
 													exprs: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 124, col: 12, offset: 3407},
+															pos:  position{line: 151, col: 12, offset: 4057},
 															name: "_",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 124, col: 14, offset: 3409},
+															pos:  position{line: 151, col: 14, offset: 4059},
 															name: "AndToken",
 														},
 													},
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 124, col: 25, offset: 3420},
+												pos:  position{line: 151, col: 25, offset: 4070},
 												name: "_",
 											},
 											&notExpr{
-												pos: position{line: 124, col: 27, offset: 3422},
+												pos: position{line: 151, col: 27, offset: 4072},
 												expr: &choiceExpr{
-													pos: position{line: 124, col: 29, offset: 3424},
+													pos: position{line: 151, col: 29, offset: 4074},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 124, col: 29, offset: 3424},
+															pos:  position{line: 151, col: 29, offset: 4074},
 															name: "OrToken",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 124, col: 39, offset: 3434},
+															pos:  position{line: 151, col: 39, offset: 4084},
 															name: "SearchKeywordGuard",
 														},
 													},
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 124, col: 59, offset: 3454},
+												pos:   position{line: 151, col: 59, offset: 4104},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 124, col: 64, offset: 3459},
+													pos:  position{line: 151, col: 64, offset: 4109},
 													name: "SearchFactor",
 												},
 											},
@@ -1271,41 +1440,41 @@ var g = &grammar{
 		},
 		{
 			name: "SearchKeywordGuard",
-			pos:  position{line: 128, col: 1, offset: 3575},
+			pos:  position{line: 155, col: 1, offset: 4225},
 			expr: &choiceExpr{
-				pos: position{line: 129, col: 5, offset: 3598},
+				pos: position{line: 156, col: 5, offset: 4248},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 129, col: 5, offset: 3598},
+						pos: position{line: 156, col: 5, offset: 4248},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 129, col: 5, offset: 3598},
+								pos:  position{line: 156, col: 5, offset: 4248},
 								name: "FromSource",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 129, col: 16, offset: 3609},
+								pos:  position{line: 156, col: 16, offset: 4259},
 								name: "__",
 							},
 							&litMatcher{
-								pos:        position{line: 129, col: 19, offset: 3612},
+								pos:        position{line: 156, col: 19, offset: 4262},
 								val:        "=>",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 129, col: 24, offset: 3617},
+								pos:  position{line: 156, col: 24, offset: 4267},
 								name: "__",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 130, col: 5, offset: 3624},
+						pos: position{line: 157, col: 5, offset: 4274},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 130, col: 5, offset: 3624},
+								pos:  position{line: 157, col: 5, offset: 4274},
 								name: "Case",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 130, col: 10, offset: 3629},
+								pos:  position{line: 157, col: 10, offset: 4279},
 								name: "__",
 							},
 						},
@@ -1315,42 +1484,42 @@ var g = &grammar{
 		},
 		{
 			name: "SearchFactor",
-			pos:  position{line: 132, col: 1, offset: 3633},
+			pos:  position{line: 159, col: 1, offset: 4283},
 			expr: &choiceExpr{
-				pos: position{line: 133, col: 5, offset: 3650},
+				pos: position{line: 160, col: 5, offset: 4300},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 133, col: 5, offset: 3650},
+						pos: position{line: 160, col: 5, offset: 4300},
 						run: (*parser).callonSearchFactor2,
 						expr: &seqExpr{
-							pos: position{line: 133, col: 5, offset: 3650},
+							pos: position{line: 160, col: 5, offset: 4300},
 							exprs: []interface{}{
 								&choiceExpr{
-									pos: position{line: 133, col: 6, offset: 3651},
+									pos: position{line: 160, col: 6, offset: 4301},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 133, col: 6, offset: 3651},
+											pos: position{line: 160, col: 6, offset: 4301},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 133, col: 6, offset: 3651},
+													pos:  position{line: 160, col: 6, offset: 4301},
 													name: "NotToken",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 133, col: 15, offset: 3660},
+													pos:  position{line: 160, col: 15, offset: 4310},
 													name: "_",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 133, col: 19, offset: 3664},
+											pos: position{line: 160, col: 19, offset: 4314},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 133, col: 19, offset: 3664},
+													pos:        position{line: 160, col: 19, offset: 4314},
 													val:        "!",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 133, col: 23, offset: 3668},
+													pos:  position{line: 160, col: 23, offset: 4318},
 													name: "__",
 												},
 											},
@@ -1358,10 +1527,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 133, col: 27, offset: 3672},
+									pos:   position{line: 160, col: 27, offset: 4322},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 133, col: 29, offset: 3674},
+										pos:  position{line: 160, col: 29, offset: 4324},
 										name: "SearchFactor",
 									},
 								},
@@ -1369,34 +1538,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 136, col: 5, offset: 3786},
+						pos: position{line: 163, col: 5, offset: 4436},
 						run: (*parser).callonSearchFactor13,
 						expr: &seqExpr{
-							pos: position{line: 136, col: 5, offset: 3786},
+							pos: position{line: 163, col: 5, offset: 4436},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 136, col: 5, offset: 3786},
+									pos:        position{line: 163, col: 5, offset: 4436},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 136, col: 9, offset: 3790},
+									pos:  position{line: 163, col: 9, offset: 4440},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 136, col: 12, offset: 3793},
+									pos:   position{line: 163, col: 12, offset: 4443},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 136, col: 17, offset: 3798},
+										pos:  position{line: 163, col: 17, offset: 4448},
 										name: "SearchBoolean",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 136, col: 31, offset: 3812},
+									pos:  position{line: 163, col: 31, offset: 4462},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 136, col: 34, offset: 3815},
+									pos:        position{line: 163, col: 34, offset: 4465},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1404,7 +1573,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 137, col: 5, offset: 3844},
+						pos:  position{line: 164, col: 5, offset: 4494},
 						name: "SearchExpr",
 					},
 				},
@@ -1412,53 +1581,53 @@ var g = &grammar{
 		},
 		{
 			name: "SearchExpr",
-			pos:  position{line: 139, col: 1, offset: 3856},
+			pos:  position{line: 166, col: 1, offset: 4506},
 			expr: &choiceExpr{
-				pos: position{line: 140, col: 5, offset: 3871},
+				pos: position{line: 167, col: 5, offset: 4521},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 140, col: 5, offset: 3871},
+						pos:  position{line: 167, col: 5, offset: 4521},
 						name: "Glob",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 141, col: 5, offset: 3880},
+						pos:  position{line: 168, col: 5, offset: 4530},
 						name: "Regexp",
 					},
 					&actionExpr{
-						pos: position{line: 142, col: 5, offset: 3891},
+						pos: position{line: 169, col: 5, offset: 4541},
 						run: (*parser).callonSearchExpr4,
 						expr: &seqExpr{
-							pos: position{line: 142, col: 5, offset: 3891},
+							pos: position{line: 169, col: 5, offset: 4541},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 142, col: 5, offset: 3891},
+									pos:   position{line: 169, col: 5, offset: 4541},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 142, col: 7, offset: 3893},
+										pos:  position{line: 169, col: 7, offset: 4543},
 										name: "SearchValue",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 142, col: 20, offset: 3906},
+									pos: position{line: 169, col: 20, offset: 4556},
 									alternatives: []interface{}{
 										&notExpr{
-											pos: position{line: 142, col: 20, offset: 3906},
+											pos: position{line: 169, col: 20, offset: 4556},
 											expr: &ruleRefExpr{
-												pos:  position{line: 142, col: 21, offset: 3907},
+												pos:  position{line: 169, col: 21, offset: 4557},
 												name: "ExprGuard",
 											},
 										},
 										&andExpr{
-											pos: position{line: 142, col: 33, offset: 3919},
+											pos: position{line: 169, col: 33, offset: 4569},
 											expr: &seqExpr{
-												pos: position{line: 142, col: 35, offset: 3921},
+												pos: position{line: 169, col: 35, offset: 4571},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 142, col: 35, offset: 3921},
+														pos:  position{line: 169, col: 35, offset: 4571},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 142, col: 37, offset: 3923},
+														pos:  position{line: 169, col: 37, offset: 4573},
 														name: "Glob",
 													},
 												},
@@ -1470,20 +1639,20 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 145, col: 5, offset: 4035},
+						pos: position{line: 172, col: 5, offset: 4685},
 						run: (*parser).callonSearchExpr15,
 						expr: &seqExpr{
-							pos: position{line: 145, col: 5, offset: 4035},
+							pos: position{line: 172, col: 5, offset: 4685},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 145, col: 5, offset: 4035},
+									pos:        position{line: 172, col: 5, offset: 4685},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&notExpr{
-									pos: position{line: 145, col: 9, offset: 4039},
+									pos: position{line: 172, col: 9, offset: 4689},
 									expr: &ruleRefExpr{
-										pos:  position{line: 145, col: 10, offset: 4040},
+										pos:  position{line: 172, col: 10, offset: 4690},
 										name: "ExprGuard",
 									},
 								},
@@ -1491,7 +1660,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 148, col: 5, offset: 4156},
+						pos:  position{line: 175, col: 5, offset: 4806},
 						name: "SearchPredicate",
 					},
 				},
@@ -1499,45 +1668,45 @@ var g = &grammar{
 		},
 		{
 			name: "SearchPredicate",
-			pos:  position{line: 150, col: 1, offset: 4173},
+			pos:  position{line: 177, col: 1, offset: 4823},
 			expr: &choiceExpr{
-				pos: position{line: 151, col: 5, offset: 4193},
+				pos: position{line: 178, col: 5, offset: 4843},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 151, col: 5, offset: 4193},
+						pos: position{line: 178, col: 5, offset: 4843},
 						run: (*parser).callonSearchPredicate2,
 						expr: &seqExpr{
-							pos: position{line: 151, col: 5, offset: 4193},
+							pos: position{line: 178, col: 5, offset: 4843},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 151, col: 5, offset: 4193},
+									pos:   position{line: 178, col: 5, offset: 4843},
 									label: "lhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 151, col: 9, offset: 4197},
+										pos:  position{line: 178, col: 9, offset: 4847},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 151, col: 22, offset: 4210},
+									pos:  position{line: 178, col: 22, offset: 4860},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 151, col: 25, offset: 4213},
+									pos:   position{line: 178, col: 25, offset: 4863},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 151, col: 28, offset: 4216},
+										pos:  position{line: 178, col: 28, offset: 4866},
 										name: "Comparator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 151, col: 39, offset: 4227},
+									pos:  position{line: 178, col: 39, offset: 4877},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 151, col: 42, offset: 4230},
+									pos:   position{line: 178, col: 42, offset: 4880},
 									label: "rhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 151, col: 46, offset: 4234},
+										pos:  position{line: 178, col: 46, offset: 4884},
 										name: "AdditiveExpr",
 									},
 								},
@@ -1545,26 +1714,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 154, col: 6, offset: 4357},
+						pos: position{line: 181, col: 6, offset: 5007},
 						run: (*parser).callonSearchPredicate12,
 						expr: &seqExpr{
-							pos: position{line: 154, col: 6, offset: 4357},
+							pos: position{line: 181, col: 6, offset: 5007},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 154, col: 6, offset: 4357},
+									pos:   position{line: 181, col: 6, offset: 5007},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 154, col: 12, offset: 4363},
+										pos:  position{line: 181, col: 12, offset: 5013},
 										name: "Function",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 154, col: 21, offset: 4372},
+									pos:   position{line: 181, col: 21, offset: 5022},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 154, col: 26, offset: 4377},
+										pos: position{line: 181, col: 26, offset: 5027},
 										expr: &ruleRefExpr{
-											pos:  position{line: 154, col: 27, offset: 4378},
+											pos:  position{line: 181, col: 27, offset: 5028},
 											name: "Deref",
 										},
 									},
@@ -1577,32 +1746,32 @@ var g = &grammar{
 		},
 		{
 			name: "SearchValue",
-			pos:  position{line: 158, col: 1, offset: 4450},
+			pos:  position{line: 185, col: 1, offset: 5100},
 			expr: &choiceExpr{
-				pos: position{line: 159, col: 5, offset: 4466},
+				pos: position{line: 186, col: 5, offset: 5116},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 159, col: 5, offset: 4466},
+						pos:  position{line: 186, col: 5, offset: 5116},
 						name: "Literal",
 					},
 					&actionExpr{
-						pos: position{line: 160, col: 5, offset: 4478},
+						pos: position{line: 187, col: 5, offset: 5128},
 						run: (*parser).callonSearchValue3,
 						expr: &seqExpr{
-							pos: position{line: 160, col: 5, offset: 4478},
+							pos: position{line: 187, col: 5, offset: 5128},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 160, col: 5, offset: 4478},
+									pos: position{line: 187, col: 5, offset: 5128},
 									expr: &ruleRefExpr{
-										pos:  position{line: 160, col: 6, offset: 4479},
+										pos:  position{line: 187, col: 6, offset: 5129},
 										name: "RegexpPattern",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 160, col: 20, offset: 4493},
+									pos:   position{line: 187, col: 20, offset: 5143},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 160, col: 22, offset: 4495},
+										pos:  position{line: 187, col: 22, offset: 5145},
 										name: "KeyWord",
 									},
 								},
@@ -1614,15 +1783,15 @@ var g = &grammar{
 		},
 		{
 			name: "Glob",
-			pos:  position{line: 164, col: 1, offset: 4603},
+			pos:  position{line: 191, col: 1, offset: 5253},
 			expr: &actionExpr{
-				pos: position{line: 165, col: 5, offset: 4612},
+				pos: position{line: 192, col: 5, offset: 5262},
 				run: (*parser).callonGlob1,
 				expr: &labeledExpr{
-					pos:   position{line: 165, col: 5, offset: 4612},
+					pos:   position{line: 192, col: 5, offset: 5262},
 					label: "pattern",
 					expr: &ruleRefExpr{
-						pos:  position{line: 165, col: 13, offset: 4620},
+						pos:  position{line: 192, col: 13, offset: 5270},
 						name: "GlobPattern",
 					},
 				},
@@ -1630,15 +1799,15 @@ var g = &grammar{
 		},
 		{
 			name: "Regexp",
-			pos:  position{line: 169, col: 1, offset: 4716},
+			pos:  position{line: 196, col: 1, offset: 5366},
 			expr: &actionExpr{
-				pos: position{line: 170, col: 5, offset: 4727},
+				pos: position{line: 197, col: 5, offset: 5377},
 				run: (*parser).callonRegexp1,
 				expr: &labeledExpr{
-					pos:   position{line: 170, col: 5, offset: 4727},
+					pos:   position{line: 197, col: 5, offset: 5377},
 					label: "pattern",
 					expr: &ruleRefExpr{
-						pos:  position{line: 170, col: 13, offset: 4735},
+						pos:  position{line: 197, col: 13, offset: 5385},
 						name: "RegexpPattern",
 					},
 				},
@@ -1646,36 +1815,36 @@ var g = &grammar{
 		},
 		{
 			name: "Aggregation",
-			pos:  position{line: 176, col: 1, offset: 4861},
+			pos:  position{line: 203, col: 1, offset: 5511},
 			expr: &choiceExpr{
-				pos: position{line: 177, col: 5, offset: 4877},
+				pos: position{line: 204, col: 5, offset: 5527},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 177, col: 5, offset: 4877},
+						pos: position{line: 204, col: 5, offset: 5527},
 						run: (*parser).callonAggregation2,
 						expr: &seqExpr{
-							pos: position{line: 177, col: 5, offset: 4877},
+							pos: position{line: 204, col: 5, offset: 5527},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 177, col: 5, offset: 4877},
+									pos: position{line: 204, col: 5, offset: 5527},
 									expr: &ruleRefExpr{
-										pos:  position{line: 177, col: 5, offset: 4877},
+										pos:  position{line: 204, col: 5, offset: 5527},
 										name: "Summarize",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 177, col: 16, offset: 4888},
+									pos:   position{line: 204, col: 16, offset: 5538},
 									label: "keys",
 									expr: &ruleRefExpr{
-										pos:  position{line: 177, col: 21, offset: 4893},
+										pos:  position{line: 204, col: 21, offset: 5543},
 										name: "GroupByKeys",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 177, col: 33, offset: 4905},
+									pos:   position{line: 204, col: 33, offset: 5555},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 177, col: 39, offset: 4911},
+										pos:  position{line: 204, col: 39, offset: 5561},
 										name: "LimitArg",
 									},
 								},
@@ -1683,40 +1852,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 180, col: 5, offset: 5037},
+						pos: position{line: 207, col: 5, offset: 5687},
 						run: (*parser).callonAggregation10,
 						expr: &seqExpr{
-							pos: position{line: 180, col: 5, offset: 5037},
+							pos: position{line: 207, col: 5, offset: 5687},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 180, col: 5, offset: 5037},
+									pos: position{line: 207, col: 5, offset: 5687},
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 5, offset: 5037},
+										pos:  position{line: 207, col: 5, offset: 5687},
 										name: "Summarize",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 180, col: 16, offset: 5048},
+									pos:   position{line: 207, col: 16, offset: 5698},
 									label: "aggs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 21, offset: 5053},
+										pos:  position{line: 207, col: 21, offset: 5703},
 										name: "AggAssignments",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 180, col: 36, offset: 5068},
+									pos:   position{line: 207, col: 36, offset: 5718},
 									label: "keys",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 180, col: 41, offset: 5073},
+										pos: position{line: 207, col: 41, offset: 5723},
 										expr: &seqExpr{
-											pos: position{line: 180, col: 42, offset: 5074},
+											pos: position{line: 207, col: 42, offset: 5724},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 180, col: 42, offset: 5074},
+													pos:  position{line: 207, col: 42, offset: 5724},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 180, col: 44, offset: 5076},
+													pos:  position{line: 207, col: 44, offset: 5726},
 													name: "GroupByKeys",
 												},
 											},
@@ -1724,10 +1893,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 180, col: 58, offset: 5090},
+									pos:   position{line: 207, col: 58, offset: 5740},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 64, offset: 5096},
+										pos:  position{line: 207, col: 64, offset: 5746},
 										name: "LimitArg",
 									},
 								},
@@ -1739,17 +1908,17 @@ var g = &grammar{
 		},
 		{
 			name: "Summarize",
-			pos:  position{line: 188, col: 1, offset: 5310},
+			pos:  position{line: 215, col: 1, offset: 5960},
 			expr: &seqExpr{
-				pos: position{line: 188, col: 13, offset: 5322},
+				pos: position{line: 215, col: 13, offset: 5972},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 188, col: 13, offset: 5322},
+						pos:        position{line: 215, col: 13, offset: 5972},
 						val:        "summarize",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 188, col: 25, offset: 5334},
+						pos:  position{line: 215, col: 25, offset: 5984},
 						name: "_",
 					},
 				},
@@ -1757,26 +1926,26 @@ var g = &grammar{
 		},
 		{
 			name: "GroupByKeys",
-			pos:  position{line: 190, col: 1, offset: 5337},
+			pos:  position{line: 217, col: 1, offset: 5987},
 			expr: &actionExpr{
-				pos: position{line: 191, col: 5, offset: 5353},
+				pos: position{line: 218, col: 5, offset: 6003},
 				run: (*parser).callonGroupByKeys1,
 				expr: &seqExpr{
-					pos: position{line: 191, col: 5, offset: 5353},
+					pos: position{line: 218, col: 5, offset: 6003},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 191, col: 5, offset: 5353},
+							pos:  position{line: 218, col: 5, offset: 6003},
 							name: "ByToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 191, col: 13, offset: 5361},
+							pos:  position{line: 218, col: 13, offset: 6011},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 191, col: 15, offset: 5363},
+							pos:   position{line: 218, col: 15, offset: 6013},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 191, col: 23, offset: 5371},
+								pos:  position{line: 218, col: 23, offset: 6021},
 								name: "FlexAssignments",
 							},
 						},
@@ -1786,43 +1955,43 @@ var g = &grammar{
 		},
 		{
 			name: "LimitArg",
-			pos:  position{line: 193, col: 1, offset: 5412},
+			pos:  position{line: 220, col: 1, offset: 6062},
 			expr: &choiceExpr{
-				pos: position{line: 194, col: 5, offset: 5425},
+				pos: position{line: 221, col: 5, offset: 6075},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 194, col: 5, offset: 5425},
+						pos: position{line: 221, col: 5, offset: 6075},
 						run: (*parser).callonLimitArg2,
 						expr: &seqExpr{
-							pos: position{line: 194, col: 5, offset: 5425},
+							pos: position{line: 221, col: 5, offset: 6075},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 194, col: 5, offset: 5425},
+									pos:  position{line: 221, col: 5, offset: 6075},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 194, col: 7, offset: 5427},
+									pos:        position{line: 221, col: 7, offset: 6077},
 									val:        "with",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 194, col: 14, offset: 5434},
+									pos:  position{line: 221, col: 14, offset: 6084},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 194, col: 16, offset: 5436},
+									pos:        position{line: 221, col: 16, offset: 6086},
 									val:        "-limit",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 194, col: 25, offset: 5445},
+									pos:  position{line: 221, col: 25, offset: 6095},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 194, col: 27, offset: 5447},
+									pos:   position{line: 221, col: 27, offset: 6097},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 194, col: 33, offset: 5453},
+										pos:  position{line: 221, col: 33, offset: 6103},
 										name: "UInt",
 									},
 								},
@@ -1830,10 +1999,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 195, col: 5, offset: 5484},
+						pos: position{line: 222, col: 5, offset: 6134},
 						run: (*parser).callonLimitArg11,
 						expr: &litMatcher{
-							pos:        position{line: 195, col: 5, offset: 5484},
+							pos:        position{line: 222, col: 5, offset: 6134},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -1843,22 +2012,22 @@ var g = &grammar{
 		},
 		{
 			name: "FlexAssignment",
-			pos:  position{line: 200, col: 1, offset: 5744},
+			pos:  position{line: 227, col: 1, offset: 6394},
 			expr: &choiceExpr{
-				pos: position{line: 201, col: 5, offset: 5763},
+				pos: position{line: 228, col: 5, offset: 6413},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 201, col: 5, offset: 5763},
+						pos:  position{line: 228, col: 5, offset: 6413},
 						name: "Assignment",
 					},
 					&actionExpr{
-						pos: position{line: 202, col: 5, offset: 5778},
+						pos: position{line: 229, col: 5, offset: 6428},
 						run: (*parser).callonFlexAssignment3,
 						expr: &labeledExpr{
-							pos:   position{line: 202, col: 5, offset: 5778},
+							pos:   position{line: 229, col: 5, offset: 6428},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 202, col: 10, offset: 5783},
+								pos:  position{line: 229, col: 10, offset: 6433},
 								name: "Expr",
 							},
 						},
@@ -1868,50 +2037,50 @@ var g = &grammar{
 		},
 		{
 			name: "FlexAssignments",
-			pos:  position{line: 204, col: 1, offset: 5875},
+			pos:  position{line: 231, col: 1, offset: 6525},
 			expr: &actionExpr{
-				pos: position{line: 205, col: 5, offset: 5895},
+				pos: position{line: 232, col: 5, offset: 6545},
 				run: (*parser).callonFlexAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 205, col: 5, offset: 5895},
+					pos: position{line: 232, col: 5, offset: 6545},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 205, col: 5, offset: 5895},
+							pos:   position{line: 232, col: 5, offset: 6545},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 205, col: 11, offset: 5901},
+								pos:  position{line: 232, col: 11, offset: 6551},
 								name: "FlexAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 205, col: 26, offset: 5916},
+							pos:   position{line: 232, col: 26, offset: 6566},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 205, col: 31, offset: 5921},
+								pos: position{line: 232, col: 31, offset: 6571},
 								expr: &actionExpr{
-									pos: position{line: 205, col: 32, offset: 5922},
+									pos: position{line: 232, col: 32, offset: 6572},
 									run: (*parser).callonFlexAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 205, col: 32, offset: 5922},
+										pos: position{line: 232, col: 32, offset: 6572},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 205, col: 32, offset: 5922},
+												pos:  position{line: 232, col: 32, offset: 6572},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 205, col: 35, offset: 5925},
+												pos:        position{line: 232, col: 35, offset: 6575},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 205, col: 39, offset: 5929},
+												pos:  position{line: 232, col: 39, offset: 6579},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 205, col: 42, offset: 5932},
+												pos:   position{line: 232, col: 42, offset: 6582},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 205, col: 47, offset: 5937},
+													pos:  position{line: 232, col: 47, offset: 6587},
 													name: "FlexAssignment",
 												},
 											},
@@ -1926,42 +2095,42 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignment",
-			pos:  position{line: 209, col: 1, offset: 6059},
+			pos:  position{line: 236, col: 1, offset: 6709},
 			expr: &choiceExpr{
-				pos: position{line: 210, col: 5, offset: 6077},
+				pos: position{line: 237, col: 5, offset: 6727},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 210, col: 5, offset: 6077},
+						pos: position{line: 237, col: 5, offset: 6727},
 						run: (*parser).callonAggAssignment2,
 						expr: &seqExpr{
-							pos: position{line: 210, col: 5, offset: 6077},
+							pos: position{line: 237, col: 5, offset: 6727},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 210, col: 5, offset: 6077},
+									pos:   position{line: 237, col: 5, offset: 6727},
 									label: "lval",
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 10, offset: 6082},
+										pos:  position{line: 237, col: 10, offset: 6732},
 										name: "Lval",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 210, col: 15, offset: 6087},
+									pos:  position{line: 237, col: 15, offset: 6737},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 210, col: 18, offset: 6090},
+									pos:        position{line: 237, col: 18, offset: 6740},
 									val:        ":=",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 210, col: 23, offset: 6095},
+									pos:  position{line: 237, col: 23, offset: 6745},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 210, col: 26, offset: 6098},
+									pos:   position{line: 237, col: 26, offset: 6748},
 									label: "agg",
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 30, offset: 6102},
+										pos:  position{line: 237, col: 30, offset: 6752},
 										name: "Agg",
 									},
 								},
@@ -1969,13 +2138,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 213, col: 5, offset: 6206},
+						pos: position{line: 240, col: 5, offset: 6856},
 						run: (*parser).callonAggAssignment11,
 						expr: &labeledExpr{
-							pos:   position{line: 213, col: 5, offset: 6206},
+							pos:   position{line: 240, col: 5, offset: 6856},
 							label: "agg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 213, col: 9, offset: 6210},
+								pos:  position{line: 240, col: 9, offset: 6860},
 								name: "Agg",
 							},
 						},
@@ -1985,81 +2154,89 @@ var g = &grammar{
 		},
 		{
 			name: "Agg",
-			pos:  position{line: 217, col: 1, offset: 6310},
+			pos:  position{line: 244, col: 1, offset: 6960},
 			expr: &actionExpr{
-				pos: position{line: 218, col: 5, offset: 6318},
+				pos: position{line: 245, col: 5, offset: 6968},
 				run: (*parser).callonAgg1,
 				expr: &seqExpr{
-					pos: position{line: 218, col: 5, offset: 6318},
+					pos: position{line: 245, col: 5, offset: 6968},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 218, col: 5, offset: 6318},
+							pos: position{line: 245, col: 5, offset: 6968},
 							expr: &ruleRefExpr{
-								pos:  position{line: 218, col: 6, offset: 6319},
+								pos:  position{line: 245, col: 6, offset: 6969},
 								name: "FuncGuard",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 16, offset: 6329},
+							pos:   position{line: 245, col: 16, offset: 6979},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 218, col: 19, offset: 6332},
+								pos:  position{line: 245, col: 19, offset: 6982},
 								name: "AggName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 27, offset: 6340},
+							pos:  position{line: 245, col: 27, offset: 6990},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 218, col: 30, offset: 6343},
+							pos:        position{line: 245, col: 30, offset: 6993},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 34, offset: 6347},
+							pos:  position{line: 245, col: 34, offset: 6997},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 37, offset: 6350},
+							pos:   position{line: 245, col: 37, offset: 7000},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 218, col: 42, offset: 6355},
+								pos: position{line: 245, col: 42, offset: 7005},
 								expr: &choiceExpr{
-									pos: position{line: 218, col: 43, offset: 6356},
+									pos: position{line: 245, col: 43, offset: 7006},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 218, col: 43, offset: 6356},
+											pos:  position{line: 245, col: 43, offset: 7006},
 											name: "OverExpr",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 218, col: 54, offset: 6367},
+											pos:  position{line: 245, col: 54, offset: 7017},
 											name: "Expr",
 										},
 									},
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 245, col: 61, offset: 7024},
+							label: "params",
+							expr: &ruleRefExpr{
+								pos:  position{line: 245, col: 68, offset: 7031},
+								name: "AggParams",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 62, offset: 6375},
+							pos:  position{line: 245, col: 78, offset: 7041},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 218, col: 65, offset: 6378},
+							pos:        position{line: 245, col: 81, offset: 7044},
 							val:        ")",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 218, col: 69, offset: 6382},
+							pos: position{line: 245, col: 85, offset: 7048},
 							expr: &seqExpr{
-								pos: position{line: 218, col: 71, offset: 6384},
+								pos: position{line: 245, col: 87, offset: 7050},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 218, col: 71, offset: 6384},
+										pos:  position{line: 245, col: 87, offset: 7050},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 218, col: 74, offset: 6387},
+										pos:        position{line: 245, col: 90, offset: 7053},
 										val:        ".",
 										ignoreCase: false,
 									},
@@ -2067,12 +2244,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 79, offset: 6392},
+							pos:   position{line: 245, col: 95, offset: 7058},
 							label: "where",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 218, col: 85, offset: 6398},
+								pos: position{line: 245, col: 101, offset: 7064},
 								expr: &ruleRefExpr{
-									pos:  position{line: 218, col: 85, offset: 6398},
+									pos:  position{line: 245, col: 101, offset: 7064},
 									name: "WhereClause",
 								},
 							},
@@ -2081,22 +2258,59 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "AggParams",
+			pos:  position{line: 256, col: 1, offset: 7336},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 256, col: 13, offset: 7348},
+				expr: &actionExpr{
+					pos: position{line: 256, col: 14, offset: 7349},
+					run: (*parser).callonAggParams2,
+					expr: &seqExpr{
+						pos: position{line: 256, col: 14, offset: 7349},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 256, col: 14, offset: 7349},
+								name: "__",
+							},
+							&litMatcher{
+								pos:        position{line: 256, col: 17, offset: 7352},
+								val:        ",",
+								ignoreCase: false,
+							},
+							&ruleRefExpr{
+								pos:  position{line: 256, col: 21, offset: 7356},
+								name: "__",
+							},
+							&labeledExpr{
+								pos:   position{line: 256, col: 24, offset: 7359},
+								label: "e",
+								expr: &ruleRefExpr{
+									pos:  position{line: 256, col: 26, offset: 7361},
+									name: "Expr",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "AggName",
-			pos:  position{line: 226, col: 1, offset: 6588},
+			pos:  position{line: 258, col: 1, offset: 7387},
 			expr: &choiceExpr{
-				pos: position{line: 227, col: 5, offset: 6600},
+				pos: position{line: 259, col: 5, offset: 7399},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 227, col: 5, offset: 6600},
+						pos:  position{line: 259, col: 5, offset: 7399},
 						name: "IdentifierName",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 228, col: 5, offset: 6619},
+						pos:  position{line: 260, col: 5, offset: 7418},
 						name: "AndToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 229, col: 5, offset: 6632},
+						pos:  position{line: 261, col: 5, offset: 7431},
 						name: "OrToken",
 					},
 				},
@@ -2104,31 +2318,31 @@ var g = &grammar{
 		},
 		{
 			name: "WhereClause",
-			pos:  position{line: 231, col: 1, offset: 6641},
+			pos:  position{line: 263, col: 1, offset: 7440},
 			expr: &actionExpr{
-				pos: position{line: 231, col: 15, offset: 6655},
+				pos: position{line: 263, col: 15, offset: 7454},
 				run: (*parser).callonWhereClause1,
 				expr: &seqExpr{
-					pos: position{line: 231, col: 15, offset: 6655},
+					pos: position{line: 263, col: 15, offset: 7454},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 231, col: 15, offset: 6655},
+							pos:  position{line: 263, col: 15, offset: 7454},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 231, col: 17, offset: 6657},
+							pos:        position{line: 263, col: 17, offset: 7456},
 							val:        "where",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 231, col: 25, offset: 6665},
+							pos:  position{line: 263, col: 25, offset: 7464},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 231, col: 27, offset: 6667},
+							pos:   position{line: 263, col: 27, offset: 7466},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 231, col: 32, offset: 6672},
+								pos:  position{line: 263, col: 32, offset: 7471},
 								name: "LogicalOrExpr",
 							},
 						},
@@ -2138,44 +2352,44 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignments",
-			pos:  position{line: 233, col: 1, offset: 6708},
+			pos:  position{line: 265, col: 1, offset: 7507},
 			expr: &actionExpr{
-				pos: position{line: 234, col: 5, offset: 6727},
+				pos: position{line: 266, col: 5, offset: 7526},
 				run: (*parser).callonAggAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 234, col: 5, offset: 6727},
+					pos: position{line: 266, col: 5, offset: 7526},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 234, col: 5, offset: 6727},
+							pos:   position{line: 266, col: 5, offset: 7526},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 234, col: 11, offset: 6733},
+								pos:  position{line: 266, col: 11, offset: 7532},
 								name: "AggAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 234, col: 25, offset: 6747},
+							pos:   position{line: 266, col: 25, offset: 7546},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 234, col: 30, offset: 6752},
+								pos: position{line: 266, col: 30, offset: 7551},
 								expr: &seqExpr{
-									pos: position{line: 234, col: 31, offset: 6753},
+									pos: position{line: 266, col: 31, offset: 7552},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 234, col: 31, offset: 6753},
+											pos:  position{line: 266, col: 31, offset: 7552},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 234, col: 34, offset: 6756},
+											pos:        position{line: 266, col: 34, offset: 7555},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 234, col: 38, offset: 6760},
+											pos:  position{line: 266, col: 38, offset: 7559},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 234, col: 41, offset: 6763},
+											pos:  position{line: 266, col: 41, offset: 7562},
 											name: "AggAssignment",
 										},
 									},
@@ -2188,96 +2402,100 @@ var g = &grammar{
 		},
 		{
 			name: "Operator",
-			pos:  position{line: 244, col: 1, offset: 6987},
+			pos:  position{line: 276, col: 1, offset: 7786},
 			expr: &choiceExpr{
-				pos: position{line: 245, col: 5, offset: 7000},
+				pos: position{line: 277, col: 5, offset: 7799},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 245, col: 5, offset: 7000},
+						pos:  position{line: 277, col: 5, offset: 7799},
 						name: "AssertOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 246, col: 5, offset: 7013},
+						pos:  position{line: 278, col: 5, offset: 7812},
 						name: "SortOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 247, col: 5, offset: 7024},
+						pos:  position{line: 279, col: 5, offset: 7823},
 						name: "TopOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 248, col: 5, offset: 7034},
+						pos:  position{line: 280, col: 5, offset: 7833},
 						name: "CutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 249, col: 5, offset: 7044},
+						pos:  position{line: 281, col: 5, offset: 7843},
 						name: "DropOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 250, col: 5, offset: 7055},
+						pos:  position{line: 282, col: 5, offset: 7854},
 						name: "HeadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 251, col: 5, offset: 7066},
+						pos:  position{line: 283, col: 5, offset: 7865},
 						name: "TailOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 252, col: 5, offset: 7077},
+						pos:  position{line: 284, col: 5, offset: 7876},
 						name: "WhereOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 253, col: 5, offset: 7089},
+						pos:  position{line: 285, col: 5, offset: 7888},
 						name: "UniqOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 254, col: 5, offset: 7100},
+						pos:  position{line: 286, col: 5, offset: 7899},
 						name: "PutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 255, col: 5, offset: 7110},
+						pos:  position{line: 287, col: 5, offset: 7909},
 						name: "RenameOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 256, col: 5, offset: 7123},
+						pos:  position{line: 288, col: 5, offset: 7922},
 						name: "FuseOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 257, col: 5, offset: 7134},
+						pos:  position{line: 289, col: 5, offset: 7933},
 						name: "ShapeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 258, col: 5, offset: 7146},
+						pos:  position{line: 290, col: 5, offset: 7945},
 						name: "JoinOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 259, col: 5, offset: 7157},
+						pos:  position{line: 291, col: 5, offset: 7956},
 						name: "SampleOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 260, col: 5, offset: 7170},
+						pos:  position{line: 292, col: 5, offset: 7969},
 						name: "SQLOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 261, col: 5, offset: 7180},
+						pos:  position{line: 293, col: 5, offset: 7979},
 						name: "FromOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 262, col: 5, offset: 7191},
+						pos:  position{line: 294, col: 5, offset: 7990},
 						name: "PassOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 263, col: 5, offset: 7202},
+						pos:  position{line: 295, col: 5, offset: 8001},
 						name: "ExplodeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 264, col: 5, offset: 7216},
+						pos:  position{line: 296, col: 5, offset: 8015},
 						name: "MergeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 265, col: 5, offset: 7228},
+						pos:  position{line: 297, col: 5, offset: 8027},
 						name: "OverOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 266, col: 5, offset: 7239},
+						pos:  position{line: 298, col: 5, offset: 8038},
+						name: "WindowOp",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 299, col: 5, offset: 8051},
 						name: "YieldOp",
 					},
 				},
@@ -2285,33 +2503,33 @@ var g = &grammar{
 		},
 		{
 			name: "AssertOp",
-			pos:  position{line: 268, col: 1, offset: 7248},
+			pos:  position{line: 301, col: 1, offset: 8060},
 			expr: &actionExpr{
-				pos: position{line: 269, col: 5, offset: 7261},
+				pos: position{line: 302, col: 5, offset: 8073},
 				run: (*parser).callonAssertOp1,
 				expr: &seqExpr{
-					pos: position{line: 269, col: 5, offset: 7261},
+					pos: position{line: 302, col: 5, offset: 8073},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 269, col: 5, offset: 7261},
+							pos:        position{line: 302, col: 5, offset: 8073},
 							val:        "assert",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 269, col: 14, offset: 7270},
+							pos:  position{line: 302, col: 14, offset: 8082},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 269, col: 16, offset: 7272},
+							pos:   position{line: 302, col: 16, offset: 8084},
 							label: "expr",
 							expr: &actionExpr{
-								pos: position{line: 269, col: 22, offset: 7278},
+								pos: position{line: 302, col: 22, offset: 8090},
 								run: (*parser).callonAssertOp6,
 								expr: &labeledExpr{
-									pos:   position{line: 269, col: 22, offset: 7278},
+									pos:   position{line: 302, col: 22, offset: 8090},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 269, col: 24, offset: 7280},
+										pos:  position{line: 302, col: 24, offset: 8092},
 										name: "Expr",
 									},
 								},
@@ -2323,53 +2541,53 @@ var g = &grammar{
 		},
 		{
 			name: "SortOp",
-			pos:  position{line: 305, col: 1, offset: 8631},
+			pos:  position{line: 338, col: 1, offset: 9443},
 			expr: &actionExpr{
-				pos: position{line: 306, col: 5, offset: 8642},
+				pos: position{line: 339, col: 5, offset: 9454},
 				run: (*parser).callonSortOp1,
 				expr: &seqExpr{
-					pos: position{line: 306, col: 5, offset: 8642},
+					pos: position{line: 339, col: 5, offset: 9454},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 306, col: 5, offset: 8642},
+							pos:        position{line: 339, col: 5, offset: 9454},
 							val:        "sort",
 							ignoreCase: false,
 						},
 						&andExpr{
-							pos: position{line: 306, col: 12, offset: 8649},
+							pos: position{line: 339, col: 12, offset: 9461},
 							expr: &ruleRefExpr{
-								pos:  position{line: 306, col: 13, offset: 8650},
+								pos:  position{line: 339, col: 13, offset: 9462},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 306, col: 18, offset: 8655},
+							pos:   position{line: 339, col: 18, offset: 9467},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 306, col: 23, offset: 8660},
+								pos:  position{line: 339, col: 23, offset: 9472},
 								name: "SortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 306, col: 32, offset: 8669},
+							pos:   position{line: 339, col: 32, offset: 9481},
 							label: "list",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 306, col: 37, offset: 8674},
+								pos: position{line: 339, col: 37, offset: 9486},
 								expr: &actionExpr{
-									pos: position{line: 306, col: 38, offset: 8675},
+									pos: position{line: 339, col: 38, offset: 9487},
 									run: (*parser).callonSortOp10,
 									expr: &seqExpr{
-										pos: position{line: 306, col: 38, offset: 8675},
+										pos: position{line: 339, col: 38, offset: 9487},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 306, col: 38, offset: 8675},
+												pos:  position{line: 339, col: 38, offset: 9487},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 306, col: 40, offset: 8677},
+												pos:   position{line: 339, col: 40, offset: 9489},
 												label: "l",
 												expr: &ruleRefExpr{
-													pos:  position{line: 306, col: 42, offset: 8679},
+													pos:  position{line: 339, col: 42, offset: 9491},
 													name: "Exprs",
 												},
 											},
@@ -2384,30 +2602,30 @@ var g = &grammar{
 		},
 		{
 			name: "SortArgs",
-			pos:  position{line: 320, col: 1, offset: 9090},
+			pos:  position{line: 353, col: 1, offset: 9902},
 			expr: &actionExpr{
-				pos: position{line: 320, col: 12, offset: 9101},
+				pos: position{line: 353, col: 12, offset: 9913},
 				run: (*parser).callonSortArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 320, col: 12, offset: 9101},
+					pos:   position{line: 353, col: 12, offset: 9913},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 320, col: 17, offset: 9106},
+						pos: position{line: 353, col: 17, offset: 9918},
 						expr: &actionExpr{
-							pos: position{line: 320, col: 18, offset: 9107},
+							pos: position{line: 353, col: 18, offset: 9919},
 							run: (*parser).callonSortArgs4,
 							expr: &seqExpr{
-								pos: position{line: 320, col: 18, offset: 9107},
+								pos: position{line: 353, col: 18, offset: 9919},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 320, col: 18, offset: 9107},
+										pos:  position{line: 353, col: 18, offset: 9919},
 										name: "_",
 									},
 									&labeledExpr{
-										pos:   position{line: 320, col: 20, offset: 9109},
+										pos:   position{line: 353, col: 20, offset: 9921},
 										label: "a",
 										expr: &ruleRefExpr{
-											pos:  position{line: 320, col: 22, offset: 9111},
+											pos:  position{line: 353, col: 22, offset: 9923},
 											name: "SortArg",
 										},
 									},
@@ -2420,50 +2638,50 @@ var g = &grammar{
 		},
 		{
 			name: "SortArg",
-			pos:  position{line: 322, col: 1, offset: 9167},
+			pos:  position{line: 355, col: 1, offset: 9979},
 			expr: &choiceExpr{
-				pos: position{line: 323, col: 5, offset: 9179},
+				pos: position{line: 356, col: 5, offset: 9991},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 323, col: 5, offset: 9179},
+						pos: position{line: 356, col: 5, offset: 9991},
 						run: (*parser).callonSortArg2,
 						expr: &litMatcher{
-							pos:        position{line: 323, col: 5, offset: 9179},
+							pos:        position{line: 356, col: 5, offset: 9991},
 							val:        "-r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 324, col: 5, offset: 9254},
+						pos: position{line: 357, col: 5, offset: 10066},
 						run: (*parser).callonSortArg4,
 						expr: &seqExpr{
-							pos: position{line: 324, col: 5, offset: 9254},
+							pos: position{line: 357, col: 5, offset: 10066},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 324, col: 5, offset: 9254},
+									pos:        position{line: 357, col: 5, offset: 10066},
 									val:        "-nulls",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 324, col: 14, offset: 9263},
+									pos:  position{line: 357, col: 14, offset: 10075},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 324, col: 16, offset: 9265},
+									pos:   position{line: 357, col: 16, offset: 10077},
 									label: "where",
 									expr: &actionExpr{
-										pos: position{line: 324, col: 23, offset: 9272},
+										pos: position{line: 357, col: 23, offset: 10084},
 										run: (*parser).callonSortArg9,
 										expr: &choiceExpr{
-											pos: position{line: 324, col: 24, offset: 9273},
+											pos: position{line: 357, col: 24, offset: 10085},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 324, col: 24, offset: 9273},
+													pos:        position{line: 357, col: 24, offset: 10085},
 													val:        "first",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 324, col: 34, offset: 9283},
+													pos:        position{line: 357, col: 34, offset: 10095},
 													val:        "last",
 													ignoreCase: false,
 												},
@@ -2479,45 +2697,45 @@ var g = &grammar{
 		},
 		{
 			name: "TopOp",
-			pos:  position{line: 326, col: 1, offset: 9397},
+			pos:  position{line: 359, col: 1, offset: 10209},
 			expr: &actionExpr{
-				pos: position{line: 327, col: 5, offset: 9407},
+				pos: position{line: 360, col: 5, offset: 10219},
 				run: (*parser).callonTopOp1,
 				expr: &seqExpr{
-					pos: position{line: 327, col: 5, offset: 9407},
+					pos: position{line: 360, col: 5, offset: 10219},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 327, col: 5, offset: 9407},
+							pos:        position{line: 360, col: 5, offset: 10219},
 							val:        "top",
 							ignoreCase: false,
 						},
 						&andExpr{
-							pos: position{line: 327, col: 11, offset: 9413},
+							pos: position{line: 360, col: 11, offset: 10225},
 							expr: &ruleRefExpr{
-								pos:  position{line: 327, col: 12, offset: 9414},
+								pos:  position{line: 360, col: 12, offset: 10226},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 327, col: 17, offset: 9419},
+							pos:   position{line: 360, col: 17, offset: 10231},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 327, col: 23, offset: 9425},
+								pos: position{line: 360, col: 23, offset: 10237},
 								expr: &actionExpr{
-									pos: position{line: 327, col: 24, offset: 9426},
+									pos: position{line: 360, col: 24, offset: 10238},
 									run: (*parser).callonTopOp8,
 									expr: &seqExpr{
-										pos: position{line: 327, col: 24, offset: 9426},
+										pos: position{line: 360, col: 24, offset: 10238},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 327, col: 24, offset: 9426},
+												pos:  position{line: 360, col: 24, offset: 10238},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 327, col: 26, offset: 9428},
+												pos:   position{line: 360, col: 26, offset: 10240},
 												label: "n",
 												expr: &ruleRefExpr{
-													pos:  position{line: 327, col: 28, offset: 9430},
+													pos:  position{line: 360, col: 28, offset: 10242},
 													name: "UInt",
 												},
 											},
@@ -2527,19 +2745,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 327, col: 52, offset: 9454},
+							pos:   position{line: 360, col: 52, offset: 10266},
 							label: "flush",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 327, col: 58, offset: 9460},
+								pos: position{line: 360, col: 58, offset: 10272},
 								expr: &seqExpr{
-									pos: position{line: 327, col: 59, offset: 9461},
+									pos: position{line: 360, col: 59, offset: 10273},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 327, col: 59, offset: 9461},
+											pos:  position{line: 360, col: 59, offset: 10273},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 327, col: 61, offset: 9463},
+											pos:        position{line: 360, col: 61, offset: 10275},
 											val:        "-flush",
 											ignoreCase: false,
 										},
@@ -2548,25 +2766,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 327, col: 72, offset: 9474},
+							pos:   position{line: 360, col: 72, offset: 10286},
 							label: "fields",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 327, col: 79, offset: 9481},
+								pos: position{line: 360, col: 79, offset: 10293},
 								expr: &actionExpr{
-									pos: position{line: 327, col: 80, offset: 9482},
+									pos: position{line: 360, col: 80, offset: 10294},
 									run: (*parser).callonTopOp20,
 									expr: &seqExpr{
-										pos: position{line: 327, col: 80, offset: 9482},
+										pos: position{line: 360, col: 80, offset: 10294},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 327, col: 80, offset: 9482},
+												pos:  position{line: 360, col: 80, offset: 10294},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 327, col: 82, offset: 9484},
+												pos:   position{line: 360, col: 82, offset: 10296},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 327, col: 84, offset: 9486},
+													pos:  position{line: 360, col: 84, offset: 10298},
 													name: "FieldExprs",
 												},
 											},
@@ -2581,27 +2799,27 @@ var g = &grammar{
 		},
 		{
 			name: "CutOp",
-			pos:  position{line: 341, col: 1, offset: 9821},
+			pos:  position{line: 374, col: 1, offset: 10633},
 			expr: &actionExpr{
-				pos: position{line: 342, col: 5, offset: 9831},
+				pos: position{line: 375, col: 5, offset: 10643},
 				run: (*parser).callonCutOp1,
 				expr: &seqExpr{
-					pos: position{line: 342, col: 5, offset: 9831},
+					pos: position{line: 375, col: 5, offset: 10643},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 342, col: 5, offset: 9831},
+							pos:        position{line: 375, col: 5, offset: 10643},
 							val:        "cut",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 342, col: 11, offset: 9837},
+							pos:  position{line: 375, col: 11, offset: 10649},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 342, col: 13, offset: 9839},
+							pos:   position{line: 375, col: 13, offset: 10651},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 342, col: 18, offset: 9844},
+								pos:  position{line: 375, col: 18, offset: 10656},
 								name: "FlexAssignments",
 							},
 						},
@@ -2611,27 +2829,27 @@ var g = &grammar{
 		},
 		{
 			name: "DropOp",
-			pos:  position{line: 346, col: 1, offset: 9939},
+			pos:  position{line: 379, col: 1, offset: 10751},
 			expr: &actionExpr{
-				pos: position{line: 347, col: 5, offset: 9950},
+				pos: position{line: 380, col: 5, offset: 10762},
 				run: (*parser).callonDropOp1,
 				expr: &seqExpr{
-					pos: position{line: 347, col: 5, offset: 9950},
+					pos: position{line: 380, col: 5, offset: 10762},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 347, col: 5, offset: 9950},
+							pos:        position{line: 380, col: 5, offset: 10762},
 							val:        "drop",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 347, col: 12, offset: 9957},
+							pos:  position{line: 380, col: 12, offset: 10769},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 14, offset: 9959},
+							pos:   position{line: 380, col: 14, offset: 10771},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 347, col: 19, offset: 9964},
+								pos:  position{line: 380, col: 19, offset: 10776},
 								name: "FieldExprs",
 							},
 						},
@@ -2641,30 +2859,30 @@ var g = &grammar{
 		},
 		{
 			name: "HeadOp",
-			pos:  position{line: 351, col: 1, offset: 10055},
+			pos:  position{line: 384, col: 1, offset: 10867},
 			expr: &choiceExpr{
-				pos: position{line: 352, col: 5, offset: 10066},
+				pos: position{line: 385, col: 5, offset: 10878},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 352, col: 5, offset: 10066},
+						pos: position{line: 385, col: 5, offset: 10878},
 						run: (*parser).callonHeadOp2,
 						expr: &seqExpr{
-							pos: position{line: 352, col: 5, offset: 10066},
+							pos: position{line: 385, col: 5, offset: 10878},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 352, col: 5, offset: 10066},
+									pos:        position{line: 385, col: 5, offset: 10878},
 									val:        "head",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 352, col: 12, offset: 10073},
+									pos:  position{line: 385, col: 12, offset: 10885},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 352, col: 14, offset: 10075},
+									pos:   position{line: 385, col: 14, offset: 10887},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 352, col: 20, offset: 10081},
+										pos:  position{line: 385, col: 20, offset: 10893},
 										name: "UInt",
 									},
 								},
@@ -2672,10 +2890,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 353, col: 5, offset: 10161},
+						pos: position{line: 386, col: 5, offset: 10973},
 						run: (*parser).callonHeadOp8,
 						expr: &litMatcher{
-							pos:        position{line: 353, col: 5, offset: 10161},
+							pos:        position{line: 386, col: 5, offset: 10973},
 							val:        "head",
 							ignoreCase: false,
 						},
@@ -2685,30 +2903,30 @@ var g = &grammar{
 		},
 		{
 			name: "TailOp",
-			pos:  position{line: 355, col: 1, offset: 10236},
+			pos:  position{line: 388, col: 1, offset: 11048},
 			expr: &choiceExpr{
-				pos: position{line: 356, col: 5, offset: 10247},
+				pos: position{line: 389, col: 5, offset: 11059},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 356, col: 5, offset: 10247},
+						pos: position{line: 389, col: 5, offset: 11059},
 						run: (*parser).callonTailOp2,
 						expr: &seqExpr{
-							pos: position{line: 356, col: 5, offset: 10247},
+							pos: position{line: 389, col: 5, offset: 11059},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 356, col: 5, offset: 10247},
+									pos:        position{line: 389, col: 5, offset: 11059},
 									val:        "tail",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 356, col: 12, offset: 10254},
+									pos:  position{line: 389, col: 12, offset: 11066},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 356, col: 14, offset: 10256},
+									pos:   position{line: 389, col: 14, offset: 11068},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 356, col: 20, offset: 10262},
+										pos:  position{line: 389, col: 20, offset: 11074},
 										name: "UInt",
 									},
 								},
@@ -2716,10 +2934,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 357, col: 5, offset: 10342},
+						pos: position{line: 390, col: 5, offset: 11154},
 						run: (*parser).callonTailOp8,
 						expr: &litMatcher{
-							pos:        position{line: 357, col: 5, offset: 10342},
+							pos:        position{line: 390, col: 5, offset: 11154},
 							val:        "tail",
 							ignoreCase: false,
 						},
@@ -2729,27 +2947,27 @@ var g = &grammar{
 		},
 		{
 			name: "WhereOp",
-			pos:  position{line: 359, col: 1, offset: 10417},
+			pos:  position{line: 392, col: 1, offset: 11229},
 			expr: &actionExpr{
-				pos: position{line: 360, col: 5, offset: 10429},
+				pos: position{line: 393, col: 5, offset: 11241},
 				run: (*parser).callonWhereOp1,
 				expr: &seqExpr{
-					pos: position{line: 360, col: 5, offset: 10429},
+					pos: position{line: 393, col: 5, offset: 11241},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 360, col: 5, offset: 10429},
+							pos:        position{line: 393, col: 5, offset: 11241},
 							val:        "where",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 360, col: 13, offset: 10437},
+							pos:  position{line: 393, col: 13, offset: 11249},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 360, col: 15, offset: 10439},
+							pos:   position{line: 393, col: 15, offset: 11251},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 360, col: 20, offset: 10444},
+								pos:  position{line: 393, col: 20, offset: 11256},
 								name: "Expr",
 							},
 						},
@@ -2759,27 +2977,27 @@ var g = &grammar{
 		},
 		{
 			name: "UniqOp",
-			pos:  position{line: 364, col: 1, offset: 10530},
+			pos:  position{line: 397, col: 1, offset: 11342},
 			expr: &choiceExpr{
-				pos: position{line: 365, col: 5, offset: 10541},
+				pos: position{line: 398, col: 5, offset: 11353},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 365, col: 5, offset: 10541},
+						pos: position{line: 398, col: 5, offset: 11353},
 						run: (*parser).callonUniqOp2,
 						expr: &seqExpr{
-							pos: position{line: 365, col: 5, offset: 10541},
+							pos: position{line: 398, col: 5, offset: 11353},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 365, col: 5, offset: 10541},
+									pos:        position{line: 398, col: 5, offset: 11353},
 									val:        "uniq",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 365, col: 12, offset: 10548},
+									pos:  position{line: 398, col: 12, offset: 11360},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 365, col: 14, offset: 10550},
+									pos:        position{line: 398, col: 14, offset: 11362},
 									val:        "-c",
 									ignoreCase: false,
 								},
@@ -2787,10 +3005,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 368, col: 5, offset: 10639},
+						pos: position{line: 401, col: 5, offset: 11451},
 						run: (*parser).callonUniqOp7,
 						expr: &litMatcher{
-							pos:        position{line: 368, col: 5, offset: 10639},
+							pos:        position{line: 401, col: 5, offset: 11451},
 							val:        "uniq",
 							ignoreCase: false,
 						},
@@ -2800,27 +3018,27 @@ var g = &grammar{
 		},
 		{
 			name: "PutOp",
-			pos:  position{line: 372, col: 1, offset: 10728},
+			pos:  position{line: 405, col: 1, offset: 11540},
 			expr: &actionExpr{
-				pos: position{line: 373, col: 5, offset: 10738},
+				pos: position{line: 406, col: 5, offset: 11550},
 				run: (*parser).callonPutOp1,
 				expr: &seqExpr{
-					pos: position{line: 373, col: 5, offset: 10738},
+					pos: position{line: 406, col: 5, offset: 11550},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 373, col: 5, offset: 10738},
+							pos:        position{line: 406, col: 5, offset: 11550},
 							val:        "put",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 373, col: 11, offset: 10744},
+							pos:  position{line: 406, col: 11, offset: 11556},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 373, col: 13, offset: 10746},
+							pos:   position{line: 406, col: 13, offset: 11558},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 373, col: 18, offset: 10751},
+								pos:  position{line: 406, col: 18, offset: 11563},
 								name: "Assignments",
 							},
 						},
@@ -2830,59 +3048,59 @@ var g = &grammar{
 		},
 		{
 			name: "RenameOp",
-			pos:  position{line: 377, col: 1, offset: 10842},
+			pos:  position{line: 410, col: 1, offset: 11654},
 			expr: &actionExpr{
-				pos: position{line: 378, col: 5, offset: 10855},
+				pos: position{line: 411, col: 5, offset: 11667},
 				run: (*parser).callonRenameOp1,
 				expr: &seqExpr{
-					pos: position{line: 378, col: 5, offset: 10855},
+					pos: position{line: 411, col: 5, offset: 11667},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 378, col: 5, offset: 10855},
+							pos:        position{line: 411, col: 5, offset: 11667},
 							val:        "rename",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 378, col: 14, offset: 10864},
+							pos:  position{line: 411, col: 14, offset: 11676},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 378, col: 16, offset: 10866},
+							pos:   position{line: 411, col: 16, offset: 11678},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 378, col: 22, offset: 10872},
+								pos:  position{line: 411, col: 22, offset: 11684},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 378, col: 33, offset: 10883},
+							pos:   position{line: 411, col: 33, offset: 11695},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 378, col: 38, offset: 10888},
+								pos: position{line: 411, col: 38, offset: 11700},
 								expr: &actionExpr{
-									pos: position{line: 378, col: 39, offset: 10889},
+									pos: position{line: 411, col: 39, offset: 11701},
 									run: (*parser).callonRenameOp9,
 									expr: &seqExpr{
-										pos: position{line: 378, col: 39, offset: 10889},
+										pos: position{line: 411, col: 39, offset: 11701},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 378, col: 39, offset: 10889},
+												pos:  position{line: 411, col: 39, offset: 11701},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 378, col: 42, offset: 10892},
+												pos:        position{line: 411, col: 42, offset: 11704},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 378, col: 46, offset: 10896},
+												pos:  position{line: 411, col: 46, offset: 11708},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 378, col: 49, offset: 10899},
+												pos:   position{line: 411, col: 49, offset: 11711},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 378, col: 52, offset: 10902},
+													pos:  position{line: 411, col: 52, offset: 11714},
 													name: "Assignment",
 												},
 											},
@@ -2897,29 +3115,29 @@ var g = &grammar{
 		},
 		{
 			name: "FuseOp",
-			pos:  position{line: 386, col: 1, offset: 11309},
+			pos:  position{line: 419, col: 1, offset: 12121},
 			expr: &actionExpr{
-				pos: position{line: 387, col: 5, offset: 11320},
+				pos: position{line: 420, col: 5, offset: 12132},
 				run: (*parser).callonFuseOp1,
 				expr: &seqExpr{
-					pos: position{line: 387, col: 5, offset: 11320},
+					pos: position{line: 420, col: 5, offset: 12132},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 387, col: 5, offset: 11320},
+							pos:        position{line: 420, col: 5, offset: 12132},
 							val:        "fuse",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 387, col: 12, offset: 11327},
+							pos: position{line: 420, col: 12, offset: 12139},
 							expr: &seqExpr{
-								pos: position{line: 387, col: 14, offset: 11329},
+								pos: position{line: 420, col: 14, offset: 12141},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 387, col: 14, offset: 11329},
+										pos:  position{line: 420, col: 14, offset: 12141},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 387, col: 17, offset: 11332},
+										pos:        position{line: 420, col: 17, offset: 12144},
 										val:        "(",
 										ignoreCase: false,
 									},
@@ -2927,9 +3145,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 387, col: 22, offset: 11337},
+							pos: position{line: 420, col: 22, offset: 12149},
 							expr: &ruleRefExpr{
-								pos:  position{line: 387, col: 23, offset: 11338},
+								pos:  position{line: 420, col: 23, offset: 12150},
 								name: "EOKW",
 							},
 						},
@@ -2939,29 +3157,29 @@ var g = &grammar{
 		},
 		{
 			name: "ShapeOp",
-			pos:  position{line: 391, col: 1, offset: 11409},
+			pos:  position{line: 424, col: 1, offset: 12221},
 			expr: &actionExpr{
-				pos: position{line: 392, col: 5, offset: 11421},
+				pos: position{line: 425, col: 5, offset: 12233},
 				run: (*parser).callonShapeOp1,
 				expr: &seqExpr{
-					pos: position{line: 392, col: 5, offset: 11421},
+					pos: position{line: 425, col: 5, offset: 12233},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 392, col: 5, offset: 11421},
+							pos:        position{line: 425, col: 5, offset: 12233},
 							val:        "shape",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 392, col: 13, offset: 11429},
+							pos: position{line: 425, col: 13, offset: 12241},
 							expr: &seqExpr{
-								pos: position{line: 392, col: 15, offset: 11431},
+								pos: position{line: 425, col: 15, offset: 12243},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 392, col: 15, offset: 11431},
+										pos:  position{line: 425, col: 15, offset: 12243},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 392, col: 18, offset: 11434},
+										pos:        position{line: 425, col: 18, offset: 12246},
 										val:        "(",
 										ignoreCase: false,
 									},
@@ -2969,9 +3187,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 392, col: 23, offset: 11439},
+							pos: position{line: 425, col: 23, offset: 12251},
 							expr: &ruleRefExpr{
-								pos:  position{line: 392, col: 24, offset: 11440},
+								pos:  position{line: 425, col: 24, offset: 12252},
 								name: "EOKW",
 							},
 						},
@@ -2981,69 +3199,69 @@ var g = &grammar{
 		},
 		{
 			name: "JoinOp",
-			pos:  position{line: 396, col: 1, offset: 11512},
+			pos:  position{line: 429, col: 1, offset: 12324},
 			expr: &actionExpr{
-				pos: position{line: 397, col: 5, offset: 11523},
+				pos: position{line: 430, col: 5, offset: 12335},
 				run: (*parser).callonJoinOp1,
 				expr: &seqExpr{
-					pos: position{line: 397, col: 5, offset: 11523},
+					pos: position{line: 430, col: 5, offset: 12335},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 397, col: 5, offset: 11523},
+							pos:   position{line: 430, col: 5, offset: 12335},
 							label: "style",
 							expr: &ruleRefExpr{
-								pos:  position{line: 397, col: 11, offset: 11529},
+								pos:  position{line: 430, col: 11, offset: 12341},
 								name: "JoinStyle",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 397, col: 21, offset: 11539},
+							pos:        position{line: 430, col: 21, offset: 12351},
 							val:        "join",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 397, col: 28, offset: 11546},
+							pos:  position{line: 430, col: 28, offset: 12358},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 397, col: 30, offset: 11548},
+							pos:  position{line: 430, col: 30, offset: 12360},
 							name: "ON",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 397, col: 33, offset: 11551},
+							pos:  position{line: 430, col: 33, offset: 12363},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 397, col: 35, offset: 11553},
+							pos:   position{line: 430, col: 35, offset: 12365},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 397, col: 39, offset: 11557},
+								pos:  position{line: 430, col: 39, offset: 12369},
 								name: "JoinKey",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 397, col: 47, offset: 11565},
+							pos:   position{line: 430, col: 47, offset: 12377},
 							label: "optKey",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 397, col: 54, offset: 11572},
+								pos: position{line: 430, col: 54, offset: 12384},
 								expr: &seqExpr{
-									pos: position{line: 397, col: 55, offset: 11573},
+									pos: position{line: 430, col: 55, offset: 12385},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 55, offset: 11573},
+											pos:  position{line: 430, col: 55, offset: 12385},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 397, col: 58, offset: 11576},
+											pos:        position{line: 430, col: 58, offset: 12388},
 											val:        "=",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 62, offset: 11580},
+											pos:  position{line: 430, col: 62, offset: 12392},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 65, offset: 11583},
+											pos:  position{line: 430, col: 65, offset: 12395},
 											name: "JoinKey",
 										},
 									},
@@ -3051,19 +3269,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 397, col: 75, offset: 11593},
+							pos:   position{line: 430, col: 75, offset: 12405},
 							label: "optArgs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 397, col: 83, offset: 11601},
+								pos: position{line: 430, col: 83, offset: 12413},
 								expr: &seqExpr{
-									pos: position{line: 397, col: 84, offset: 11602},
+									pos: position{line: 430, col: 84, offset: 12414},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 84, offset: 11602},
+											pos:  position{line: 430, col: 84, offset: 12414},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 397, col: 86, offset: 11604},
+											pos:  position{line: 430, col: 86, offset: 12416},
 											name: "FlexAssignments",
 										},
 									},
@@ -3076,87 +3294,105 @@ var g = &grammar{
 		},
 		{
 			name: "JoinStyle",
-			pos:  position{line: 408, col: 1, offset: 11933},
+			pos:  position{line: 441, col: 1, offset: 12745},
 			expr: &choiceExpr{
-				pos: position{line: 409, col: 5, offset: 11947},
+				pos: position{line: 442, col: 5, offset: 12759},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 409, col: 5, offset: 11947},
+						pos: position{line: 442, col: 5, offset: 12759},
 						run: (*parser).callonJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 409, col: 5, offset: 11947},
+							pos: position{line: 442, col: 5, offset: 12759},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 409, col: 5, offset: 11947},
+									pos:        position{line: 442, col: 5, offset: 12759},
 									val:        "anti",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 409, col: 12, offset: 11954},
+									pos:  position{line: 442, col: 12, offset: 12766},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 410, col: 5, offset: 11984},
+						pos: position{line: 443, col: 5, offset: 12796},
 						run: (*parser).callonJoinStyle6,
 						expr: &seqExpr{
-							pos: position{line: 410, col: 5, offset: 11984},
+							pos: position{line: 443, col: 5, offset: 12796},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 410, col: 5, offset: 11984},
-									val:        "inner",
+									pos:        position{line: 443, col: 5, offset: 12796},
+									val:        "full",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 410, col: 13, offset: 11992},
+									pos:  position{line: 443, col: 13, offset: 12804},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 411, col: 5, offset: 12022},
+						pos: position{line: 444, col: 5, offset: 12833},
 						run: (*parser).callonJoinStyle10,
 						expr: &seqExpr{
-							pos: position{line: 411, col: 5, offset: 12022},
+							pos: position{line: 444, col: 5, offset: 12833},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 411, col: 5, offset: 12022},
-									val:        "left",
+									pos:        position{line: 444, col: 5, offset: 12833},
+									val:        "inner",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 411, col: 13, offset: 12030},
+									pos:  position{line: 444, col: 13, offset: 12841},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 412, col: 5, offset: 12059},
+						pos: position{line: 445, col: 5, offset: 12871},
 						run: (*parser).callonJoinStyle14,
 						expr: &seqExpr{
-							pos: position{line: 412, col: 5, offset: 12059},
+							pos: position{line: 445, col: 5, offset: 12871},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 412, col: 5, offset: 12059},
-									val:        "right",
+									pos:        position{line: 445, col: 5, offset: 12871},
+									val:        "left",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 412, col: 13, offset: 12067},
+									pos:  position{line: 445, col: 13, offset: 12879},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 413, col: 5, offset: 12097},
+						pos: position{line: 446, col: 5, offset: 12908},
 						run: (*parser).callonJoinStyle18,
+						expr: &seqExpr{
+							pos: position{line: 446, col: 5, offset: 12908},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 446, col: 5, offset: 12908},
+									val:        "right",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 446, col: 13, offset: 12916},
+									name: "_",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 447, col: 5, offset: 12946},
+						run: (*parser).callonJoinStyle22,
 						expr: &litMatcher{
-							pos:        position{line: 413, col: 5, offset: 12097},
+							pos:        position{line: 447, col: 5, offset: 12946},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -3166,35 +3402,35 @@ var g = &grammar{
 		},
 		{
 			name: "JoinKey",
-			pos:  position{line: 415, col: 1, offset: 12133},
+			pos:  position{line: 449, col: 1, offset: 12982},
 			expr: &choiceExpr{
-				pos: position{line: 416, col: 5, offset: 12145},
+				pos: position{line: 450, col: 5, offset: 12994},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 416, col: 5, offset: 12145},
+						pos:  position{line: 450, col: 5, offset: 12994},
 						name: "Lval",
 					},
 					&actionExpr{
-						pos: position{line: 417, col: 5, offset: 12154},
+						pos: position{line: 451, col: 5, offset: 13003},
 						run: (*parser).callonJoinKey3,
 						expr: &seqExpr{
-							pos: position{line: 417, col: 5, offset: 12154},
+							pos: position{line: 451, col: 5, offset: 13003},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 417, col: 5, offset: 12154},
+									pos:        position{line: 451, col: 5, offset: 13003},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 417, col: 9, offset: 12158},
+									pos:   position{line: 451, col: 9, offset: 13007},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 417, col: 14, offset: 12163},
+										pos:  position{line: 451, col: 14, offset: 13012},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 417, col: 19, offset: 12168},
+									pos:        position{line: 451, col: 19, offset: 13017},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -3204,32 +3440,315 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "WindowOp",
+			pos:  position{line: 453, col: 1, offset: 13043},
+			expr: &actionExpr{
+				pos: position{line: 454, col: 5, offset: 13056},
+				run: (*parser).callonWindowOp1,
+				expr: &seqExpr{
+					pos: position{line: 454, col: 5, offset: 13056},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 454, col: 5, offset: 13056},
+							val:        "window",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 454, col: 14, offset: 13065},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 454, col: 16, offset: 13067},
+							label: "aggs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 454, col: 21, offset: 13072},
+								name: "AggAssignments",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 454, col: 36, offset: 13087},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 454, col: 41, offset: 13092},
+								expr: &actionExpr{
+									pos: position{line: 454, col: 42, offset: 13093},
+									run: (*parser).callonWindowOp9,
+									expr: &seqExpr{
+										pos: position{line: 454, col: 42, offset: 13093},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 454, col: 42, offset: 13093},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 454, col: 44, offset: 13095},
+												name: "ByToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 454, col: 52, offset: 13103},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 454, col: 54, offset: 13105},
+												label: "e",
+												expr: &ruleRefExpr{
+													pos:  position{line: 454, col: 56, offset: 13107},
+													name: "Exprs",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 454, col: 82, offset: 13133},
+							label: "sort",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 454, col: 87, offset: 13138},
+								expr: &actionExpr{
+									pos: position{line: 454, col: 88, offset: 13139},
+									run: (*parser).callonWindowOp18,
+									expr: &seqExpr{
+										pos: position{line: 454, col: 88, offset: 13139},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 454, col: 88, offset: 13139},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 454, col: 90, offset: 13141},
+												label: "s",
+												expr: &ruleRefExpr{
+													pos:  position{line: 454, col: 92, offset: 13143},
+													name: "SortOp",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 454, col: 119, offset: 13170},
+							label: "frame",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 454, col: 125, offset: 13176},
+								expr: &actionExpr{
+									pos: position{line: 454, col: 126, offset: 13177},
+									run: (*parser).callonWindowOp25,
+									expr: &seqExpr{
+										pos: position{line: 454, col: 126, offset: 13177},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 454, col: 126, offset: 13177},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 454, col: 128, offset: 13179},
+												label: "f",
+												expr: &ruleRefExpr{
+													pos:  position{line: 454, col: 130, offset: 13181},
+													name: "WindowFrame",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "WindowFrame",
+			pos:  position{line: 458, col: 1, offset: 13339},
+			expr: &actionExpr{
+				pos: position{line: 459, col: 5, offset: 13355},
+				run: (*parser).callonWindowFrame1,
+				expr: &seqExpr{
+					pos: position{line: 459, col: 5, offset: 13355},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 459, col: 5, offset: 13355},
+							val:        "rows",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 459, col: 12, offset: 13362},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 459, col: 14, offset: 13364},
+							label: "lower",
+							expr: &ruleRefExpr{
+								pos:  position{line: 459, col: 20, offset: 13370},
+								name: "WindowBound",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 459, col: 32, offset: 13382},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 459, col: 34, offset: 13384},
+							val:        "to",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 459, col: 39, offset: 13389},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 459, col: 41, offset: 13391},
+							label: "upper",
+							expr: &ruleRefExpr{
+								pos:  position{line: 459, col: 47, offset: 13397},
+								name: "WindowBound",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "WindowBound",
+			pos:  position{line: 463, col: 1, offset: 13491},
+			expr: &choiceExpr{
+				pos: position{line: 464, col: 5, offset: 13507},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 464, col: 5, offset: 13507},
+						run: (*parser).callonWindowBound2,
+						expr: &seqExpr{
+							pos: position{line: 464, col: 5, offset: 13507},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 464, col: 5, offset: 13507},
+									val:        "unbounded",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 464, col: 17, offset: 13519},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 464, col: 19, offset: 13521},
+									label: "typ",
+									expr: &ruleRefExpr{
+										pos:  position{line: 464, col: 23, offset: 13525},
+										name: "WindowDirection",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 467, col: 5, offset: 13638},
+						run: (*parser).callonWindowBound8,
+						expr: &seqExpr{
+							pos: position{line: 467, col: 5, offset: 13638},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 467, col: 5, offset: 13638},
+									label: "count",
+									expr: &ruleRefExpr{
+										pos:  position{line: 467, col: 11, offset: 13644},
+										name: "UInt",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 467, col: 16, offset: 13649},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 467, col: 18, offset: 13651},
+									label: "typ",
+									expr: &ruleRefExpr{
+										pos:  position{line: 467, col: 22, offset: 13655},
+										name: "WindowDirection",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 470, col: 5, offset: 13773},
+						run: (*parser).callonWindowBound15,
+						expr: &seqExpr{
+							pos: position{line: 470, col: 5, offset: 13773},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 470, col: 5, offset: 13773},
+									val:        "current",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 470, col: 15, offset: 13783},
+									name: "_",
+								},
+								&litMatcher{
+									pos:        position{line: 470, col: 17, offset: 13785},
+									val:        "row",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "WindowDirection",
+			pos:  position{line: 474, col: 1, offset: 13892},
+			expr: &actionExpr{
+				pos: position{line: 474, col: 19, offset: 13910},
+				run: (*parser).callonWindowDirection1,
+				expr: &choiceExpr{
+					pos: position{line: 474, col: 20, offset: 13911},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 474, col: 20, offset: 13911},
+							val:        "preceding",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 474, col: 34, offset: 13925},
+							val:        "following",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "SampleOp",
-			pos:  position{line: 419, col: 1, offset: 12194},
+			pos:  position{line: 476, col: 1, offset: 13970},
 			expr: &actionExpr{
-				pos: position{line: 420, col: 5, offset: 12207},
+				pos: position{line: 477, col: 5, offset: 13983},
 				run: (*parser).callonSampleOp1,
 				expr: &seqExpr{
-					pos: position{line: 420, col: 5, offset: 12207},
+					pos: position{line: 477, col: 5, offset: 13983},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 420, col: 5, offset: 12207},
+							pos:        position{line: 477, col: 5, offset: 13983},
 							val:        "sample",
 							ignoreCase: false,
 						},
 						&andExpr{
-							pos: position{line: 420, col: 14, offset: 12216},
+							pos: position{line: 477, col: 14, offset: 13992},
 							expr: &ruleRefExpr{
-								pos:  position{line: 420, col: 15, offset: 12217},
+								pos:  position{line: 477, col: 15, offset: 13993},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 420, col: 20, offset: 12222},
+							pos:   position{line: 477, col: 20, offset: 13998},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 420, col: 22, offset: 12224},
+								pos:  position{line: 477, col: 22, offset: 14000},
 								name: "SampleExpr",
 							},
 						},
@@ -3239,15 +3758,15 @@ var g = &grammar{
 		},
 		{
 			name: "OpAssignment",
-			pos:  position{line: 462, col: 1, offset: 13723},
+			pos:  position{line: 519, col: 1, offset: 15499},
 			expr: &actionExpr{
-				pos: position{line: 463, col: 5, offset: 13740},
+				pos: position{line: 520, col: 5, offset: 15516},
 				run: (*parser).callonOpAssignment1,
 				expr: &labeledExpr{
-					pos:   position{line: 463, col: 5, offset: 13740},
+					pos:   position{line: 520, col: 5, offset: 15516},
 					label: "a",
 					expr: &ruleRefExpr{
-						pos:  position{line: 463, col: 7, offset: 13742},
+						pos:  position{line: 520, col: 7, offset: 15518},
 						name: "Assignments",
 					},
 				},
@@ -3255,25 +3774,25 @@ var g = &grammar{
 		},
 		{
 			name: "SampleExpr",
-			pos:  position{line: 467, col: 1, offset: 13842},
+			pos:  position{line: 524, col: 1, offset: 15618},
 			expr: &choiceExpr{
-				pos: position{line: 468, col: 5, offset: 13857},
+				pos: position{line: 525, col: 5, offset: 15633},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 468, col: 5, offset: 13857},
+						pos: position{line: 525, col: 5, offset: 15633},
 						run: (*parser).callonSampleExpr2,
 						expr: &seqExpr{
-							pos: position{line: 468, col: 5, offset: 13857},
+							pos: position{line: 525, col: 5, offset: 15633},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 468, col: 5, offset: 13857},
+									pos:  position{line: 525, col: 5, offset: 15633},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 468, col: 7, offset: 13859},
+									pos:   position{line: 525, col: 7, offset: 15635},
 									label: "lval",
 									expr: &ruleRefExpr{
-										pos:  position{line: 468, col: 12, offset: 13864},
+										pos:  position{line: 525, col: 12, offset: 15640},
 										name: "Lval",
 									},
 								},
@@ -3281,10 +3800,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 469, col: 5, offset: 13893},
+						pos: position{line: 526, col: 5, offset: 15669},
 						run: (*parser).callonSampleExpr7,
 						expr: &litMatcher{
-							pos:        position{line: 469, col: 5, offset: 13893},
+							pos:        position{line: 526, col: 5, offset: 15669},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -3294,15 +3813,15 @@ var g = &grammar{
 		},
 		{
 			name: "FromOp",
-			pos:  position{line: 471, col: 1, offset: 13964},
+			pos:  position{line: 528, col: 1, offset: 15740},
 			expr: &actionExpr{
-				pos: position{line: 472, col: 5, offset: 13975},
+				pos: position{line: 529, col: 5, offset: 15751},
 				run: (*parser).callonFromOp1,
 				expr: &labeledExpr{
-					pos:   position{line: 472, col: 5, offset: 13975},
+					pos:   position{line: 529, col: 5, offset: 15751},
 					label: "source",
 					expr: &ruleRefExpr{
-						pos:  position{line: 472, col: 12, offset: 13982},
+						pos:  position{line: 529, col: 12, offset: 15758},
 						name: "FromAny",
 					},
 				},
@@ -3310,20 +3829,20 @@ var g = &grammar{
 		},
 		{
 			name: "FromAny",
-			pos:  position{line: 476, col: 1, offset: 14138},
+			pos:  position{line: 533, col: 1, offset: 15914},
 			expr: &choiceExpr{
-				pos: position{line: 477, col: 5, offset: 14150},
+				pos: position{line: 534, col: 5, offset: 15926},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 477, col: 5, offset: 14150},
+						pos:  position{line: 534, col: 5, offset: 15926},
 						name: "File",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 478, col: 5, offset: 14159},
+						pos:  position{line: 535, col: 5, offset: 15935},
 						name: "Get",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 479, col: 5, offset: 14167},
+						pos:  position{line: 536, col: 5, offset: 15943},
 						name: "From",
 					},
 				},
@@ -3331,48 +3850,48 @@ var g = &grammar{
 		},
 		{
 			name: "File",
-			pos:  position{line: 481, col: 1, offset: 14173},
+			pos:  position{line: 538, col: 1, offset: 15949},
 			expr: &actionExpr{
-				pos: position{line: 482, col: 5, offset: 14182},
+				pos: position{line: 539, col: 5, offset: 15958},
 				run: (*parser).callonFile1,
 				expr: &seqExpr{
-					pos: position{line: 482, col: 5, offset: 14182},
+					pos: position{line: 539, col: 5, offset: 15958},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 482, col: 5, offset: 14182},
+							pos:        position{line: 539, col: 5, offset: 15958},
 							val:        "file",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 482, col: 12, offset: 14189},
+							pos:  position{line: 539, col: 12, offset: 15965},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 14, offset: 14191},
+							pos:   position{line: 539, col: 14, offset: 15967},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 482, col: 19, offset: 14196},
+								pos:  position{line: 539, col: 19, offset: 15972},
 								name: "Path",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 24, offset: 14201},
+							pos:   position{line: 539, col: 24, offset: 15977},
 							label: "format",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 482, col: 31, offset: 14208},
+								pos: position{line: 539, col: 31, offset: 15984},
 								expr: &ruleRefExpr{
-									pos:  position{line: 482, col: 31, offset: 14208},
+									pos:  position{line: 539, col: 31, offset: 15984},
 									name: "FormatArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 42, offset: 14219},
+							pos:   position{line: 539, col: 42, offset: 15995},
 							label: "layout",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 482, col: 49, offset: 14226},
+								pos: position{line: 539, col: 49, offset: 16002},
 								expr: &ruleRefExpr{
-									pos:  position{line: 482, col: 49, offset: 14226},
+									pos:  position{line: 539, col: 49, offset: 16002},
 									name: "LayoutArg",
 								},
 							},
@@ -3383,27 +3902,27 @@ var g = &grammar{
 		},
 		{
 			name: "From",
-			pos:  position{line: 486, col: 1, offset: 14355},
+			pos:  position{line: 543, col: 1, offset: 16131},
 			expr: &actionExpr{
-				pos: position{line: 487, col: 5, offset: 14364},
+				pos: position{line: 544, col: 5, offset: 16140},
 				run: (*parser).callonFrom1,
 				expr: &seqExpr{
-					pos: position{line: 487, col: 5, offset: 14364},
+					pos: position{line: 544, col: 5, offset: 16140},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 487, col: 5, offset: 14364},
+							pos:        position{line: 544, col: 5, offset: 16140},
 							val:        "from",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 487, col: 12, offset: 14371},
+							pos:  position{line: 544, col: 12, offset: 16147},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 487, col: 14, offset: 14373},
+							pos:   position{line: 544, col: 14, offset: 16149},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 487, col: 19, offset: 14378},
+								pos:  position{line: 544, col: 19, offset: 16154},
 								name: "PoolBody",
 							},
 						},
@@ -3413,27 +3932,27 @@ var g = &grammar{
 		},
 		{
 			name: "Pool",
-			pos:  position{line: 489, col: 1, offset: 14409},
+			pos:  position{line: 546, col: 1, offset: 16185},
 			expr: &actionExpr{
-				pos: position{line: 490, col: 5, offset: 14418},
+				pos: position{line: 547, col: 5, offset: 16194},
 				run: (*parser).callonPool1,
 				expr: &seqExpr{
-					pos: position{line: 490, col: 5, offset: 14418},
+					pos: position{line: 547, col: 5, offset: 16194},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 490, col: 5, offset: 14418},
+							pos:        position{line: 547, col: 5, offset: 16194},
 							val:        "pool",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 490, col: 12, offset: 14425},
+							pos:  position{line: 547, col: 12, offset: 16201},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 490, col: 14, offset: 14427},
+							pos:   position{line: 547, col: 14, offset: 16203},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 490, col: 19, offset: 14432},
+								pos:  position{line: 547, col: 19, offset: 16208},
 								name: "PoolBody",
 							},
 						},
@@ -3443,28 +3962,28 @@ var g = &grammar{
 		},
 		{
 			name: "PoolBody",
-			pos:  position{line: 492, col: 1, offset: 14463},
+			pos:  position{line: 549, col: 1, offset: 16239},
 			expr: &actionExpr{
-				pos: position{line: 493, col: 5, offset: 14476},
+				pos: position{line: 550, col: 5, offset: 16252},
 				run: (*parser).callonPoolBody1,
 				expr: &seqExpr{
-					pos: position{line: 493, col: 5, offset: 14476},
+					pos: position{line: 550, col: 5, offset: 16252},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 493, col: 5, offset: 14476},
+							pos:   position{line: 550, col: 5, offset: 16252},
 							label: "spec",
 							expr: &ruleRefExpr{
-								pos:  position{line: 493, col: 10, offset: 14481},
+								pos:  position{line: 550, col: 10, offset: 16257},
 								name: "PoolSpec",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 493, col: 19, offset: 14490},
+							pos:   position{line: 550, col: 19, offset: 16266},
 							label: "at",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 493, col: 22, offset: 14493},
+								pos: position{line: 550, col: 22, offset: 16269},
 								expr: &ruleRefExpr{
-									pos:  position{line: 493, col: 22, offset: 14493},
+									pos:  position{line: 550, col: 22, offset: 16269},
 									name: "PoolAt",
 								},
 							},
@@ -3475,48 +3994,48 @@ var g = &grammar{
 		},
 		{
 			name: "Get",
-			pos:  position{line: 497, col: 1, offset: 14591},
+			pos:  position{line: 554, col: 1, offset: 16367},
 			expr: &actionExpr{
-				pos: position{line: 498, col: 5, offset: 14599},
+				pos: position{line: 555, col: 5, offset: 16375},
 				run: (*parser).callonGet1,
 				expr: &seqExpr{
-					pos: position{line: 498, col: 5, offset: 14599},
+					pos: position{line: 555, col: 5, offset: 16375},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 498, col: 5, offset: 14599},
+							pos:        position{line: 555, col: 5, offset: 16375},
 							val:        "get",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 498, col: 11, offset: 14605},
+							pos:  position{line: 555, col: 11, offset: 16381},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 498, col: 13, offset: 14607},
+							pos:   position{line: 555, col: 13, offset: 16383},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 498, col: 17, offset: 14611},
+								pos:  position{line: 555, col: 17, offset: 16387},
 								name: "URL",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 498, col: 21, offset: 14615},
+							pos:   position{line: 555, col: 21, offset: 16391},
 							label: "format",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 498, col: 28, offset: 14622},
+								pos: position{line: 555, col: 28, offset: 16398},
 								expr: &ruleRefExpr{
-									pos:  position{line: 498, col: 28, offset: 14622},
+									pos:  position{line: 555, col: 28, offset: 16398},
 									name: "FormatArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 498, col: 39, offset: 14633},
+							pos:   position{line: 555, col: 39, offset: 16409},
 							label: "layout",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 498, col: 46, offset: 14640},
+								pos: position{line: 555, col: 46, offset: 16416},
 								expr: &ruleRefExpr{
-									pos:  position{line: 498, col: 46, offset: 14640},
+									pos:  position{line: 555, col: 46, offset: 16416},
 									name: "LayoutArg",
 								},
 							},
@@ -3527,30 +4046,30 @@ var g = &grammar{
 		},
 		{
 			name: "URL",
-			pos:  position{line: 502, col: 1, offset: 14766},
+			pos:  position{line: 559, col: 1, offset: 16542},
 			expr: &actionExpr{
-				pos: position{line: 502, col: 7, offset: 14772},
+				pos: position{line: 559, col: 7, offset: 16548},
 				run: (*parser).callonURL1,
 				expr: &seqExpr{
-					pos: position{line: 502, col: 7, offset: 14772},
+					pos: position{line: 559, col: 7, offset: 16548},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 502, col: 8, offset: 14773},
+							pos: position{line: 559, col: 8, offset: 16549},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 502, col: 8, offset: 14773},
+									pos:        position{line: 559, col: 8, offset: 16549},
 									val:        "http:",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 502, col: 18, offset: 14783},
+									pos:        position{line: 559, col: 18, offset: 16559},
 									val:        "https:",
 									ignoreCase: false,
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 502, col: 28, offset: 14793},
+							pos:  position{line: 559, col: 28, offset: 16569},
 							name: "Path",
 						},
 					},
@@ -3559,29 +4078,29 @@ var g = &grammar{
 		},
 		{
 			name: "Path",
-			pos:  position{line: 504, col: 1, offset: 14830},
+			pos:  position{line: 561, col: 1, offset: 16606},
 			expr: &choiceExpr{
-				pos: position{line: 505, col: 5, offset: 14839},
+				pos: position{line: 562, col: 5, offset: 16615},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 505, col: 5, offset: 14839},
+						pos: position{line: 562, col: 5, offset: 16615},
 						run: (*parser).callonPath2,
 						expr: &labeledExpr{
-							pos:   position{line: 505, col: 5, offset: 14839},
+							pos:   position{line: 562, col: 5, offset: 16615},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 505, col: 7, offset: 14841},
+								pos:  position{line: 562, col: 7, offset: 16617},
 								name: "QuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 506, col: 5, offset: 14876},
+						pos: position{line: 563, col: 5, offset: 16652},
 						run: (*parser).callonPath5,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 506, col: 5, offset: 14876},
+							pos: position{line: 563, col: 5, offset: 16652},
 							expr: &charClassMatcher{
-								pos:        position{line: 506, col: 5, offset: 14876},
+								pos:        position{line: 563, col: 5, offset: 16652},
 								val:        "[0-9a-zA-Z!@$%^&*()_=<>,./?:[\\]{}~|+-]",
 								chars:      []rune{'!', '@', '$', '%', '^', '&', '*', '(', ')', '_', '=', '<', '>', ',', '.', '/', '?', ':', '[', ']', '{', '}', '~', '|', '+', '-'},
 								ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
@@ -3595,31 +4114,31 @@ var g = &grammar{
 		},
 		{
 			name: "PoolAt",
-			pos:  position{line: 509, col: 1, offset: 14981},
+			pos:  position{line: 566, col: 1, offset: 16757},
 			expr: &actionExpr{
-				pos: position{line: 510, col: 5, offset: 14992},
+				pos: position{line: 567, col: 5, offset: 16768},
 				run: (*parser).callonPoolAt1,
 				expr: &seqExpr{
-					pos: position{line: 510, col: 5, offset: 14992},
+					pos: position{line: 567, col: 5, offset: 16768},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 510, col: 5, offset: 14992},
+							pos:  position{line: 567, col: 5, offset: 16768},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 510, col: 7, offset: 14994},
+							pos:        position{line: 567, col: 7, offset: 16770},
 							val:        "at",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 510, col: 12, offset: 14999},
+							pos:  position{line: 567, col: 12, offset: 16775},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 510, col: 14, offset: 15001},
+							pos:   position{line: 567, col: 14, offset: 16777},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 510, col: 17, offset: 15004},
+								pos:  position{line: 567, col: 17, offset: 16780},
 								name: "KSUID",
 							},
 						},
@@ -3629,14 +4148,14 @@ var g = &grammar{
 		},
 		{
 			name: "KSUID",
-			pos:  position{line: 513, col: 1, offset: 15070},
+			pos:  position{line: 570, col: 1, offset: 16846},
 			expr: &actionExpr{
-				pos: position{line: 513, col: 9, offset: 15078},
+				pos: position{line: 570, col: 9, offset: 16854},
 				run: (*parser).callonKSUID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 513, col: 9, offset: 15078},
+					pos: position{line: 570, col: 9, offset: 16854},
 					expr: &charClassMatcher{
-						pos:        position{line: 513, col: 10, offset: 15079},
+						pos:        position{line: 570, col: 10, offset: 16855},
 						val:        "[0-9a-zA-Z]",
 						ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
 						ignoreCase: false,
@@ -3647,51 +4166,51 @@ var g = &grammar{
 		},
 		{
 			name: "PoolSpec",
-			pos:  position{line: 515, col: 1, offset: 15125},
+			pos:  position{line: 572, col: 1, offset: 16901},
 			expr: &choiceExpr{
-				pos: position{line: 516, col: 5, offset: 15138},
+				pos: position{line: 573, col: 5, offset: 16914},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 516, col: 5, offset: 15138},
+						pos: position{line: 573, col: 5, offset: 16914},
 						run: (*parser).callonPoolSpec2,
 						expr: &seqExpr{
-							pos: position{line: 516, col: 5, offset: 15138},
+							pos: position{line: 573, col: 5, offset: 16914},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 516, col: 5, offset: 15138},
+									pos:   position{line: 573, col: 5, offset: 16914},
 									label: "pool",
 									expr: &ruleRefExpr{
-										pos:  position{line: 516, col: 10, offset: 15143},
+										pos:  position{line: 573, col: 10, offset: 16919},
 										name: "PoolName",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 516, col: 19, offset: 15152},
+									pos:   position{line: 573, col: 19, offset: 16928},
 									label: "commit",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 516, col: 26, offset: 15159},
+										pos: position{line: 573, col: 26, offset: 16935},
 										expr: &ruleRefExpr{
-											pos:  position{line: 516, col: 26, offset: 15159},
+											pos:  position{line: 573, col: 26, offset: 16935},
 											name: "PoolCommit",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 516, col: 38, offset: 15171},
+									pos:   position{line: 573, col: 38, offset: 16947},
 									label: "meta",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 516, col: 43, offset: 15176},
+										pos: position{line: 573, col: 43, offset: 16952},
 										expr: &ruleRefExpr{
-											pos:  position{line: 516, col: 43, offset: 15176},
+											pos:  position{line: 573, col: 43, offset: 16952},
 											name: "PoolMeta",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 516, col: 53, offset: 15186},
+									pos:   position{line: 573, col: 53, offset: 16962},
 									label: "tap",
 									expr: &ruleRefExpr{
-										pos:  position{line: 516, col: 57, offset: 15190},
+										pos:  position{line: 573, col: 57, offset: 16966},
 										name: "TapArg",
 									},
 								},
@@ -3699,13 +4218,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 519, col: 5, offset: 15307},
+						pos: position{line: 576, col: 5, offset: 17083},
 						run: (*parser).callonPoolSpec14,
 						expr: &labeledExpr{
-							pos:   position{line: 519, col: 5, offset: 15307},
+							pos:   position{line: 576, col: 5, offset: 17083},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 519, col: 10, offset: 15312},
+								pos:  position{line: 576, col: 10, offset: 17088},
 								name: "PoolMeta",
 							},
 						},
@@ -3715,23 +4234,23 @@ var g = &grammar{
 		},
 		{
 			name: "PoolCommit",
-			pos:  position{line: 523, col: 1, offset: 15413},
+			pos:  position{line: 580, col: 1, offset: 17189},
 			expr: &actionExpr{
-				pos: position{line: 524, col: 5, offset: 15428},
+				pos: position{line: 581, col: 5, offset: 17204},
 				run: (*parser).callonPoolCommit1,
 				expr: &seqExpr{
-					pos: position{line: 524, col: 5, offset: 15428},
+					pos: position{line: 581, col: 5, offset: 17204},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 524, col: 5, offset: 15428},
+							pos:        position{line: 581, col: 5, offset: 17204},
 							val:        "@",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 524, col: 9, offset: 15432},
+							pos:   position{line: 581, col: 9, offset: 17208},
 							label: "commit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 524, col: 16, offset: 15439},
+								pos:  position{line: 581, col: 16, offset: 17215},
 								name: "PoolNameString",
 							},
 						},
@@ -3741,23 +4260,23 @@ var g = &grammar{
 		},
 		{
 			name: "PoolMeta",
-			pos:  position{line: 526, col: 1, offset: 15478},
+			pos:  position{line: 583, col: 1, offset: 17254},
 			expr: &actionExpr{
-				pos: position{line: 527, col: 5, offset: 15491},
+				pos: position{line: 584, col: 5, offset: 17267},
 				run: (*parser).callonPoolMeta1,
 				expr: &seqExpr{
-					pos: position{line: 527, col: 5, offset: 15491},
+					pos: position{line: 584, col: 5, offset: 17267},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 527, col: 5, offset: 15491},
+							pos:        position{line: 584, col: 5, offset: 17267},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 527, col: 9, offset: 15495},
+							pos:   position{line: 584, col: 9, offset: 17271},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 527, col: 14, offset: 15500},
+								pos:  position{line: 584, col: 14, offset: 17276},
 								name: "PoolIdentifier",
 							},
 						},
@@ -3767,29 +4286,29 @@ var g = &grammar{
 		},
 		{
 			name: "PoolName",
-			pos:  position{line: 529, col: 1, offset: 15537},
+			pos:  position{line: 586, col: 1, offset: 17313},
 			expr: &choiceExpr{
-				pos: position{line: 530, col: 5, offset: 15550},
+				pos: position{line: 587, col: 5, offset: 17326},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 530, col: 5, offset: 15550},
+						pos:  position{line: 587, col: 5, offset: 17326},
 						name: "Glob",
 					},
 					&actionExpr{
-						pos: position{line: 531, col: 5, offset: 15559},
+						pos: position{line: 588, col: 5, offset: 17335},
 						run: (*parser).callonPoolName3,
 						expr: &seqExpr{
-							pos: position{line: 531, col: 5, offset: 15559},
+							pos: position{line: 588, col: 5, offset: 17335},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 531, col: 5, offset: 15559},
+									pos:        position{line: 588, col: 5, offset: 17335},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&notExpr{
-									pos: position{line: 531, col: 9, offset: 15563},
+									pos: position{line: 588, col: 9, offset: 17339},
 									expr: &ruleRefExpr{
-										pos:  position{line: 531, col: 10, offset: 15564},
+										pos:  position{line: 588, col: 10, offset: 17340},
 										name: "ExprGuard",
 									},
 								},
@@ -3797,17 +4316,17 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 532, col: 5, offset: 15649},
+						pos:  position{line: 589, col: 5, offset: 17425},
 						name: "Regexp",
 					},
 					&actionExpr{
-						pos: position{line: 533, col: 5, offset: 15660},
+						pos: position{line: 590, col: 5, offset: 17436},
 						run: (*parser).callonPoolName9,
 						expr: &labeledExpr{
-							pos:   position{line: 533, col: 5, offset: 15660},
+							pos:   position{line: 590, col: 5, offset: 17436},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 533, col: 10, offset: 15665},
+								pos:  position{line: 590, col: 10, offset: 17441},
 								name: "PoolNameString",
 							},
 						},
@@ -3817,20 +4336,20 @@ var g = &grammar{
 		},
 		{
 			name: "PoolNameString",
-			pos:  position{line: 535, col: 1, offset: 15752},
+			pos:  position{line: 592, col: 1, offset: 17528},
 			expr: &choiceExpr{
-				pos: position{line: 536, col: 5, offset: 15771},
+				pos: position{line: 593, col: 5, offset: 17547},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 536, col: 5, offset: 15771},
+						pos:  position{line: 593, col: 5, offset: 17547},
 						name: "PoolIdentifier",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 537, col: 5, offset: 15790},
+						pos:  position{line: 594, col: 5, offset: 17566},
 						name: "KSUID",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 538, col: 5, offset: 15800},
+						pos:  position{line: 595, col: 5, offset: 17576},
 						name: "QuotedString",
 					},
 				},
@@ -3838,38 +4357,38 @@ var g = &grammar{
 		},
 		{
 			name: "PoolIdentifier",
-			pos:  position{line: 540, col: 1, offset: 15814},
+			pos:  position{line: 597, col: 1, offset: 17590},
 			expr: &actionExpr{
-				pos: position{line: 541, col: 5, offset: 15833},
+				pos: position{line: 598, col: 5, offset: 17609},
 				run: (*parser).callonPoolIdentifier1,
 				expr: &seqExpr{
-					pos: position{line: 541, col: 5, offset: 15833},
+					pos: position{line: 598, col: 5, offset: 17609},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 541, col: 6, offset: 15834},
+							pos: position{line: 598, col: 6, offset: 17610},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 541, col: 6, offset: 15834},
+									pos:  position{line: 598, col: 6, offset: 17610},
 									name: "IdentifierStart",
 								},
 								&litMatcher{
-									pos:        position{line: 541, col: 24, offset: 15852},
+									pos:        position{line: 598, col: 24, offset: 17628},
 									val:        ".",
 									ignoreCase: false,
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 541, col: 29, offset: 15857},
+							pos: position{line: 598, col: 29, offset: 17633},
 							expr: &choiceExpr{
-								pos: position{line: 541, col: 30, offset: 15858},
+								pos: position{line: 598, col: 30, offset: 17634},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 541, col: 30, offset: 15858},
+										pos:  position{line: 598, col: 30, offset: 17634},
 										name: "IdentifierRest",
 									},
 									&litMatcher{
-										pos:        position{line: 541, col: 47, offset: 15875},
+										pos:        position{line: 598, col: 47, offset: 17651},
 										val:        ".",
 										ignoreCase: false,
 									},
@@ -3882,39 +4401,39 @@ var g = &grammar{
 		},
 		{
 			name: "LayoutArg",
-			pos:  position{line: 543, col: 1, offset: 15914},
+			pos:  position{line: 600, col: 1, offset: 17690},
 			expr: &actionExpr{
-				pos: position{line: 544, col: 5, offset: 15928},
+				pos: position{line: 601, col: 5, offset: 17704},
 				run: (*parser).callonLayoutArg1,
 				expr: &seqExpr{
-					pos: position{line: 544, col: 5, offset: 15928},
+					pos: position{line: 601, col: 5, offset: 17704},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 544, col: 5, offset: 15928},
+							pos:  position{line: 601, col: 5, offset: 17704},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 544, col: 7, offset: 15930},
+							pos:        position{line: 601, col: 7, offset: 17706},
 							val:        "order",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 544, col: 15, offset: 15938},
+							pos:  position{line: 601, col: 15, offset: 17714},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 544, col: 17, offset: 15940},
+							pos:   position{line: 601, col: 17, offset: 17716},
 							label: "keys",
 							expr: &ruleRefExpr{
-								pos:  position{line: 544, col: 22, offset: 15945},
+								pos:  position{line: 601, col: 22, offset: 17721},
 								name: "FieldExprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 544, col: 33, offset: 15956},
+							pos:   position{line: 601, col: 33, offset: 17732},
 							label: "order",
 							expr: &ruleRefExpr{
-								pos:  position{line: 544, col: 39, offset: 15962},
+								pos:  position{line: 601, col: 39, offset: 17738},
 								name: "OrderSuffix",
 							},
 						},
@@ -3924,22 +4443,22 @@ var g = &grammar{
 		},
 		{
 			name: "TapArg",
-			pos:  position{line: 548, col: 1, offset: 16072},
+			pos:  position{line: 605, col: 1, offset: 17848},
 			expr: &choiceExpr{
-				pos: position{line: 549, col: 5, offset: 16083},
+				pos: position{line: 606, col: 5, offset: 17859},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 549, col: 5, offset: 16083},
+						pos: position{line: 606, col: 5, offset: 17859},
 						run: (*parser).callonTapArg2,
 						expr: &seqExpr{
-							pos: position{line: 549, col: 5, offset: 16083},
+							pos: position{line: 606, col: 5, offset: 17859},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 549, col: 5, offset: 16083},
+									pos:  position{line: 606, col: 5, offset: 17859},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 549, col: 7, offset: 16085},
+									pos:        position{line: 606, col: 7, offset: 17861},
 									val:        "tap",
 									ignoreCase: false,
 								},
@@ -3947,10 +4466,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 550, col: 5, offset: 16116},
+						pos: position{line: 607, col: 5, offset: 17892},
 						run: (*parser).callonTapArg6,
 						expr: &litMatcher{
-							pos:        position{line: 550, col: 5, offset: 16116},
+							pos:        position{line: 607, col: 5, offset: 17892},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -3960,31 +4479,31 @@ var g = &grammar{
 		},
 		{
 			name: "FormatArg",
-			pos:  position{line: 552, col: 1, offset: 16142},
+			pos:  position{line: 609, col: 1, offset: 17918},
 			expr: &actionExpr{
-				pos: position{line: 553, col: 5, offset: 16156},
+				pos: position{line: 610, col: 5, offset: 17932},
 				run: (*parser).callonFormatArg1,
 				expr: &seqExpr{
-					pos: position{line: 553, col: 5, offset: 16156},
+					pos: position{line: 610, col: 5, offset: 17932},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 553, col: 5, offset: 16156},
+							pos:  position{line: 610, col: 5, offset: 17932},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 553, col: 7, offset: 16158},
+							pos:        position{line: 610, col: 7, offset: 17934},
 							val:        "format",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 553, col: 16, offset: 16167},
+							pos:  position{line: 610, col: 16, offset: 17943},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 553, col: 18, offset: 16169},
+							pos:   position{line: 610, col: 18, offset: 17945},
 							label: "val",
 							expr: &ruleRefExpr{
-								pos:  position{line: 553, col: 22, offset: 16173},
+								pos:  position{line: 610, col: 22, offset: 17949},
 								name: "IdentifierName",
 							},
 						},
@@ -3994,33 +4513,33 @@ var g = &grammar{
 		},
 		{
 			name: "OrderSuffix",
-			pos:  position{line: 555, col: 1, offset: 16209},
+			pos:  position{line: 612, col: 1, offset: 17985},
 			expr: &choiceExpr{
-				pos: position{line: 556, col: 5, offset: 16225},
+				pos: position{line: 613, col: 5, offset: 18001},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 556, col: 5, offset: 16225},
+						pos: position{line: 613, col: 5, offset: 18001},
 						run: (*parser).callonOrderSuffix2,
 						expr: &litMatcher{
-							pos:        position{line: 556, col: 5, offset: 16225},
+							pos:        position{line: 613, col: 5, offset: 18001},
 							val:        ":asc",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 557, col: 5, offset: 16259},
+						pos: position{line: 614, col: 5, offset: 18035},
 						run: (*parser).callonOrderSuffix4,
 						expr: &litMatcher{
-							pos:        position{line: 557, col: 5, offset: 16259},
+							pos:        position{line: 614, col: 5, offset: 18035},
 							val:        ":desc",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 558, col: 5, offset: 16295},
+						pos: position{line: 615, col: 5, offset: 18071},
 						run: (*parser).callonOrderSuffix6,
 						expr: &litMatcher{
-							pos:        position{line: 558, col: 5, offset: 16295},
+							pos:        position{line: 615, col: 5, offset: 18071},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -4030,22 +4549,22 @@ var g = &grammar{
 		},
 		{
 			name: "PassOp",
-			pos:  position{line: 560, col: 1, offset: 16321},
+			pos:  position{line: 617, col: 1, offset: 18097},
 			expr: &actionExpr{
-				pos: position{line: 561, col: 5, offset: 16332},
+				pos: position{line: 618, col: 5, offset: 18108},
 				run: (*parser).callonPassOp1,
 				expr: &seqExpr{
-					pos: position{line: 561, col: 5, offset: 16332},
+					pos: position{line: 618, col: 5, offset: 18108},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 561, col: 5, offset: 16332},
+							pos:        position{line: 618, col: 5, offset: 18108},
 							val:        "pass",
 							ignoreCase: false,
 						},
 						&andExpr{
-							pos: position{line: 561, col: 12, offset: 16339},
+							pos: position{line: 618, col: 12, offset: 18115},
 							expr: &ruleRefExpr{
-								pos:  position{line: 561, col: 13, offset: 16340},
+								pos:  position{line: 618, col: 13, offset: 18116},
 								name: "EOKW",
 							},
 						},
@@ -4055,45 +4574,45 @@ var g = &grammar{
 		},
 		{
 			name: "ExplodeOp",
-			pos:  position{line: 567, col: 1, offset: 16532},
+			pos:  position{line: 624, col: 1, offset: 18308},
 			expr: &actionExpr{
-				pos: position{line: 568, col: 5, offset: 16546},
+				pos: position{line: 625, col: 5, offset: 18322},
 				run: (*parser).callonExplodeOp1,
 				expr: &seqExpr{
-					pos: position{line: 568, col: 5, offset: 16546},
+					pos: position{line: 625, col: 5, offset: 18322},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 568, col: 5, offset: 16546},
+							pos:        position{line: 625, col: 5, offset: 18322},
 							val:        "explode",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 568, col: 15, offset: 16556},
+							pos:  position{line: 625, col: 15, offset: 18332},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 568, col: 17, offset: 16558},
+							pos:   position{line: 625, col: 17, offset: 18334},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 568, col: 22, offset: 16563},
+								pos:  position{line: 625, col: 22, offset: 18339},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 568, col: 28, offset: 16569},
+							pos:   position{line: 625, col: 28, offset: 18345},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 568, col: 32, offset: 16573},
+								pos:  position{line: 625, col: 32, offset: 18349},
 								name: "TypeArg",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 568, col: 40, offset: 16581},
+							pos:   position{line: 625, col: 40, offset: 18357},
 							label: "as",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 568, col: 43, offset: 16584},
+								pos: position{line: 625, col: 43, offset: 18360},
 								expr: &ruleRefExpr{
-									pos:  position{line: 568, col: 43, offset: 16584},
+									pos:  position{line: 625, col: 43, offset: 18360},
 									name: "AsArg",
 								},
 							},
@@ -4104,27 +4623,27 @@ var g = &grammar{
 		},
 		{
 			name: "MergeOp",
-			pos:  position{line: 572, col: 1, offset: 16696},
+			pos:  position{line: 629, col: 1, offset: 18472},
 			expr: &actionExpr{
-				pos: position{line: 573, col: 5, offset: 16708},
+				pos: position{line: 630, col: 5, offset: 18484},
 				run: (*parser).callonMergeOp1,
 				expr: &seqExpr{
-					pos: position{line: 573, col: 5, offset: 16708},
+					pos: position{line: 630, col: 5, offset: 18484},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 573, col: 5, offset: 16708},
+							pos:        position{line: 630, col: 5, offset: 18484},
 							val:        "merge",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 573, col: 13, offset: 16716},
+							pos:  position{line: 630, col: 13, offset: 18492},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 573, col: 15, offset: 16718},
+							pos:   position{line: 630, col: 15, offset: 18494},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 573, col: 20, offset: 16723},
+								pos:  position{line: 630, col: 20, offset: 18499},
 								name: "Expr",
 							},
 						},
@@ -4134,48 +4653,48 @@ var g = &grammar{
 		},
 		{
 			name: "OverOp",
-			pos:  position{line: 577, col: 1, offset: 16804},
+			pos:  position{line: 634, col: 1, offset: 18580},
 			expr: &actionExpr{
-				pos: position{line: 578, col: 5, offset: 16815},
+				pos: position{line: 635, col: 5, offset: 18591},
 				run: (*parser).callonOverOp1,
 				expr: &seqExpr{
-					pos: position{line: 578, col: 5, offset: 16815},
+					pos: position{line: 635, col: 5, offset: 18591},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 578, col: 5, offset: 16815},
+							pos:        position{line: 635, col: 5, offset: 18591},
 							val:        "over",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 578, col: 12, offset: 16822},
+							pos:  position{line: 635, col: 12, offset: 18598},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 14, offset: 16824},
+							pos:   position{line: 635, col: 14, offset: 18600},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 578, col: 20, offset: 16830},
+								pos:  position{line: 635, col: 20, offset: 18606},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 26, offset: 16836},
+							pos:   position{line: 635, col: 26, offset: 18612},
 							label: "locals",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 578, col: 33, offset: 16843},
+								pos: position{line: 635, col: 33, offset: 18619},
 								expr: &ruleRefExpr{
-									pos:  position{line: 578, col: 33, offset: 16843},
+									pos:  position{line: 635, col: 33, offset: 18619},
 									name: "Locals",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 41, offset: 16851},
+							pos:   position{line: 635, col: 41, offset: 18627},
 							label: "scope",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 578, col: 47, offset: 16857},
+								pos: position{line: 635, col: 47, offset: 18633},
 								expr: &ruleRefExpr{
-									pos:  position{line: 578, col: 47, offset: 16857},
+									pos:  position{line: 635, col: 47, offset: 18633},
 									name: "Scope",
 								},
 							},
@@ -4186,49 +4705,49 @@ var g = &grammar{
 		},
 		{
 			name: "Scope",
-			pos:  position{line: 586, col: 1, offset: 17107},
+			pos:  position{line: 643, col: 1, offset: 18883},
 			expr: &actionExpr{
-				pos: position{line: 587, col: 5, offset: 17117},
+				pos: position{line: 644, col: 5, offset: 18893},
 				run: (*parser).callonScope1,
 				expr: &seqExpr{
-					pos: position{line: 587, col: 5, offset: 17117},
+					pos: position{line: 644, col: 5, offset: 18893},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 587, col: 5, offset: 17117},
+							pos:  position{line: 644, col: 5, offset: 18893},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 587, col: 8, offset: 17120},
+							pos:        position{line: 644, col: 8, offset: 18896},
 							val:        "=>",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 587, col: 13, offset: 17125},
+							pos:  position{line: 644, col: 13, offset: 18901},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 587, col: 16, offset: 17128},
+							pos:        position{line: 644, col: 16, offset: 18904},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 587, col: 20, offset: 17132},
+							pos:  position{line: 644, col: 20, offset: 18908},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 587, col: 23, offset: 17135},
+							pos:   position{line: 644, col: 23, offset: 18911},
 							label: "seq",
 							expr: &ruleRefExpr{
-								pos:  position{line: 587, col: 27, offset: 17139},
+								pos:  position{line: 644, col: 27, offset: 18915},
 								name: "Sequential",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 587, col: 38, offset: 17150},
+							pos:  position{line: 644, col: 38, offset: 18926},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 587, col: 41, offset: 17153},
+							pos:        position{line: 644, col: 41, offset: 18929},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -4238,63 +4757,63 @@ var g = &grammar{
 		},
 		{
 			name: "Locals",
-			pos:  position{line: 589, col: 1, offset: 17178},
+			pos:  position{line: 646, col: 1, offset: 18954},
 			expr: &actionExpr{
-				pos: position{line: 590, col: 5, offset: 17189},
+				pos: position{line: 647, col: 5, offset: 18965},
 				run: (*parser).callonLocals1,
 				expr: &seqExpr{
-					pos: position{line: 590, col: 5, offset: 17189},
+					pos: position{line: 647, col: 5, offset: 18965},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 590, col: 5, offset: 17189},
+							pos:  position{line: 647, col: 5, offset: 18965},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 590, col: 7, offset: 17191},
+							pos:        position{line: 647, col: 7, offset: 18967},
 							val:        "with",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 590, col: 14, offset: 17198},
+							pos:  position{line: 647, col: 14, offset: 18974},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 590, col: 16, offset: 17200},
+							pos:   position{line: 647, col: 16, offset: 18976},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 590, col: 22, offset: 17206},
+								pos:  position{line: 647, col: 22, offset: 18982},
 								name: "LocalsAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 590, col: 39, offset: 17223},
+							pos:   position{line: 647, col: 39, offset: 18999},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 590, col: 44, offset: 17228},
+								pos: position{line: 647, col: 44, offset: 19004},
 								expr: &actionExpr{
-									pos: position{line: 590, col: 45, offset: 17229},
+									pos: position{line: 647, col: 45, offset: 19005},
 									run: (*parser).callonLocals10,
 									expr: &seqExpr{
-										pos: position{line: 590, col: 45, offset: 17229},
+										pos: position{line: 647, col: 45, offset: 19005},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 590, col: 45, offset: 17229},
+												pos:  position{line: 647, col: 45, offset: 19005},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 590, col: 48, offset: 17232},
+												pos:        position{line: 647, col: 48, offset: 19008},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 590, col: 52, offset: 17236},
+												pos:  position{line: 647, col: 52, offset: 19012},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 590, col: 55, offset: 17239},
+												pos:   position{line: 647, col: 55, offset: 19015},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 590, col: 57, offset: 17241},
+													pos:  position{line: 647, col: 57, offset: 19017},
 													name: "LocalsAssignment",
 												},
 											},
@@ -4309,44 +4828,44 @@ var g = &grammar{
 		},
 		{
 			name: "LocalsAssignment",
-			pos:  position{line: 594, col: 1, offset: 17362},
+			pos:  position{line: 651, col: 1, offset: 19138},
 			expr: &actionExpr{
-				pos: position{line: 595, col: 5, offset: 17383},
+				pos: position{line: 652, col: 5, offset: 19159},
 				run: (*parser).callonLocalsAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 595, col: 5, offset: 17383},
+					pos: position{line: 652, col: 5, offset: 19159},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 595, col: 5, offset: 17383},
+							pos:   position{line: 652, col: 5, offset: 19159},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 595, col: 10, offset: 17388},
+								pos:  position{line: 652, col: 10, offset: 19164},
 								name: "IdentifierName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 595, col: 25, offset: 17403},
+							pos:   position{line: 652, col: 25, offset: 19179},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 595, col: 29, offset: 17407},
+								pos: position{line: 652, col: 29, offset: 19183},
 								expr: &seqExpr{
-									pos: position{line: 595, col: 30, offset: 17408},
+									pos: position{line: 652, col: 30, offset: 19184},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 595, col: 30, offset: 17408},
+											pos:  position{line: 652, col: 30, offset: 19184},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 595, col: 33, offset: 17411},
+											pos:        position{line: 652, col: 33, offset: 19187},
 											val:        "=",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 595, col: 37, offset: 17415},
+											pos:  position{line: 652, col: 37, offset: 19191},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 595, col: 40, offset: 17418},
+											pos:  position{line: 652, col: 40, offset: 19194},
 											name: "Expr",
 										},
 									},
@@ -4359,27 +4878,27 @@ var g = &grammar{
 		},
 		{
 			name: "YieldOp",
-			pos:  position{line: 603, col: 1, offset: 17639},
+			pos:  position{line: 660, col: 1, offset: 19415},
 			expr: &actionExpr{
-				pos: position{line: 604, col: 5, offset: 17651},
+				pos: position{line: 661, col: 5, offset: 19427},
 				run: (*parser).callonYieldOp1,
 				expr: &seqExpr{
-					pos: position{line: 604, col: 5, offset: 17651},
+					pos: position{line: 661, col: 5, offset: 19427},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 604, col: 5, offset: 17651},
+							pos:        position{line: 661, col: 5, offset: 19427},
 							val:        "yield",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 604, col: 13, offset: 17659},
+							pos:  position{line: 661, col: 13, offset: 19435},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 604, col: 15, offset: 17661},
+							pos:   position{line: 661, col: 15, offset: 19437},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 604, col: 21, offset: 17667},
+								pos:  position{line: 661, col: 21, offset: 19443},
 								name: "Exprs",
 							},
 						},
@@ -4389,30 +4908,30 @@ var g = &grammar{
 		},
 		{
 			name: "TypeArg",
-			pos:  position{line: 608, col: 1, offset: 17751},
+			pos:  position{line: 665, col: 1, offset: 19527},
 			expr: &actionExpr{
-				pos: position{line: 609, col: 5, offset: 17763},
+				pos: position{line: 666, col: 5, offset: 19539},
 				run: (*parser).callonTypeArg1,
 				expr: &seqExpr{
-					pos: position{line: 609, col: 5, offset: 17763},
+					pos: position{line: 666, col: 5, offset: 19539},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 609, col: 5, offset: 17763},
+							pos:  position{line: 666, col: 5, offset: 19539},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 609, col: 7, offset: 17765},
+							pos:  position{line: 666, col: 7, offset: 19541},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 609, col: 10, offset: 17768},
+							pos:  position{line: 666, col: 10, offset: 19544},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 609, col: 12, offset: 17770},
+							pos:   position{line: 666, col: 12, offset: 19546},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 609, col: 16, offset: 17774},
+								pos:  position{line: 666, col: 16, offset: 19550},
 								name: "Type",
 							},
 						},
@@ -4422,30 +4941,30 @@ var g = &grammar{
 		},
 		{
 			name: "AsArg",
-			pos:  position{line: 611, col: 1, offset: 17799},
+			pos:  position{line: 668, col: 1, offset: 19575},
 			expr: &actionExpr{
-				pos: position{line: 612, col: 5, offset: 17809},
+				pos: position{line: 669, col: 5, offset: 19585},
 				run: (*parser).callonAsArg1,
 				expr: &seqExpr{
-					pos: position{line: 612, col: 5, offset: 17809},
+					pos: position{line: 669, col: 5, offset: 19585},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 612, col: 5, offset: 17809},
+							pos:  position{line: 669, col: 5, offset: 19585},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 612, col: 7, offset: 17811},
+							pos:  position{line: 669, col: 7, offset: 19587},
 							name: "AS",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 612, col: 10, offset: 17814},
+							pos:  position{line: 669, col: 10, offset: 19590},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 612, col: 12, offset: 17816},
+							pos:   position{line: 669, col: 12, offset: 19592},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 612, col: 16, offset: 17820},
+								pos:  position{line: 669, col: 16, offset: 19596},
 								name: "Lval",
 							},
 						},
@@ -4455,58 +4974,58 @@ var g = &grammar{
 		},
 		{
 			name: "Lval",
-			pos:  position{line: 616, col: 1, offset: 17871},
+			pos:  position{line: 673, col: 1, offset: 19647},
 			expr: &ruleRefExpr{
-				pos:  position{line: 616, col: 8, offset: 17878},
+				pos:  position{line: 673, col: 8, offset: 19654},
 				name: "DerefExpr",
 			},
 		},
 		{
 			name: "Lvals",
-			pos:  position{line: 618, col: 1, offset: 17889},
+			pos:  position{line: 675, col: 1, offset: 19665},
 			expr: &actionExpr{
-				pos: position{line: 619, col: 5, offset: 17899},
+				pos: position{line: 676, col: 5, offset: 19675},
 				run: (*parser).callonLvals1,
 				expr: &seqExpr{
-					pos: position{line: 619, col: 5, offset: 17899},
+					pos: position{line: 676, col: 5, offset: 19675},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 619, col: 5, offset: 17899},
+							pos:   position{line: 676, col: 5, offset: 19675},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 619, col: 11, offset: 17905},
+								pos:  position{line: 676, col: 11, offset: 19681},
 								name: "Lval",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 619, col: 16, offset: 17910},
+							pos:   position{line: 676, col: 16, offset: 19686},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 619, col: 21, offset: 17915},
+								pos: position{line: 676, col: 21, offset: 19691},
 								expr: &actionExpr{
-									pos: position{line: 619, col: 22, offset: 17916},
+									pos: position{line: 676, col: 22, offset: 19692},
 									run: (*parser).callonLvals7,
 									expr: &seqExpr{
-										pos: position{line: 619, col: 22, offset: 17916},
+										pos: position{line: 676, col: 22, offset: 19692},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 619, col: 22, offset: 17916},
+												pos:  position{line: 676, col: 22, offset: 19692},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 619, col: 25, offset: 17919},
+												pos:        position{line: 676, col: 25, offset: 19695},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 619, col: 29, offset: 17923},
+												pos:  position{line: 676, col: 29, offset: 19699},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 619, col: 32, offset: 17926},
+												pos:   position{line: 676, col: 32, offset: 19702},
 												label: "lval",
 												expr: &ruleRefExpr{
-													pos:  position{line: 619, col: 37, offset: 17931},
+													pos:  position{line: 676, col: 37, offset: 19707},
 													name: "Lval",
 												},
 											},
@@ -4521,52 +5040,52 @@ var g = &grammar{
 		},
 		{
 			name: "FieldExpr",
-			pos:  position{line: 623, col: 1, offset: 18043},
+			pos:  position{line: 680, col: 1, offset: 19819},
 			expr: &ruleRefExpr{
-				pos:  position{line: 623, col: 13, offset: 18055},
+				pos:  position{line: 680, col: 13, offset: 19831},
 				name: "Lval",
 			},
 		},
 		{
 			name: "FieldExprs",
-			pos:  position{line: 625, col: 1, offset: 18061},
+			pos:  position{line: 682, col: 1, offset: 19837},
 			expr: &actionExpr{
-				pos: position{line: 626, col: 5, offset: 18076},
+				pos: position{line: 683, col: 5, offset: 19852},
 				run: (*parser).callonFieldExprs1,
 				expr: &seqExpr{
-					pos: position{line: 626, col: 5, offset: 18076},
+					pos: position{line: 683, col: 5, offset: 19852},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 626, col: 5, offset: 18076},
+							pos:   position{line: 683, col: 5, offset: 19852},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 626, col: 11, offset: 18082},
+								pos:  position{line: 683, col: 11, offset: 19858},
 								name: "FieldExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 626, col: 21, offset: 18092},
+							pos:   position{line: 683, col: 21, offset: 19868},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 626, col: 26, offset: 18097},
+								pos: position{line: 683, col: 26, offset: 19873},
 								expr: &seqExpr{
-									pos: position{line: 626, col: 27, offset: 18098},
+									pos: position{line: 683, col: 27, offset: 19874},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 626, col: 27, offset: 18098},
+											pos:  position{line: 683, col: 27, offset: 19874},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 626, col: 30, offset: 18101},
+											pos:        position{line: 683, col: 30, offset: 19877},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 626, col: 34, offset: 18105},
+											pos:  position{line: 683, col: 34, offset: 19881},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 626, col: 37, offset: 18108},
+											pos:  position{line: 683, col: 37, offset: 19884},
 											name: "FieldExpr",
 										},
 									},
@@ -4579,50 +5098,50 @@ var g = &grammar{
 		},
 		{
 			name: "Assignments",
-			pos:  position{line: 636, col: 1, offset: 18307},
+			pos:  position{line: 693, col: 1, offset: 20083},
 			expr: &actionExpr{
-				pos: position{line: 637, col: 5, offset: 18323},
+				pos: position{line: 694, col: 5, offset: 20099},
 				run: (*parser).callonAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 637, col: 5, offset: 18323},
+					pos: position{line: 694, col: 5, offset: 20099},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 637, col: 5, offset: 18323},
+							pos:   position{line: 694, col: 5, offset: 20099},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 637, col: 11, offset: 18329},
+								pos:  position{line: 694, col: 11, offset: 20105},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 637, col: 22, offset: 18340},
+							pos:   position{line: 694, col: 22, offset: 20116},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 637, col: 27, offset: 18345},
+								pos: position{line: 694, col: 27, offset: 20121},
 								expr: &actionExpr{
-									pos: position{line: 637, col: 28, offset: 18346},
+									pos: position{line: 694, col: 28, offset: 20122},
 									run: (*parser).callonAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 637, col: 28, offset: 18346},
+										pos: position{line: 694, col: 28, offset: 20122},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 637, col: 28, offset: 18346},
+												pos:  position{line: 694, col: 28, offset: 20122},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 637, col: 31, offset: 18349},
+												pos:        position{line: 694, col: 31, offset: 20125},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 637, col: 35, offset: 18353},
+												pos:  position{line: 694, col: 35, offset: 20129},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 637, col: 38, offset: 18356},
+												pos:   position{line: 694, col: 38, offset: 20132},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 637, col: 40, offset: 18358},
+													pos:  position{line: 694, col: 40, offset: 20134},
 													name: "Assignment",
 												},
 											},
@@ -4637,39 +5156,39 @@ var g = &grammar{
 		},
 		{
 			name: "Assignment",
-			pos:  position{line: 641, col: 1, offset: 18469},
+			pos:  position{line: 698, col: 1, offset: 20245},
 			expr: &actionExpr{
-				pos: position{line: 642, col: 5, offset: 18484},
+				pos: position{line: 699, col: 5, offset: 20260},
 				run: (*parser).callonAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 642, col: 5, offset: 18484},
+					pos: position{line: 699, col: 5, offset: 20260},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 642, col: 5, offset: 18484},
+							pos:   position{line: 699, col: 5, offset: 20260},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 642, col: 9, offset: 18488},
+								pos:  position{line: 699, col: 9, offset: 20264},
 								name: "Lval",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 642, col: 14, offset: 18493},
+							pos:  position{line: 699, col: 14, offset: 20269},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 642, col: 17, offset: 18496},
+							pos:        position{line: 699, col: 17, offset: 20272},
 							val:        ":=",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 642, col: 22, offset: 18501},
+							pos:  position{line: 699, col: 22, offset: 20277},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 642, col: 25, offset: 18504},
+							pos:   position{line: 699, col: 25, offset: 20280},
 							label: "rhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 642, col: 29, offset: 18508},
+								pos:  position{line: 699, col: 29, offset: 20284},
 								name: "Expr",
 							},
 						},
@@ -4679,69 +5198,69 @@ var g = &grammar{
 		},
 		{
 			name: "Expr",
-			pos:  position{line: 644, col: 1, offset: 18599},
+			pos:  position{line: 701, col: 1, offset: 20375},
 			expr: &ruleRefExpr{
-				pos:  position{line: 644, col: 8, offset: 18606},
+				pos:  position{line: 701, col: 8, offset: 20382},
 				name: "ConditionalExpr",
 			},
 		},
 		{
 			name: "ConditionalExpr",
-			pos:  position{line: 646, col: 1, offset: 18623},
+			pos:  position{line: 703, col: 1, offset: 20399},
 			expr: &actionExpr{
-				pos: position{line: 647, col: 5, offset: 18643},
+				pos: position{line: 704, col: 5, offset: 20419},
 				run: (*parser).callonConditionalExpr1,
 				expr: &seqExpr{
-					pos: position{line: 647, col: 5, offset: 18643},
+					pos: position{line: 704, col: 5, offset: 20419},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 647, col: 5, offset: 18643},
+							pos:   position{line: 704, col: 5, offset: 20419},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 647, col: 10, offset: 18648},
+								pos:  position{line: 704, col: 10, offset: 20424},
 								name: "LogicalOrExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 647, col: 24, offset: 18662},
+							pos:   position{line: 704, col: 24, offset: 20438},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 647, col: 28, offset: 18666},
+								pos: position{line: 704, col: 28, offset: 20442},
 								expr: &seqExpr{
-									pos: position{line: 647, col: 29, offset: 18667},
+									pos: position{line: 704, col: 29, offset: 20443},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 647, col: 29, offset: 18667},
+											pos:  position{line: 704, col: 29, offset: 20443},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 647, col: 32, offset: 18670},
+											pos:        position{line: 704, col: 32, offset: 20446},
 											val:        "?",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 647, col: 36, offset: 18674},
+											pos:  position{line: 704, col: 36, offset: 20450},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 647, col: 39, offset: 18677},
+											pos:  position{line: 704, col: 39, offset: 20453},
 											name: "Expr",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 647, col: 44, offset: 18682},
+											pos:  position{line: 704, col: 44, offset: 20458},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 647, col: 47, offset: 18685},
+											pos:        position{line: 704, col: 47, offset: 20461},
 											val:        ":",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 647, col: 51, offset: 18689},
+											pos:  position{line: 704, col: 51, offset: 20465},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 647, col: 54, offset: 18692},
+											pos:  position{line: 704, col: 54, offset: 20468},
 											name: "Expr",
 										},
 									},
@@ -4754,53 +5273,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalOrExpr",
-			pos:  position{line: 656, col: 1, offset: 18953},
+			pos:  position{line: 713, col: 1, offset: 20729},
 			expr: &actionExpr{
-				pos: position{line: 657, col: 5, offset: 18971},
+				pos: position{line: 714, col: 5, offset: 20747},
 				run: (*parser).callonLogicalOrExpr1,
 				expr: &seqExpr{
-					pos: position{line: 657, col: 5, offset: 18971},
+					pos: position{line: 714, col: 5, offset: 20747},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 657, col: 5, offset: 18971},
+							pos:   position{line: 714, col: 5, offset: 20747},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 657, col: 11, offset: 18977},
+								pos:  position{line: 714, col: 11, offset: 20753},
 								name: "LogicalAndExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 658, col: 5, offset: 18996},
+							pos:   position{line: 715, col: 5, offset: 20772},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 658, col: 10, offset: 19001},
+								pos: position{line: 715, col: 10, offset: 20777},
 								expr: &actionExpr{
-									pos: position{line: 658, col: 11, offset: 19002},
+									pos: position{line: 715, col: 11, offset: 20778},
 									run: (*parser).callonLogicalOrExpr7,
 									expr: &seqExpr{
-										pos: position{line: 658, col: 11, offset: 19002},
+										pos: position{line: 715, col: 11, offset: 20778},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 658, col: 11, offset: 19002},
+												pos:  position{line: 715, col: 11, offset: 20778},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 658, col: 14, offset: 19005},
+												pos:   position{line: 715, col: 14, offset: 20781},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 658, col: 17, offset: 19008},
+													pos:  position{line: 715, col: 17, offset: 20784},
 													name: "OrToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 658, col: 25, offset: 19016},
+												pos:  position{line: 715, col: 25, offset: 20792},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 658, col: 28, offset: 19019},
+												pos:   position{line: 715, col: 28, offset: 20795},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 658, col: 33, offset: 19024},
+													pos:  position{line: 715, col: 33, offset: 20800},
 													name: "LogicalAndExpr",
 												},
 											},
@@ -4815,53 +5334,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalAndExpr",
-			pos:  position{line: 662, col: 1, offset: 19142},
+			pos:  position{line: 719, col: 1, offset: 20918},
 			expr: &actionExpr{
-				pos: position{line: 663, col: 5, offset: 19161},
+				pos: position{line: 720, col: 5, offset: 20937},
 				run: (*parser).callonLogicalAndExpr1,
 				expr: &seqExpr{
-					pos: position{line: 663, col: 5, offset: 19161},
+					pos: position{line: 720, col: 5, offset: 20937},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 663, col: 5, offset: 19161},
+							pos:   position{line: 720, col: 5, offset: 20937},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 663, col: 11, offset: 19167},
+								pos:  position{line: 720, col: 11, offset: 20943},
 								name: "ComparisonExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 664, col: 5, offset: 19186},
+							pos:   position{line: 721, col: 5, offset: 20962},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 664, col: 10, offset: 19191},
+								pos: position{line: 721, col: 10, offset: 20967},
 								expr: &actionExpr{
-									pos: position{line: 664, col: 11, offset: 19192},
+									pos: position{line: 721, col: 11, offset: 20968},
 									run: (*parser).callonLogicalAndExpr7,
 									expr: &seqExpr{
-										pos: position{line: 664, col: 11, offset: 19192},
+										pos: position{line: 721, col: 11, offset: 20968},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 664, col: 11, offset: 19192},
+												pos:  position{line: 721, col: 11, offset: 20968},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 664, col: 14, offset: 19195},
+												pos:   position{line: 721, col: 14, offset: 20971},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 664, col: 17, offset: 19198},
+													pos:  position{line: 721, col: 17, offset: 20974},
 													name: "AndToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 664, col: 26, offset: 19207},
+												pos:  position{line: 721, col: 26, offset: 20983},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 664, col: 29, offset: 19210},
+												pos:   position{line: 721, col: 29, offset: 20986},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 664, col: 34, offset: 19215},
+													pos:  position{line: 721, col: 34, offset: 20991},
 													name: "ComparisonExpr",
 												},
 											},
@@ -4876,72 +5395,72 @@ var g = &grammar{
 		},
 		{
 			name: "ComparisonExpr",
-			pos:  position{line: 668, col: 1, offset: 19333},
+			pos:  position{line: 725, col: 1, offset: 21109},
 			expr: &actionExpr{
-				pos: position{line: 669, col: 5, offset: 19352},
+				pos: position{line: 726, col: 5, offset: 21128},
 				run: (*parser).callonComparisonExpr1,
 				expr: &seqExpr{
-					pos: position{line: 669, col: 5, offset: 19352},
+					pos: position{line: 726, col: 5, offset: 21128},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 669, col: 5, offset: 19352},
+							pos:   position{line: 726, col: 5, offset: 21128},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 669, col: 9, offset: 19356},
+								pos:  position{line: 726, col: 9, offset: 21132},
 								name: "AdditiveExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 669, col: 22, offset: 19369},
+							pos:   position{line: 726, col: 22, offset: 21145},
 							label: "opAndRHS",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 669, col: 31, offset: 19378},
+								pos: position{line: 726, col: 31, offset: 21154},
 								expr: &choiceExpr{
-									pos: position{line: 669, col: 32, offset: 19379},
+									pos: position{line: 726, col: 32, offset: 21155},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 669, col: 32, offset: 19379},
+											pos: position{line: 726, col: 32, offset: 21155},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 669, col: 32, offset: 19379},
+													pos:  position{line: 726, col: 32, offset: 21155},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 669, col: 35, offset: 19382},
+													pos:  position{line: 726, col: 35, offset: 21158},
 													name: "Comparator",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 669, col: 46, offset: 19393},
+													pos:  position{line: 726, col: 46, offset: 21169},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 669, col: 49, offset: 19396},
+													pos:  position{line: 726, col: 49, offset: 21172},
 													name: "AdditiveExpr",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 669, col: 64, offset: 19411},
+											pos: position{line: 726, col: 64, offset: 21187},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 669, col: 64, offset: 19411},
+													pos:  position{line: 726, col: 64, offset: 21187},
 													name: "__",
 												},
 												&actionExpr{
-													pos: position{line: 669, col: 68, offset: 19415},
+													pos: position{line: 726, col: 68, offset: 21191},
 													run: (*parser).callonComparisonExpr15,
 													expr: &litMatcher{
-														pos:        position{line: 669, col: 68, offset: 19415},
+														pos:        position{line: 726, col: 68, offset: 21191},
 														val:        "~",
 														ignoreCase: false,
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 669, col: 104, offset: 19451},
+													pos:  position{line: 726, col: 104, offset: 21227},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 669, col: 107, offset: 19454},
+													pos:  position{line: 726, col: 107, offset: 21230},
 													name: "Regexp",
 												},
 											},
@@ -4956,53 +5475,53 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpr",
-			pos:  position{line: 678, col: 1, offset: 19715},
+			pos:  position{line: 735, col: 1, offset: 21491},
 			expr: &actionExpr{
-				pos: position{line: 679, col: 5, offset: 19732},
+				pos: position{line: 736, col: 5, offset: 21508},
 				run: (*parser).callonAdditiveExpr1,
 				expr: &seqExpr{
-					pos: position{line: 679, col: 5, offset: 19732},
+					pos: position{line: 736, col: 5, offset: 21508},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 679, col: 5, offset: 19732},
+							pos:   position{line: 736, col: 5, offset: 21508},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 679, col: 11, offset: 19738},
+								pos:  position{line: 736, col: 11, offset: 21514},
 								name: "MultiplicativeExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 680, col: 5, offset: 19761},
+							pos:   position{line: 737, col: 5, offset: 21537},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 680, col: 10, offset: 19766},
+								pos: position{line: 737, col: 10, offset: 21542},
 								expr: &actionExpr{
-									pos: position{line: 680, col: 11, offset: 19767},
+									pos: position{line: 737, col: 11, offset: 21543},
 									run: (*parser).callonAdditiveExpr7,
 									expr: &seqExpr{
-										pos: position{line: 680, col: 11, offset: 19767},
+										pos: position{line: 737, col: 11, offset: 21543},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 680, col: 11, offset: 19767},
+												pos:  position{line: 737, col: 11, offset: 21543},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 680, col: 14, offset: 19770},
+												pos:   position{line: 737, col: 14, offset: 21546},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 680, col: 17, offset: 19773},
+													pos:  position{line: 737, col: 17, offset: 21549},
 													name: "AdditiveOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 680, col: 34, offset: 19790},
+												pos:  position{line: 737, col: 34, offset: 21566},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 680, col: 37, offset: 19793},
+												pos:   position{line: 737, col: 37, offset: 21569},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 680, col: 42, offset: 19798},
+													pos:  position{line: 737, col: 42, offset: 21574},
 													name: "MultiplicativeExpr",
 												},
 											},
@@ -5017,20 +5536,20 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 684, col: 1, offset: 19920},
+			pos:  position{line: 741, col: 1, offset: 21696},
 			expr: &actionExpr{
-				pos: position{line: 684, col: 20, offset: 19939},
+				pos: position{line: 741, col: 20, offset: 21715},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 684, col: 21, offset: 19940},
+					pos: position{line: 741, col: 21, offset: 21716},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 684, col: 21, offset: 19940},
+							pos:        position{line: 741, col: 21, offset: 21716},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 684, col: 27, offset: 19946},
+							pos:        position{line: 741, col: 27, offset: 21722},
 							val:        "-",
 							ignoreCase: false,
 						},
@@ -5040,53 +5559,53 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpr",
-			pos:  position{line: 686, col: 1, offset: 19983},
+			pos:  position{line: 743, col: 1, offset: 21759},
 			expr: &actionExpr{
-				pos: position{line: 687, col: 5, offset: 20006},
+				pos: position{line: 744, col: 5, offset: 21782},
 				run: (*parser).callonMultiplicativeExpr1,
 				expr: &seqExpr{
-					pos: position{line: 687, col: 5, offset: 20006},
+					pos: position{line: 744, col: 5, offset: 21782},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 687, col: 5, offset: 20006},
+							pos:   position{line: 744, col: 5, offset: 21782},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 687, col: 11, offset: 20012},
+								pos:  position{line: 744, col: 11, offset: 21788},
 								name: "NotExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 688, col: 5, offset: 20024},
+							pos:   position{line: 745, col: 5, offset: 21800},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 688, col: 10, offset: 20029},
+								pos: position{line: 745, col: 10, offset: 21805},
 								expr: &actionExpr{
-									pos: position{line: 688, col: 11, offset: 20030},
+									pos: position{line: 745, col: 11, offset: 21806},
 									run: (*parser).callonMultiplicativeExpr7,
 									expr: &seqExpr{
-										pos: position{line: 688, col: 11, offset: 20030},
+										pos: position{line: 745, col: 11, offset: 21806},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 688, col: 11, offset: 20030},
+												pos:  position{line: 745, col: 11, offset: 21806},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 688, col: 14, offset: 20033},
+												pos:   position{line: 745, col: 14, offset: 21809},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 688, col: 17, offset: 20036},
+													pos:  position{line: 745, col: 17, offset: 21812},
 													name: "MultiplicativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 688, col: 40, offset: 20059},
+												pos:  position{line: 745, col: 40, offset: 21835},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 688, col: 43, offset: 20062},
+												pos:   position{line: 745, col: 43, offset: 21838},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 688, col: 48, offset: 20067},
+													pos:  position{line: 745, col: 48, offset: 21843},
 													name: "NotExpr",
 												},
 											},
@@ -5101,25 +5620,25 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 692, col: 1, offset: 20178},
+			pos:  position{line: 749, col: 1, offset: 21954},
 			expr: &actionExpr{
-				pos: position{line: 692, col: 26, offset: 20203},
+				pos: position{line: 749, col: 26, offset: 21979},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 692, col: 27, offset: 20204},
+					pos: position{line: 749, col: 27, offset: 21980},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 692, col: 27, offset: 20204},
+							pos:        position{line: 749, col: 27, offset: 21980},
 							val:        "*",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 692, col: 33, offset: 20210},
+							pos:        position{line: 749, col: 33, offset: 21986},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 692, col: 39, offset: 20216},
+							pos:        position{line: 749, col: 39, offset: 21992},
 							val:        "%",
 							ignoreCase: false,
 						},
@@ -5129,30 +5648,30 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpr",
-			pos:  position{line: 694, col: 1, offset: 20253},
+			pos:  position{line: 751, col: 1, offset: 22029},
 			expr: &choiceExpr{
-				pos: position{line: 695, col: 5, offset: 20265},
+				pos: position{line: 752, col: 5, offset: 22041},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 695, col: 5, offset: 20265},
+						pos: position{line: 752, col: 5, offset: 22041},
 						run: (*parser).callonNotExpr2,
 						expr: &seqExpr{
-							pos: position{line: 695, col: 5, offset: 20265},
+							pos: position{line: 752, col: 5, offset: 22041},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 695, col: 5, offset: 20265},
+									pos:        position{line: 752, col: 5, offset: 22041},
 									val:        "!",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 695, col: 9, offset: 20269},
+									pos:  position{line: 752, col: 9, offset: 22045},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 695, col: 12, offset: 20272},
+									pos:   position{line: 752, col: 12, offset: 22048},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 695, col: 14, offset: 20274},
+										pos:  position{line: 752, col: 14, offset: 22050},
 										name: "NotExpr",
 									},
 								},
@@ -5160,7 +5679,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 698, col: 5, offset: 20383},
+						pos:  position{line: 755, col: 5, offset: 22159},
 						name: "NegationExpr",
 					},
 				},
@@ -5168,37 +5687,37 @@ var g = &grammar{
 		},
 		{
 			name: "NegationExpr",
-			pos:  position{line: 700, col: 1, offset: 20397},
+			pos:  position{line: 757, col: 1, offset: 22173},
 			expr: &choiceExpr{
-				pos: position{line: 701, col: 5, offset: 20414},
+				pos: position{line: 758, col: 5, offset: 22190},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 701, col: 5, offset: 20414},
+						pos: position{line: 758, col: 5, offset: 22190},
 						run: (*parser).callonNegationExpr2,
 						expr: &seqExpr{
-							pos: position{line: 701, col: 5, offset: 20414},
+							pos: position{line: 758, col: 5, offset: 22190},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 701, col: 5, offset: 20414},
+									pos: position{line: 758, col: 5, offset: 22190},
 									expr: &ruleRefExpr{
-										pos:  position{line: 701, col: 6, offset: 20415},
+										pos:  position{line: 758, col: 6, offset: 22191},
 										name: "Literal",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 701, col: 14, offset: 20423},
+									pos:        position{line: 758, col: 14, offset: 22199},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 701, col: 18, offset: 20427},
+									pos:  position{line: 758, col: 18, offset: 22203},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 701, col: 21, offset: 20430},
+									pos:   position{line: 758, col: 21, offset: 22206},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 701, col: 23, offset: 20432},
+										pos:  position{line: 758, col: 23, offset: 22208},
 										name: "FuncExpr",
 									},
 								},
@@ -5206,7 +5725,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 704, col: 5, offset: 20542},
+						pos:  position{line: 761, col: 5, offset: 22318},
 						name: "FuncExpr",
 					},
 				},
@@ -5214,31 +5733,31 @@ var g = &grammar{
 		},
 		{
 			name: "FuncExpr",
-			pos:  position{line: 706, col: 1, offset: 20552},
+			pos:  position{line: 763, col: 1, offset: 22328},
 			expr: &choiceExpr{
-				pos: position{line: 707, col: 5, offset: 20565},
+				pos: position{line: 764, col: 5, offset: 22341},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 707, col: 5, offset: 20565},
+						pos: position{line: 764, col: 5, offset: 22341},
 						run: (*parser).callonFuncExpr2,
 						expr: &seqExpr{
-							pos: position{line: 707, col: 5, offset: 20565},
+							pos: position{line: 764, col: 5, offset: 22341},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 707, col: 5, offset: 20565},
+									pos:   position{line: 764, col: 5, offset: 22341},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 707, col: 11, offset: 20571},
+										pos:  position{line: 764, col: 11, offset: 22347},
 										name: "Cast",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 707, col: 16, offset: 20576},
+									pos:   position{line: 764, col: 16, offset: 22352},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 707, col: 21, offset: 20581},
+										pos: position{line: 764, col: 21, offset: 22357},
 										expr: &ruleRefExpr{
-											pos:  position{line: 707, col: 22, offset: 20582},
+											pos:  position{line: 764, col: 22, offset: 22358},
 											name: "Deref",
 										},
 									},
@@ -5247,26 +5766,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 710, col: 5, offset: 20653},
+						pos: position{line: 767, col: 5, offset: 22429},
 						run: (*parser).callonFuncExpr9,
 						expr: &seqExpr{
-							pos: position{line: 710, col: 5, offset: 20653},
+							pos: position{line: 767, col: 5, offset: 22429},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 710, col: 5, offset: 20653},
+									pos:   position{line: 767, col: 5, offset: 22429},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 710, col: 11, offset: 20659},
+										pos:  position{line: 767, col: 11, offset: 22435},
 										name: "Function",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 710, col: 20, offset: 20668},
+									pos:   position{line: 767, col: 20, offset: 22444},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 710, col: 25, offset: 20673},
+										pos: position{line: 767, col: 25, offset: 22449},
 										expr: &ruleRefExpr{
-											pos:  position{line: 710, col: 26, offset: 20674},
+											pos:  position{line: 767, col: 26, offset: 22450},
 											name: "Deref",
 										},
 									},
@@ -5275,11 +5794,11 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 713, col: 5, offset: 20745},
+						pos:  position{line: 770, col: 5, offset: 22521},
 						name: "DerefExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 714, col: 5, offset: 20759},
+						pos:  position{line: 771, col: 5, offset: 22535},
 						name: "Primary",
 					},
 				},
@@ -5287,20 +5806,20 @@ var g = &grammar{
 		},
 		{
 			name: "FuncGuard",
-			pos:  position{line: 716, col: 1, offset: 20768},
+			pos:  position{line: 773, col: 1, offset: 22544},
 			expr: &seqExpr{
-				pos: position{line: 716, col: 13, offset: 20780},
+				pos: position{line: 773, col: 13, offset: 22556},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 716, col: 13, offset: 20780},
+						pos:  position{line: 773, col: 13, offset: 22556},
 						name: "NotFuncs",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 716, col: 22, offset: 20789},
+						pos:  position{line: 773, col: 22, offset: 22565},
 						name: "__",
 					},
 					&litMatcher{
-						pos:        position{line: 716, col: 25, offset: 20792},
+						pos:        position{line: 773, col: 25, offset: 22568},
 						val:        "(",
 						ignoreCase: false,
 					},
@@ -5309,17 +5828,17 @@ var g = &grammar{
 		},
 		{
 			name: "NotFuncs",
-			pos:  position{line: 718, col: 1, offset: 20797},
+			pos:  position{line: 775, col: 1, offset: 22573},
 			expr: &choiceExpr{
-				pos: position{line: 719, col: 5, offset: 20810},
+				pos: position{line: 776, col: 5, offset: 22586},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 719, col: 5, offset: 20810},
+						pos:        position{line: 776, col: 5, offset: 22586},
 						val:        "not",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 720, col: 5, offset: 20820},
+						pos:        position{line: 777, col: 5, offset: 22596},
 						val:        "select",
 						ignoreCase: false,
 					},
@@ -5328,57 +5847,57 @@ var g = &grammar{
 		},
 		{
 			name: "Cast",
-			pos:  position{line: 722, col: 1, offset: 20830},
+			pos:  position{line: 779, col: 1, offset: 22606},
 			expr: &actionExpr{
-				pos: position{line: 723, col: 5, offset: 20839},
+				pos: position{line: 780, col: 5, offset: 22615},
 				run: (*parser).callonCast1,
 				expr: &seqExpr{
-					pos: position{line: 723, col: 5, offset: 20839},
+					pos: position{line: 780, col: 5, offset: 22615},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 723, col: 5, offset: 20839},
+							pos:   position{line: 780, col: 5, offset: 22615},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 723, col: 9, offset: 20843},
+								pos:  position{line: 780, col: 9, offset: 22619},
 								name: "CastType",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 723, col: 18, offset: 20852},
+							pos:  position{line: 780, col: 18, offset: 22628},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 723, col: 21, offset: 20855},
+							pos:        position{line: 780, col: 21, offset: 22631},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 723, col: 25, offset: 20859},
+							pos:  position{line: 780, col: 25, offset: 22635},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 723, col: 28, offset: 20862},
+							pos:   position{line: 780, col: 28, offset: 22638},
 							label: "expr",
 							expr: &choiceExpr{
-								pos: position{line: 723, col: 34, offset: 20868},
+								pos: position{line: 780, col: 34, offset: 22644},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 723, col: 34, offset: 20868},
+										pos:  position{line: 780, col: 34, offset: 22644},
 										name: "OverExpr",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 723, col: 45, offset: 20879},
+										pos:  position{line: 780, col: 45, offset: 22655},
 										name: "Expr",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 723, col: 51, offset: 20885},
+							pos:  position{line: 780, col: 51, offset: 22661},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 723, col: 54, offset: 20888},
+							pos:        position{line: 780, col: 54, offset: 22664},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -5388,83 +5907,83 @@ var g = &grammar{
 		},
 		{
 			name: "Function",
-			pos:  position{line: 727, col: 1, offset: 20985},
+			pos:  position{line: 784, col: 1, offset: 22761},
 			expr: &choiceExpr{
-				pos: position{line: 728, col: 5, offset: 20998},
+				pos: position{line: 785, col: 5, offset: 22774},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 728, col: 5, offset: 20998},
+						pos:  position{line: 785, col: 5, offset: 22774},
 						name: "Grep",
 					},
 					&actionExpr{
-						pos: position{line: 730, col: 5, offset: 21053},
+						pos: position{line: 787, col: 5, offset: 22829},
 						run: (*parser).callonFunction3,
 						expr: &seqExpr{
-							pos: position{line: 730, col: 5, offset: 21053},
+							pos: position{line: 787, col: 5, offset: 22829},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 730, col: 5, offset: 21053},
+									pos:        position{line: 787, col: 5, offset: 22829},
 									val:        "regexp",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 730, col: 14, offset: 21062},
+									pos:  position{line: 787, col: 14, offset: 22838},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 730, col: 17, offset: 21065},
+									pos:        position{line: 787, col: 17, offset: 22841},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 730, col: 21, offset: 21069},
+									pos:  position{line: 787, col: 21, offset: 22845},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 730, col: 24, offset: 21072},
+									pos:   position{line: 787, col: 24, offset: 22848},
 									label: "arg0Text",
 									expr: &ruleRefExpr{
-										pos:  position{line: 730, col: 33, offset: 21081},
+										pos:  position{line: 787, col: 33, offset: 22857},
 										name: "RegexpPattern",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 730, col: 47, offset: 21095},
+									pos:  position{line: 787, col: 47, offset: 22871},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 730, col: 50, offset: 21098},
+									pos:        position{line: 787, col: 50, offset: 22874},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 730, col: 54, offset: 21102},
+									pos:  position{line: 787, col: 54, offset: 22878},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 730, col: 57, offset: 21105},
+									pos:   position{line: 787, col: 57, offset: 22881},
 									label: "arg1",
 									expr: &ruleRefExpr{
-										pos:  position{line: 730, col: 62, offset: 21110},
+										pos:  position{line: 787, col: 62, offset: 22886},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 730, col: 67, offset: 21115},
+									pos:  position{line: 787, col: 67, offset: 22891},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 730, col: 70, offset: 21118},
+									pos:        position{line: 787, col: 70, offset: 22894},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 730, col: 74, offset: 21122},
+									pos:   position{line: 787, col: 74, offset: 22898},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 730, col: 80, offset: 21128},
+										pos: position{line: 787, col: 80, offset: 22904},
 										expr: &ruleRefExpr{
-											pos:  position{line: 730, col: 80, offset: 21128},
+											pos:  position{line: 787, col: 80, offset: 22904},
 											name: "WhereClause",
 										},
 									},
@@ -5473,169 +5992,342 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 734, col: 5, offset: 21376},
-						run: (*parser).callonFunction21,
+						pos: position{line: 791, col: 5, offset: 23152},
+						run: (*parser).callonFunction21,
+						expr: &seqExpr{
+							pos: position{line: 791, col: 5, offset: 23152},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 791, col: 5, offset: 23152},
+									expr: &ruleRefExpr{
+										pos:  position{line: 791, col: 6, offset: 23153},
+										name: "FuncGuard",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 791, col: 16, offset: 23163},
+									label: "fn",
+									expr: &ruleRefExpr{
+										pos:  position{line: 791, col: 19, offset: 23166},
+										name: "IdentifierName",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 791, col: 34, offset: 23181},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 791, col: 37, offset: 23184},
+									val:        "(",
+									ignoreCase: false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 791, col: 41, offset: 23188},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 791, col: 44, offset: 23191},
+									label: "args",
+									expr: &ruleRefExpr{
+										pos:  position{line: 791, col: 49, offset: 23196},
+										name: "FunctionArgs",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 791, col: 62, offset: 23209},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 791, col: 65, offset: 23212},
+									val:        ")",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 791, col: 69, offset: 23216},
+									label: "where",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 791, col: 75, offset: 23222},
+										expr: &ruleRefExpr{
+											pos:  position{line: 791, col: 75, offset: 23222},
+											name: "WhereClause",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FunctionArgs",
+			pos:  position{line: 795, col: 1, offset: 23343},
+			expr: &choiceExpr{
+				pos: position{line: 796, col: 5, offset: 23360},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 796, col: 5, offset: 23360},
+						run: (*parser).callonFunctionArgs2,
+						expr: &labeledExpr{
+							pos:   position{line: 796, col: 5, offset: 23360},
+							label: "o",
+							expr: &ruleRefExpr{
+								pos:  position{line: 796, col: 7, offset: 23362},
+								name: "OverExpr",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 797, col: 5, offset: 23408},
+						run: (*parser).callonFunctionArgs5,
+						expr: &seqExpr{
+							pos: position{line: 797, col: 5, offset: 23408},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 797, col: 5, offset: 23408},
+									label: "first",
+									expr: &ruleRefExpr{
+										pos:  position{line: 797, col: 11, offset: 23414},
+										name: "FunctionArg",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 797, col: 23, offset: 23426},
+									label: "rest",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 797, col: 28, offset: 23431},
+										expr: &actionExpr{
+											pos: position{line: 797, col: 29, offset: 23432},
+											run: (*parser).callonFunctionArgs11,
+											expr: &seqExpr{
+												pos: position{line: 797, col: 29, offset: 23432},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 797, col: 29, offset: 23432},
+														name: "__",
+													},
+													&litMatcher{
+														pos:        position{line: 797, col: 32, offset: 23435},
+														val:        ",",
+														ignoreCase: false,
+													},
+													&ruleRefExpr{
+														pos:  position{line: 797, col: 36, offset: 23439},
+														name: "__",
+													},
+													&labeledExpr{
+														pos:   position{line: 797, col: 39, offset: 23442},
+														label: "e",
+														expr: &ruleRefExpr{
+															pos:  position{line: 797, col: 41, offset: 23444},
+															name: "FunctionArg",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 800, col: 5, offset: 23563},
+						run: (*parser).callonFunctionArgs18,
+						expr: &ruleRefExpr{
+							pos:  position{line: 800, col: 5, offset: 23563},
+							name: "__",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FunctionArg",
+			pos:  position{line: 802, col: 1, offset: 23599},
+			expr: &choiceExpr{
+				pos: position{line: 803, col: 5, offset: 23615},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 803, col: 5, offset: 23615},
+						name: "Lambda",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 804, col: 5, offset: 23626},
+						name: "Expr",
+					},
+				},
+			},
+		},
+		{
+			name: "Lambda",
+			pos:  position{line: 806, col: 1, offset: 23632},
+			expr: &actionExpr{
+				pos: position{line: 807, col: 5, offset: 23643},
+				run: (*parser).callonLambda1,
+				expr: &seqExpr{
+					pos: position{line: 807, col: 5, offset: 23643},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 807, col: 5, offset: 23643},
+							label: "params",
+							expr: &ruleRefExpr{
+								pos:  position{line: 807, col: 12, offset: 23650},
+								name: "LambdaParams",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 807, col: 25, offset: 23663},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 807, col: 28, offset: 23666},
+							val:        "=>",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 807, col: 33, offset: 23671},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 807, col: 36, offset: 23674},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 807, col: 41, offset: 23679},
+								name: "Expr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LambdaParams",
+			pos:  position{line: 811, col: 1, offset: 23784},
+			expr: &choiceExpr{
+				pos: position{line: 812, col: 5, offset: 23801},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 812, col: 5, offset: 23801},
+						run: (*parser).callonLambdaParams2,
+						expr: &labeledExpr{
+							pos:   position{line: 812, col: 5, offset: 23801},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 812, col: 8, offset: 23804},
+								name: "IdentifierName",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 813, col: 5, offset: 23857},
+						run: (*parser).callonLambdaParams5,
 						expr: &seqExpr{
-							pos: position{line: 734, col: 5, offset: 21376},
+							pos: position{line: 813, col: 5, offset: 23857},
 							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 734, col: 5, offset: 21376},
-									expr: &ruleRefExpr{
-										pos:  position{line: 734, col: 6, offset: 21377},
-										name: "FuncGuard",
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 734, col: 16, offset: 21387},
-									label: "fn",
-									expr: &ruleRefExpr{
-										pos:  position{line: 734, col: 19, offset: 21390},
-										name: "IdentifierName",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 734, col: 34, offset: 21405},
-									name: "__",
-								},
 								&litMatcher{
-									pos:        position{line: 734, col: 37, offset: 21408},
+									pos:        position{line: 813, col: 5, offset: 23857},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 734, col: 41, offset: 21412},
+									pos:  position{line: 813, col: 9, offset: 23861},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 734, col: 44, offset: 21415},
-									label: "args",
+									pos:   position{line: 813, col: 12, offset: 23864},
+									label: "ids",
 									expr: &ruleRefExpr{
-										pos:  position{line: 734, col: 49, offset: 21420},
-										name: "FunctionArgs",
+										pos:  position{line: 813, col: 16, offset: 23868},
+										name: "IdentifierNames",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 734, col: 62, offset: 21433},
+									pos:  position{line: 813, col: 32, offset: 23884},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 734, col: 65, offset: 21436},
+									pos:        position{line: 813, col: 35, offset: 23887},
 									val:        ")",
 									ignoreCase: false,
 								},
-								&labeledExpr{
-									pos:   position{line: 734, col: 69, offset: 21440},
-									label: "where",
-									expr: &zeroOrOneExpr{
-										pos: position{line: 734, col: 75, offset: 21446},
-										expr: &ruleRefExpr{
-											pos:  position{line: 734, col: 75, offset: 21446},
-											name: "WhereClause",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "FunctionArgs",
-			pos:  position{line: 738, col: 1, offset: 21567},
-			expr: &choiceExpr{
-				pos: position{line: 739, col: 5, offset: 21584},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 739, col: 5, offset: 21584},
-						run: (*parser).callonFunctionArgs2,
-						expr: &labeledExpr{
-							pos:   position{line: 739, col: 5, offset: 21584},
-							label: "o",
-							expr: &ruleRefExpr{
-								pos:  position{line: 739, col: 7, offset: 21586},
-								name: "OverExpr",
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 740, col: 5, offset: 21632},
-						name: "OptionalExprs",
-					},
 				},
 			},
 		},
 		{
 			name: "Grep",
-			pos:  position{line: 742, col: 1, offset: 21647},
+			pos:  position{line: 815, col: 1, offset: 23912},
 			expr: &actionExpr{
-				pos: position{line: 743, col: 5, offset: 21656},
+				pos: position{line: 816, col: 5, offset: 23921},
 				run: (*parser).callonGrep1,
 				expr: &seqExpr{
-					pos: position{line: 743, col: 5, offset: 21656},
+					pos: position{line: 816, col: 5, offset: 23921},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 743, col: 5, offset: 21656},
+							pos:        position{line: 816, col: 5, offset: 23921},
 							val:        "grep",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 743, col: 12, offset: 21663},
+							pos:  position{line: 816, col: 12, offset: 23928},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 743, col: 15, offset: 21666},
+							pos:        position{line: 816, col: 15, offset: 23931},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 743, col: 19, offset: 21670},
+							pos:  position{line: 816, col: 19, offset: 23935},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 743, col: 22, offset: 21673},
+							pos:   position{line: 816, col: 22, offset: 23938},
 							label: "pattern",
 							expr: &ruleRefExpr{
-								pos:  position{line: 743, col: 30, offset: 21681},
+								pos:  position{line: 816, col: 30, offset: 23946},
 								name: "Pattern",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 743, col: 38, offset: 21689},
+							pos:  position{line: 816, col: 38, offset: 23954},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 743, col: 42, offset: 21693},
+							pos:   position{line: 816, col: 42, offset: 23958},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 743, col: 46, offset: 21697},
+								pos: position{line: 816, col: 46, offset: 23962},
 								expr: &seqExpr{
-									pos: position{line: 743, col: 47, offset: 21698},
+									pos: position{line: 816, col: 47, offset: 23963},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 743, col: 47, offset: 21698},
+											pos:        position{line: 816, col: 47, offset: 23963},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 743, col: 51, offset: 21702},
+											pos:  position{line: 816, col: 51, offset: 23967},
 											name: "__",
 										},
 										&choiceExpr{
-											pos: position{line: 743, col: 56, offset: 21707},
+											pos: position{line: 816, col: 56, offset: 23972},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 743, col: 56, offset: 21707},
+													pos:  position{line: 816, col: 56, offset: 23972},
 													name: "OverExpr",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 743, col: 67, offset: 21718},
+													pos:  position{line: 816, col: 67, offset: 23983},
 													name: "Expr",
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 743, col: 73, offset: 21724},
+											pos:  position{line: 816, col: 73, offset: 23989},
 											name: "__",
 										},
 									},
@@ -5643,7 +6335,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 743, col: 78, offset: 21729},
+							pos:        position{line: 816, col: 78, offset: 23994},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -5653,26 +6345,26 @@ var g = &grammar{
 		},
 		{
 			name: "Pattern",
-			pos:  position{line: 751, col: 1, offset: 21970},
+			pos:  position{line: 824, col: 1, offset: 24235},
 			expr: &choiceExpr{
-				pos: position{line: 752, col: 5, offset: 21982},
+				pos: position{line: 825, col: 5, offset: 24247},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 752, col: 5, offset: 21982},
+						pos:  position{line: 825, col: 5, offset: 24247},
 						name: "Regexp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 753, col: 5, offset: 21993},
+						pos:  position{line: 826, col: 5, offset: 24258},
 						name: "Glob",
 					},
 					&actionExpr{
-						pos: position{line: 754, col: 5, offset: 22002},
+						pos: position{line: 827, col: 5, offset: 24267},
 						run: (*parser).callonPattern4,
 						expr: &labeledExpr{
-							pos:   position{line: 754, col: 5, offset: 22002},
+							pos:   position{line: 827, col: 5, offset: 24267},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 754, col: 7, offset: 22004},
+								pos:  position{line: 827, col: 7, offset: 24269},
 								name: "QuotedString",
 							},
 						},
@@ -5682,19 +6374,19 @@ var g = &grammar{
 		},
 		{
 			name: "OptionalExprs",
-			pos:  position{line: 758, col: 1, offset: 22096},
+			pos:  position{line: 831, col: 1, offset: 24361},
 			expr: &choiceExpr{
-				pos: position{line: 759, col: 5, offset: 22114},
+				pos: position{line: 832, col: 5, offset: 24379},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 759, col: 5, offset: 22114},
+						pos:  position{line: 832, col: 5, offset: 24379},
 						name: "Exprs",
 					},
 					&actionExpr{
-						pos: position{line: 760, col: 5, offset: 22124},
+						pos: position{line: 833, col: 5, offset: 24389},
 						run: (*parser).callonOptionalExprs3,
 						expr: &ruleRefExpr{
-							pos:  position{line: 760, col: 5, offset: 22124},
+							pos:  position{line: 833, col: 5, offset: 24389},
 							name: "__",
 						},
 					},
@@ -5703,50 +6395,50 @@ var g = &grammar{
 		},
 		{
 			name: "Exprs",
-			pos:  position{line: 762, col: 1, offset: 22160},
+			pos:  position{line: 835, col: 1, offset: 24425},
 			expr: &actionExpr{
-				pos: position{line: 763, col: 5, offset: 22170},
+				pos: position{line: 836, col: 5, offset: 24435},
 				run: (*parser).callonExprs1,
 				expr: &seqExpr{
-					pos: position{line: 763, col: 5, offset: 22170},
+					pos: position{line: 836, col: 5, offset: 24435},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 763, col: 5, offset: 22170},
+							pos:   position{line: 836, col: 5, offset: 24435},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 763, col: 11, offset: 22176},
+								pos:  position{line: 836, col: 11, offset: 24441},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 763, col: 16, offset: 22181},
+							pos:   position{line: 836, col: 16, offset: 24446},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 763, col: 21, offset: 22186},
+								pos: position{line: 836, col: 21, offset: 24451},
 								expr: &actionExpr{
-									pos: position{line: 763, col: 22, offset: 22187},
+									pos: position{line: 836, col: 22, offset: 24452},
 									run: (*parser).callonExprs7,
 									expr: &seqExpr{
-										pos: position{line: 763, col: 22, offset: 22187},
+										pos: position{line: 836, col: 22, offset: 24452},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 763, col: 22, offset: 22187},
+												pos:  position{line: 836, col: 22, offset: 24452},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 763, col: 25, offset: 22190},
+												pos:        position{line: 836, col: 25, offset: 24455},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 763, col: 29, offset: 22194},
+												pos:  position{line: 836, col: 29, offset: 24459},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 763, col: 32, offset: 22197},
+												pos:   position{line: 836, col: 32, offset: 24462},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 763, col: 34, offset: 22199},
+													pos:  position{line: 836, col: 34, offset: 24464},
 													name: "Expr",
 												},
 											},
@@ -5761,35 +6453,35 @@ var g = &grammar{
 		},
 		{
 			name: "DerefExpr",
-			pos:  position{line: 767, col: 1, offset: 22308},
+			pos:  position{line: 840, col: 1, offset: 24573},
 			expr: &actionExpr{
-				pos: position{line: 768, col: 5, offset: 22322},
+				pos: position{line: 841, col: 5, offset: 24587},
 				run: (*parser).callonDerefExpr1,
 				expr: &seqExpr{
-					pos: position{line: 768, col: 5, offset: 22322},
+					pos: position{line: 841, col: 5, offset: 24587},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 768, col: 5, offset: 22322},
+							pos: position{line: 841, col: 5, offset: 24587},
 							expr: &ruleRefExpr{
-								pos:  position{line: 768, col: 6, offset: 22323},
+								pos:  position{line: 841, col: 6, offset: 24588},
 								name: "IP6",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 768, col: 10, offset: 22327},
+							pos:   position{line: 841, col: 10, offset: 24592},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 768, col: 16, offset: 22333},
+								pos:  position{line: 841, col: 16, offset: 24598},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 768, col: 27, offset: 22344},
+							pos:   position{line: 841, col: 27, offset: 24609},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 768, col: 32, offset: 22349},
+								pos: position{line: 841, col: 32, offset: 24614},
 								expr: &ruleRefExpr{
-									pos:  position{line: 768, col: 33, offset: 22350},
+									pos:  position{line: 841, col: 33, offset: 24615},
 									name: "Deref",
 								},
 							},
@@ -5800,55 +6492,55 @@ var g = &grammar{
 		},
 		{
 			name: "Deref",
-			pos:  position{line: 772, col: 1, offset: 22418},
+			pos:  position{line: 845, col: 1, offset: 24683},
 			expr: &choiceExpr{
-				pos: position{line: 773, col: 5, offset: 22428},
+				pos: position{line: 846, col: 5, offset: 24693},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 773, col: 5, offset: 22428},
+						pos: position{line: 846, col: 5, offset: 24693},
 						run: (*parser).callonDeref2,
 						expr: &seqExpr{
-							pos: position{line: 773, col: 5, offset: 22428},
+							pos: position{line: 846, col: 5, offset: 24693},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 773, col: 5, offset: 22428},
+									pos:        position{line: 846, col: 5, offset: 24693},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 773, col: 9, offset: 22432},
+									pos:   position{line: 846, col: 9, offset: 24697},
 									label: "from",
 									expr: &ruleRefExpr{
-										pos:  position{line: 773, col: 14, offset: 22437},
+										pos:  position{line: 846, col: 14, offset: 24702},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 773, col: 27, offset: 22450},
+									pos:  position{line: 846, col: 27, offset: 24715},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 773, col: 30, offset: 22453},
+									pos:        position{line: 846, col: 30, offset: 24718},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 773, col: 34, offset: 22457},
+									pos:  position{line: 846, col: 34, offset: 24722},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 773, col: 37, offset: 22460},
+									pos:   position{line: 846, col: 37, offset: 24725},
 									label: "to",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 773, col: 40, offset: 22463},
+										pos: position{line: 846, col: 40, offset: 24728},
 										expr: &ruleRefExpr{
-											pos:  position{line: 773, col: 40, offset: 22463},
+											pos:  position{line: 846, col: 40, offset: 24728},
 											name: "AdditiveExpr",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 773, col: 54, offset: 22477},
+									pos:        position{line: 846, col: 54, offset: 24742},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -5856,39 +6548,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 779, col: 5, offset: 22648},
+						pos: position{line: 852, col: 5, offset: 24913},
 						run: (*parser).callonDeref14,
 						expr: &seqExpr{
-							pos: position{line: 779, col: 5, offset: 22648},
+							pos: position{line: 852, col: 5, offset: 24913},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 779, col: 5, offset: 22648},
+									pos:        position{line: 852, col: 5, offset: 24913},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 779, col: 9, offset: 22652},
+									pos:  position{line: 852, col: 9, offset: 24917},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 779, col: 12, offset: 22655},
+									pos:        position{line: 852, col: 12, offset: 24920},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 779, col: 16, offset: 22659},
+									pos:  position{line: 852, col: 16, offset: 24924},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 779, col: 19, offset: 22662},
+									pos:   position{line: 852, col: 19, offset: 24927},
 									label: "to",
 									expr: &ruleRefExpr{
-										pos:  position{line: 779, col: 22, offset: 22665},
+										pos:  position{line: 852, col: 22, offset: 24930},
 										name: "AdditiveExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 779, col: 35, offset: 22678},
+									pos:        position{line: 852, col: 35, offset: 24943},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -5896,26 +6588,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 785, col: 5, offset: 22849},
+						pos: position{line: 858, col: 5, offset: 25114},
 						run: (*parser).callonDeref23,
 						expr: &seqExpr{
-							pos: position{line: 785, col: 5, offset: 22849},
+							pos: position{line: 858, col: 5, offset: 25114},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 785, col: 5, offset: 22849},
+									pos:        position{line: 858, col: 5, offset: 25114},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 785, col: 9, offset: 22853},
+									pos:   position{line: 858, col: 9, offset: 25118},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 785, col: 14, offset: 22858},
+										pos:  position{line: 858, col: 14, offset: 25123},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 785, col: 19, offset: 22863},
+									pos:        position{line: 858, col: 19, offset: 25128},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -5923,21 +6615,21 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 786, col: 5, offset: 22912},
+						pos: position{line: 859, col: 5, offset: 25177},
 						run: (*parser).callonDeref29,
 						expr: &seqExpr{
-							pos: position{line: 786, col: 5, offset: 22912},
+							pos: position{line: 859, col: 5, offset: 25177},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 786, col: 5, offset: 22912},
+									pos:        position{line: 859, col: 5, offset: 25177},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 786, col: 9, offset: 22916},
+									pos:   position{line: 859, col: 9, offset: 25181},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 786, col: 12, offset: 22919},
+										pos:  position{line: 859, col: 12, offset: 25184},
 										name: "Identifier",
 									},
 								},
@@ -5949,59 +6641,59 @@ var g = &grammar{
 		},
 		{
 			name: "Primary",
-			pos:  position{line: 788, col: 1, offset: 22970},
+			pos:  position{line: 861, col: 1, offset: 25235},
 			expr: &choiceExpr{
-				pos: position{line: 789, col: 5, offset: 22982},
+				pos: position{line: 862, col: 5, offset: 25247},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 789, col: 5, offset: 22982},
+						pos:  position{line: 862, col: 5, offset: 25247},
 						name: "Record",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 790, col: 5, offset: 22993},
+						pos:  position{line: 863, col: 5, offset: 25258},
 						name: "Array",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 791, col: 5, offset: 23003},
+						pos:  position{line: 864, col: 5, offset: 25268},
 						name: "Set",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 792, col: 5, offset: 23011},
+						pos:  position{line: 865, col: 5, offset: 25276},
 						name: "Map",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 793, col: 5, offset: 23019},
+						pos:  position{line: 866, col: 5, offset: 25284},
 						name: "Literal",
 					},
 					&actionExpr{
-						pos: position{line: 794, col: 5, offset: 23031},
+						pos: position{line: 867, col: 5, offset: 25296},
 						run: (*parser).callonPrimary7,
 						expr: &seqExpr{
-							pos: position{line: 794, col: 5, offset: 23031},
+							pos: position{line: 867, col: 5, offset: 25296},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 794, col: 5, offset: 23031},
+									pos:        position{line: 867, col: 5, offset: 25296},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 794, col: 9, offset: 23035},
+									pos:  position{line: 867, col: 9, offset: 25300},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 794, col: 12, offset: 23038},
+									pos:   position{line: 867, col: 12, offset: 25303},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 794, col: 17, offset: 23043},
+										pos:  position{line: 867, col: 17, offset: 25308},
 										name: "OverExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 794, col: 26, offset: 23052},
+									pos:  position{line: 867, col: 26, offset: 25317},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 794, col: 29, offset: 23055},
+									pos:        position{line: 867, col: 29, offset: 25320},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -6009,34 +6701,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 795, col: 5, offset: 23085},
+						pos: position{line: 868, col: 5, offset: 25350},
 						run: (*parser).callonPrimary15,
 						expr: &seqExpr{
-							pos: position{line: 795, col: 5, offset: 23085},
+							pos: position{line: 868, col: 5, offset: 25350},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 795, col: 5, offset: 23085},
+									pos:        position{line: 868, col: 5, offset: 25350},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 795, col: 9, offset: 23089},
+									pos:  position{line: 868, col: 9, offset: 25354},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 795, col: 12, offset: 23092},
+									pos:   position{line: 868, col: 12, offset: 25357},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 795, col: 17, offset: 23097},
+										pos:  position{line: 868, col: 17, offset: 25362},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 795, col: 22, offset: 23102},
+									pos:  position{line: 868, col: 22, offset: 25367},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 795, col: 25, offset: 23105},
+									pos:        position{line: 868, col: 25, offset: 25370},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -6048,59 +6740,59 @@ var g = &grammar{
 		},
 		{
 			name: "OverExpr",
-			pos:  position{line: 797, col: 1, offset: 23131},
+			pos:  position{line: 870, col: 1, offset: 25396},
 			expr: &actionExpr{
-				pos: position{line: 798, col: 5, offset: 23144},
+				pos: position{line: 871, col: 5, offset: 25409},
 				run: (*parser).callonOverExpr1,
 				expr: &seqExpr{
-					pos: position{line: 798, col: 5, offset: 23144},
+					pos: position{line: 871, col: 5, offset: 25409},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 798, col: 5, offset: 23144},
+							pos:        position{line: 871, col: 5, offset: 25409},
 							val:        "over",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 798, col: 12, offset: 23151},
+							pos:  position{line: 871, col: 12, offset: 25416},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 798, col: 14, offset: 23153},
+							pos:   position{line: 871, col: 14, offset: 25418},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 798, col: 20, offset: 23159},
+								pos:  position{line: 871, col: 20, offset: 25424},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 798, col: 26, offset: 23165},
+							pos:   position{line: 871, col: 26, offset: 25430},
 							label: "locals",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 798, col: 33, offset: 23172},
+								pos: position{line: 871, col: 33, offset: 25437},
 								expr: &ruleRefExpr{
-									pos:  position{line: 798, col: 33, offset: 23172},
+									pos:  position{line: 871, col: 33, offset: 25437},
 									name: "Locals",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 798, col: 41, offset: 23180},
+							pos:  position{line: 871, col: 41, offset: 25445},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 798, col: 44, offset: 23183},
+							pos:        position{line: 871, col: 44, offset: 25448},
 							val:        "|",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 798, col: 48, offset: 23187},
+							pos:  position{line: 871, col: 48, offset: 25452},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 798, col: 51, offset: 23190},
+							pos:   position{line: 871, col: 51, offset: 25455},
 							label: "scope",
 							expr: &ruleRefExpr{
-								pos:  position{line: 798, col: 57, offset: 23196},
+								pos:  position{line: 871, col: 57, offset: 25461},
 								name: "Sequential",
 							},
 						},
@@ -6110,36 +6802,36 @@ var g = &grammar{
 		},
 		{
 			name: "Record",
-			pos:  position{line: 802, col: 1, offset: 23327},
+			pos:  position{line: 875, col: 1, offset: 25592},
 			expr: &actionExpr{
-				pos: position{line: 803, col: 5, offset: 23338},
+				pos: position{line: 876, col: 5, offset: 25603},
 				run: (*parser).callonRecord1,
 				expr: &seqExpr{
-					pos: position{line: 803, col: 5, offset: 23338},
+					pos: position{line: 876, col: 5, offset: 25603},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 803, col: 5, offset: 23338},
+							pos:        position{line: 876, col: 5, offset: 25603},
 							val:        "{",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 9, offset: 23342},
+							pos:  position{line: 876, col: 9, offset: 25607},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 803, col: 12, offset: 23345},
+							pos:   position{line: 876, col: 12, offset: 25610},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 803, col: 18, offset: 23351},
+								pos:  position{line: 876, col: 18, offset: 25616},
 								name: "RecordElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 30, offset: 23363},
+							pos:  position{line: 876, col: 30, offset: 25628},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 803, col: 33, offset: 23366},
+							pos:        position{line: 876, col: 33, offset: 25631},
 							val:        "}",
 							ignoreCase: false,
 						},
@@ -6149,31 +6841,31 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElems",
-			pos:  position{line: 807, col: 1, offset: 23456},
+			pos:  position{line: 880, col: 1, offset: 25721},
 			expr: &choiceExpr{
-				pos: position{line: 808, col: 5, offset: 23472},
+				pos: position{line: 881, col: 5, offset: 25737},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 808, col: 5, offset: 23472},
+						pos: position{line: 881, col: 5, offset: 25737},
 						run: (*parser).callonRecordElems2,
 						expr: &seqExpr{
-							pos: position{line: 808, col: 5, offset: 23472},
+							pos: position{line: 881, col: 5, offset: 25737},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 808, col: 5, offset: 23472},
+									pos:   position{line: 881, col: 5, offset: 25737},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 808, col: 11, offset: 23478},
+										pos:  position{line: 881, col: 11, offset: 25743},
 										name: "RecordElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 808, col: 22, offset: 23489},
+									pos:   position{line: 881, col: 22, offset: 25754},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 808, col: 27, offset: 23494},
+										pos: position{line: 881, col: 27, offset: 25759},
 										expr: &ruleRefExpr{
-											pos:  position{line: 808, col: 27, offset: 23494},
+											pos:  position{line: 881, col: 27, offset: 25759},
 											name: "RecordElemTail",
 										},
 									},
@@ -6182,10 +6874,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 811, col: 5, offset: 23593},
+						pos: position{line: 884, col: 5, offset: 25858},
 						run: (*parser).callonRecordElems9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 811, col: 5, offset: 23593},
+							pos:  position{line: 884, col: 5, offset: 25858},
 							name: "__",
 						},
 					},
@@ -6194,31 +6886,31 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElemTail",
-			pos:  position{line: 813, col: 1, offset: 23629},
+			pos:  position{line: 886, col: 1, offset: 25894},
 			expr: &actionExpr{
-				pos: position{line: 813, col: 18, offset: 23646},
+				pos: position{line: 886, col: 18, offset: 25911},
 				run: (*parser).callonRecordElemTail1,
 				expr: &seqExpr{
-					pos: position{line: 813, col: 18, offset: 23646},
+					pos: position{line: 886, col: 18, offset: 25911},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 813, col: 18, offset: 23646},
+							pos:  position{line: 886, col: 18, offset: 25911},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 813, col: 21, offset: 23649},
+							pos:        position{line: 886, col: 21, offset: 25914},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 813, col: 25, offset: 23653},
+							pos:  position{line: 886, col: 25, offset: 25918},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 813, col: 28, offset: 23656},
+							pos:   position{line: 886, col: 28, offset: 25921},
 							label: "elem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 813, col: 33, offset: 23661},
+								pos:  position{line: 886, col: 33, offset: 25926},
 								name: "RecordElem",
 							},
 						},
@@ -6228,20 +6920,20 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElem",
-			pos:  position{line: 815, col: 1, offset: 23694},
+			pos:  position{line: 888, col: 1, offset: 25959},
 			expr: &choiceExpr{
-				pos: position{line: 816, col: 5, offset: 23709},
+				pos: position{line: 889, col: 5, offset: 25974},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 816, col: 5, offset: 23709},
+						pos:  position{line: 889, col: 5, offset: 25974},
 						name: "Spread",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 817, col: 5, offset: 23720},
+						pos:  position{line: 890, col: 5, offset: 25985},
 						name: "Field",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 818, col: 5, offset: 23730},
+						pos:  position{line: 891, col: 5, offset: 25995},
 						name: "Identifier",
 					},
 				},
@@ -6249,27 +6941,27 @@ var g = &grammar{
 		},
 		{
 			name: "Spread",
-			pos:  position{line: 820, col: 1, offset: 23742},
+			pos:  position{line: 893, col: 1, offset: 26007},
 			expr: &actionExpr{
-				pos: position{line: 821, col: 5, offset: 23753},
+				pos: position{line: 894, col: 5, offset: 26018},
 				run: (*parser).callonSpread1,
 				expr: &seqExpr{
-					pos: position{line: 821, col: 5, offset: 23753},
+					pos: position{line: 894, col: 5, offset: 26018},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 821, col: 5, offset: 23753},
+							pos:        position{line: 894, col: 5, offset: 26018},
 							val:        "...",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 821, col: 11, offset: 23759},
+							pos:  position{line: 894, col: 11, offset: 26024},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 821, col: 14, offset: 23762},
+							pos:   position{line: 894, col: 14, offset: 26027},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 821, col: 19, offset: 23767},
+								pos:  position{line: 894, col: 19, offset: 26032},
 								name: "Expr",
 							},
 						},
@@ -6279,39 +6971,39 @@ var g = &grammar{
 		},
 		{
 			name: "Field",
-			pos:  position{line: 825, col: 1, offset: 23853},
+			pos:  position{line: 898, col: 1, offset: 26118},
 			expr: &actionExpr{
-				pos: position{line: 826, col: 5, offset: 23863},
+				pos: position{line: 899, col: 5, offset: 26128},
 				run: (*parser).callonField1,
 				expr: &seqExpr{
-					pos: position{line: 826, col: 5, offset: 23863},
+					pos: position{line: 899, col: 5, offset: 26128},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 826, col: 5, offset: 23863},
+							pos:   position{line: 899, col: 5, offset: 26128},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 826, col: 10, offset: 23868},
+								pos:  position{line: 899, col: 10, offset: 26133},
 								name: "FieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 826, col: 20, offset: 23878},
+							pos:  position{line: 899, col: 20, offset: 26143},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 826, col: 23, offset: 23881},
+							pos:        position{line: 899, col: 23, offset: 26146},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 826, col: 27, offset: 23885},
+							pos:  position{line: 899, col: 27, offset: 26150},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 826, col: 30, offset: 23888},
+							pos:   position{line: 899, col: 30, offset: 26153},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 826, col: 36, offset: 23894},
+								pos:  position{line: 899, col: 36, offset: 26159},
 								name: "Expr",
 							},
 						},
@@ -6321,36 +7013,36 @@ var g = &grammar{
 		},
 		{
 			name: "Array",
-			pos:  position{line: 830, col: 1, offset: 23994},
+			pos:  position{line: 903, col: 1, offset: 26259},
 			expr: &actionExpr{
-				pos: position{line: 831, col: 5, offset: 24004},
+				pos: position{line: 904, col: 5, offset: 26269},
 				run: (*parser).callonArray1,
 				expr: &seqExpr{
-					pos: position{line: 831, col: 5, offset: 24004},
+					pos: position{line: 904, col: 5, offset: 26269},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 831, col: 5, offset: 24004},
+							pos:        position{line: 904, col: 5, offset: 26269},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 831, col: 9, offset: 24008},
+							pos:  position{line: 904, col: 9, offset: 26273},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 831, col: 12, offset: 24011},
+							pos:   position{line: 904, col: 12, offset: 26276},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 831, col: 18, offset: 24017},
+								pos:  position{line: 904, col: 18, offset: 26282},
 								name: "VectorElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 831, col: 30, offset: 24029},
+							pos:  position{line: 904, col: 30, offset: 26294},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 831, col: 33, offset: 24032},
+							pos:        position{line: 904, col: 33, offset: 26297},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -6360,36 +7052,36 @@ var g = &grammar{
 		},
 		{
 			name: "Set",
-			pos:  position{line: 835, col: 1, offset: 24122},
+			pos:  position{line: 908, col: 1, offset: 26387},
 			expr: &actionExpr{
-				pos: position{line: 836, col: 5, offset: 24130},
+				pos: position{line: 909, col: 5, offset: 26395},
 				run: (*parser).callonSet1,
 				expr: &seqExpr{
-					pos: position{line: 836, col: 5, offset: 24130},
+					pos: position{line: 909, col: 5, offset: 26395},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 836, col: 5, offset: 24130},
+							pos:        position{line: 909, col: 5, offset: 26395},
 							val:        "|[",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 836, col: 10, offset: 24135},
+							pos:  position{line: 909, col: 10, offset: 26400},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 836, col: 13, offset: 24138},
+							pos:   position{line: 909, col: 13, offset: 26403},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 836, col: 19, offset: 24144},
+								pos:  position{line: 909, col: 19, offset: 26409},
 								name: "VectorElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 836, col: 31, offset: 24156},
+							pos:  position{line: 909, col: 31, offset: 26421},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 836, col: 34, offset: 24159},
+							pos:        position{line: 909, col: 34, offset: 26424},
 							val:        "]|",
 							ignoreCase: false,
 						},
@@ -6399,53 +7091,53 @@ var g = &grammar{
 		},
 		{
 			name: "VectorElems",
-			pos:  position{line: 840, col: 1, offset: 24248},
+			pos:  position{line: 913, col: 1, offset: 26513},
 			expr: &choiceExpr{
-				pos: position{line: 841, col: 5, offset: 24264},
+				pos: position{line: 914, col: 5, offset: 26529},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 841, col: 5, offset: 24264},
+						pos: position{line: 914, col: 5, offset: 26529},
 						run: (*parser).callonVectorElems2,
 						expr: &seqExpr{
-							pos: position{line: 841, col: 5, offset: 24264},
+							pos: position{line: 914, col: 5, offset: 26529},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 841, col: 5, offset: 24264},
+									pos:   position{line: 914, col: 5, offset: 26529},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 841, col: 11, offset: 24270},
+										pos:  position{line: 914, col: 11, offset: 26535},
 										name: "VectorElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 841, col: 22, offset: 24281},
+									pos:   position{line: 914, col: 22, offset: 26546},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 841, col: 27, offset: 24286},
+										pos: position{line: 914, col: 27, offset: 26551},
 										expr: &actionExpr{
-											pos: position{line: 841, col: 28, offset: 24287},
+											pos: position{line: 914, col: 28, offset: 26552},
 											run: (*parser).callonVectorElems8,
 											expr: &seqExpr{
-												pos: position{line: 841, col: 28, offset: 24287},
+												pos: position{line: 914, col: 28, offset: 26552},
 												exprs: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 841, col: 28, offset: 24287},
+														pos:  position{line: 914, col: 28, offset: 26552},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 841, col: 31, offset: 24290},
+														pos:        position{line: 914, col: 31, offset: 26555},
 														val:        ",",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 841, col: 35, offset: 24294},
+														pos:  position{line: 914, col: 35, offset: 26559},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 841, col: 38, offset: 24297},
+														pos:   position{line: 914, col: 38, offset: 26562},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 841, col: 40, offset: 24299},
+															pos:  position{line: 914, col: 40, offset: 26564},
 															name: "VectorElem",
 														},
 													},
@@ -6458,10 +7150,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 844, col: 5, offset: 24417},
+						pos: position{line: 917, col: 5, offset: 26682},
 						run: (*parser).callonVectorElems15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 844, col: 5, offset: 24417},
+							pos:  position{line: 917, col: 5, offset: 26682},
 							name: "__",
 						},
 					},
@@ -6470,22 +7162,22 @@ var g = &grammar{
 		},
 		{
 			name: "VectorElem",
-			pos:  position{line: 846, col: 1, offset: 24453},
+			pos:  position{line: 919, col: 1, offset: 26718},
 			expr: &choiceExpr{
-				pos: position{line: 847, col: 5, offset: 24468},
+				pos: position{line: 920, col: 5, offset: 26733},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 847, col: 5, offset: 24468},
+						pos:  position{line: 920, col: 5, offset: 26733},
 						name: "Spread",
 					},
 					&actionExpr{
-						pos: position{line: 848, col: 5, offset: 24479},
+						pos: position{line: 921, col: 5, offset: 26744},
 						run: (*parser).callonVectorElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 848, col: 5, offset: 24479},
+							pos:   position{line: 921, col: 5, offset: 26744},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 848, col: 7, offset: 24481},
+								pos:  position{line: 921, col: 7, offset: 26746},
 								name: "Expr",
 							},
 						},
@@ -6495,36 +7187,36 @@ var g = &grammar{
 		},
 		{
 			name: "Map",
-			pos:  position{line: 850, col: 1, offset: 24557},
+			pos:  position{line: 923, col: 1, offset: 26822},
 			expr: &actionExpr{
-				pos: position{line: 851, col: 5, offset: 24565},
+				pos: position{line: 924, col: 5, offset: 26830},
 				run: (*parser).callonMap1,
 				expr: &seqExpr{
-					pos: position{line: 851, col: 5, offset: 24565},
+					pos: position{line: 924, col: 5, offset: 26830},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 851, col: 5, offset: 24565},
+							pos:        position{line: 924, col: 5, offset: 26830},
 							val:        "|{",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 851, col: 10, offset: 24570},
+							pos:  position{line: 924, col: 10, offset: 26835},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 851, col: 13, offset: 24573},
+							pos:   position{line: 924, col: 13, offset: 26838},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 851, col: 19, offset: 24579},
+								pos:  position{line: 924, col: 19, offset: 26844},
 								name: "Entries",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 851, col: 27, offset: 24587},
+							pos:  position{line: 924, col: 27, offset: 26852},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 851, col: 30, offset: 24590},
+							pos:        position{line: 924, col: 30, offset: 26855},
 							val:        "}|",
 							ignoreCase: false,
 						},
@@ -6534,31 +7226,31 @@ var g = &grammar{
 		},
 		{
 			name: "Entries",
-			pos:  position{line: 855, col: 1, offset: 24681},
+			pos:  position{line: 928, col: 1, offset: 26946},
 			expr: &choiceExpr{
-				pos: position{line: 856, col: 5, offset: 24693},
+				pos: position{line: 929, col: 5, offset: 26958},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 856, col: 5, offset: 24693},
+						pos: position{line: 929, col: 5, offset: 26958},
 						run: (*parser).callonEntries2,
 						expr: &seqExpr{
-							pos: position{line: 856, col: 5, offset: 24693},
+							pos: position{line: 929, col: 5, offset: 26958},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 856, col: 5, offset: 24693},
+									pos:   position{line: 929, col: 5, offset: 26958},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 856, col: 11, offset: 24699},
+										pos:  position{line: 929, col: 11, offset: 26964},
 										name: "Entry",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 856, col: 17, offset: 24705},
+									pos:   position{line: 929, col: 17, offset: 26970},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 856, col: 22, offset: 24710},
+										pos: position{line: 929, col: 22, offset: 26975},
 										expr: &ruleRefExpr{
-											pos:  position{line: 856, col: 22, offset: 24710},
+											pos:  position{line: 929, col: 22, offset: 26975},
 											name: "EntryTail",
 										},
 									},
@@ -6567,10 +7259,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 859, col: 5, offset: 24804},
+						pos: position{line: 932, col: 5, offset: 27069},
 						run: (*parser).callonEntries9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 859, col: 5, offset: 24804},
+							pos:  position{line: 932, col: 5, offset: 27069},
 							name: "__",
 						},
 					},
@@ -6579,31 +7271,31 @@ var g = &grammar{
 		},
 		{
 			name: "EntryTail",
-			pos:  position{line: 862, col: 1, offset: 24841},
+			pos:  position{line: 935, col: 1, offset: 27106},
 			expr: &actionExpr{
-				pos: position{line: 862, col: 13, offset: 24853},
+				pos: position{line: 935, col: 13, offset: 27118},
 				run: (*parser).callonEntryTail1,
 				expr: &seqExpr{
-					pos: position{line: 862, col: 13, offset: 24853},
+					pos: position{line: 935, col: 13, offset: 27118},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 862, col: 13, offset: 24853},
+							pos:  position{line: 935, col: 13, offset: 27118},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 862, col: 16, offset: 24856},
+							pos:        position{line: 935, col: 16, offset: 27121},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 862, col: 20, offset: 24860},
+							pos:  position{line: 935, col: 20, offset: 27125},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 862, col: 23, offset: 24863},
+							pos:   position{line: 935, col: 23, offset: 27128},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 862, col: 25, offset: 24865},
+								pos:  position{line: 935, col: 25, offset: 27130},
 								name: "Entry",
 							},
 						},
@@ -6613,39 +7305,39 @@ var g = &grammar{
 		},
 		{
 			name: "Entry",
-			pos:  position{line: 864, col: 1, offset: 24890},
+			pos:  position{line: 937, col: 1, offset: 27155},
 			expr: &actionExpr{
-				pos: position{line: 865, col: 5, offset: 24900},
+				pos: position{line: 938, col: 5, offset: 27165},
 				run: (*parser).callonEntry1,
 				expr: &seqExpr{
-					pos: position{line: 865, col: 5, offset: 24900},
+					pos: position{line: 938, col: 5, offset: 27165},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 865, col: 5, offset: 24900},
+							pos:   position{line: 938, col: 5, offset: 27165},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 865, col: 9, offset: 24904},
+								pos:  position{line: 938, col: 9, offset: 27169},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 865, col: 14, offset: 24909},
+							pos:  position{line: 938, col: 14, offset: 27174},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 865, col: 17, offset: 24912},
+							pos:        position{line: 938, col: 17, offset: 27177},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 865, col: 21, offset: 24916},
+							pos:  position{line: 938, col: 21, offset: 27181},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 865, col: 24, offset: 24919},
+							pos:   position{line: 938, col: 24, offset: 27184},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 865, col: 30, offset: 24925},
+								pos:  position{line: 938, col: 30, offset: 27190},
 								name: "Expr",
 							},
 						},
@@ -6655,92 +7347,92 @@ var g = &grammar{
 		},
 		{
 			name: "SQLOp",
-			pos:  position{line: 871, col: 1, offset: 25032},
+			pos:  position{line: 944, col: 1, offset: 27297},
 			expr: &actionExpr{
-				pos: position{line: 872, col: 5, offset: 25042},
+				pos: position{line: 945, col: 5, offset: 27307},
 				run: (*parser).callonSQLOp1,
 				expr: &seqExpr{
-					pos: position{line: 872, col: 5, offset: 25042},
+					pos: position{line: 945, col: 5, offset: 27307},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 872, col: 5, offset: 25042},
+							pos:   position{line: 945, col: 5, offset: 27307},
 							label: "selection",
 							expr: &ruleRefExpr{
-								pos:  position{line: 872, col: 15, offset: 25052},
+								pos:  position{line: 945, col: 15, offset: 27317},
 								name: "SQLSelect",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 873, col: 5, offset: 25066},
+							pos:   position{line: 946, col: 5, offset: 27331},
 							label: "from",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 873, col: 10, offset: 25071},
+								pos: position{line: 946, col: 10, offset: 27336},
 								expr: &ruleRefExpr{
-									pos:  position{line: 873, col: 10, offset: 25071},
+									pos:  position{line: 946, col: 10, offset: 27336},
 									name: "SQLFrom",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 874, col: 5, offset: 25084},
+							pos:   position{line: 947, col: 5, offset: 27349},
 							label: "joins",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 874, col: 11, offset: 25090},
+								pos: position{line: 947, col: 11, offset: 27355},
 								expr: &ruleRefExpr{
-									pos:  position{line: 874, col: 11, offset: 25090},
+									pos:  position{line: 947, col: 11, offset: 27355},
 									name: "SQLJoins",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 875, col: 5, offset: 25104},
+							pos:   position{line: 948, col: 5, offset: 27369},
 							label: "where",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 875, col: 11, offset: 25110},
+								pos: position{line: 948, col: 11, offset: 27375},
 								expr: &ruleRefExpr{
-									pos:  position{line: 875, col: 11, offset: 25110},
+									pos:  position{line: 948, col: 11, offset: 27375},
 									name: "SQLWhere",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 876, col: 5, offset: 25124},
+							pos:   position{line: 949, col: 5, offset: 27389},
 							label: "groupby",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 876, col: 13, offset: 25132},
+								pos: position{line: 949, col: 13, offset: 27397},
 								expr: &ruleRefExpr{
-									pos:  position{line: 876, col: 13, offset: 25132},
+									pos:  position{line: 949, col: 13, offset: 27397},
 									name: "SQLGroupBy",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 877, col: 5, offset: 25148},
+							pos:   position{line: 950, col: 5, offset: 27413},
 							label: "having",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 877, col: 12, offset: 25155},
+								pos: position{line: 950, col: 12, offset: 27420},
 								expr: &ruleRefExpr{
-									pos:  position{line: 877, col: 12, offset: 25155},
+									pos:  position{line: 950, col: 12, offset: 27420},
 									name: "SQLHaving",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 878, col: 5, offset: 25170},
+							pos:   position{line: 951, col: 5, offset: 27435},
 							label: "orderby",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 878, col: 13, offset: 25178},
+								pos: position{line: 951, col: 13, offset: 27443},
 								expr: &ruleRefExpr{
-									pos:  position{line: 878, col: 13, offset: 25178},
+									pos:  position{line: 951, col: 13, offset: 27443},
 									name: "SQLOrderBy",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 879, col: 5, offset: 25194},
+							pos:   position{line: 952, col: 5, offset: 27459},
 							label: "limit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 879, col: 11, offset: 25200},
+								pos:  position{line: 952, col: 11, offset: 27465},
 								name: "SQLLimit",
 							},
 						},
@@ -6750,26 +7442,26 @@ var g = &grammar{
 		},
 		{
 			name: "SQLSelect",
-			pos:  position{line: 903, col: 1, offset: 25567},
+			pos:  position{line: 976, col: 1, offset: 27832},
 			expr: &choiceExpr{
-				pos: position{line: 904, col: 5, offset: 25581},
+				pos: position{line: 977, col: 5, offset: 27846},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 904, col: 5, offset: 25581},
+						pos: position{line: 977, col: 5, offset: 27846},
 						run: (*parser).callonSQLSelect2,
 						expr: &seqExpr{
-							pos: position{line: 904, col: 5, offset: 25581},
+							pos: position{line: 977, col: 5, offset: 27846},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 904, col: 5, offset: 25581},
+									pos:  position{line: 977, col: 5, offset: 27846},
 									name: "SELECT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 904, col: 12, offset: 25588},
+									pos:  position{line: 977, col: 12, offset: 27853},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 904, col: 14, offset: 25590},
+									pos:        position{line: 977, col: 14, offset: 27855},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -6777,24 +7469,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 905, col: 5, offset: 25618},
+						pos: position{line: 978, col: 5, offset: 27883},
 						run: (*parser).callonSQLSelect7,
 						expr: &seqExpr{
-							pos: position{line: 905, col: 5, offset: 25618},
+							pos: position{line: 978, col: 5, offset: 27883},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 905, col: 5, offset: 25618},
+									pos:  position{line: 978, col: 5, offset: 27883},
 									name: "SELECT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 905, col: 12, offset: 25625},
+									pos:  position{line: 978, col: 12, offset: 27890},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 905, col: 14, offset: 25627},
+									pos:   position{line: 978, col: 14, offset: 27892},
 									label: "assignments",
 									expr: &ruleRefExpr{
-										pos:  position{line: 905, col: 26, offset: 25639},
+										pos:  position{line: 978, col: 26, offset: 27904},
 										name: "SQLAssignments",
 									},
 								},
@@ -6806,43 +7498,43 @@ var g = &grammar{
 		},
 		{
 			name: "SQLAssignment",
-			pos:  position{line: 907, col: 1, offset: 25683},
+			pos:  position{line: 980, col: 1, offset: 27948},
 			expr: &actionExpr{
-				pos: position{line: 908, col: 5, offset: 25701},
+				pos: position{line: 981, col: 5, offset: 27966},
 				run: (*parser).callonSQLAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 908, col: 5, offset: 25701},
+					pos: position{line: 981, col: 5, offset: 27966},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 908, col: 5, offset: 25701},
+							pos:   position{line: 981, col: 5, offset: 27966},
 							label: "rhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 908, col: 9, offset: 25705},
+								pos:  position{line: 981, col: 9, offset: 27970},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 908, col: 14, offset: 25710},
+							pos:   position{line: 981, col: 14, offset: 27975},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 908, col: 18, offset: 25714},
+								pos: position{line: 981, col: 18, offset: 27979},
 								expr: &seqExpr{
-									pos: position{line: 908, col: 19, offset: 25715},
+									pos: position{line: 981, col: 19, offset: 27980},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 908, col: 19, offset: 25715},
+											pos:  position{line: 981, col: 19, offset: 27980},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 908, col: 21, offset: 25717},
+											pos:  position{line: 981, col: 21, offset: 27982},
 											name: "AS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 908, col: 24, offset: 25720},
+											pos:  position{line: 981, col: 24, offset: 27985},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 908, col: 26, offset: 25722},
+											pos:  position{line: 981, col: 26, offset: 27987},
 											name: "Lval",
 										},
 									},
@@ -6855,50 +7547,50 @@ var g = &grammar{
 		},
 		{
 			name: "SQLAssignments",
-			pos:  position{line: 916, col: 1, offset: 25913},
+			pos:  position{line: 989, col: 1, offset: 28178},
 			expr: &actionExpr{
-				pos: position{line: 917, col: 5, offset: 25932},
+				pos: position{line: 990, col: 5, offset: 28197},
 				run: (*parser).callonSQLAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 917, col: 5, offset: 25932},
+					pos: position{line: 990, col: 5, offset: 28197},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 917, col: 5, offset: 25932},
+							pos:   position{line: 990, col: 5, offset: 28197},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 917, col: 11, offset: 25938},
+								pos:  position{line: 990, col: 11, offset: 28203},
 								name: "SQLAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 917, col: 25, offset: 25952},
+							pos:   position{line: 990, col: 25, offset: 28217},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 917, col: 30, offset: 25957},
+								pos: position{line: 990, col: 30, offset: 28222},
 								expr: &actionExpr{
-									pos: position{line: 917, col: 31, offset: 25958},
+									pos: position{line: 990, col: 31, offset: 28223},
 									run: (*parser).callonSQLAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 917, col: 31, offset: 25958},
+										pos: position{line: 990, col: 31, offset: 28223},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 917, col: 31, offset: 25958},
+												pos:  position{line: 990, col: 31, offset: 28223},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 917, col: 34, offset: 25961},
+												pos:        position{line: 990, col: 34, offset: 28226},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 917, col: 38, offset: 25965},
+												pos:  position{line: 990, col: 38, offset: 28230},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 917, col: 41, offset: 25968},
+												pos:   position{line: 990, col: 41, offset: 28233},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 917, col: 46, offset: 25973},
+													pos:  position{line: 990, col: 46, offset: 28238},
 													name: "SQLAssignment",
 												},
 											},
@@ -6913,43 +7605,43 @@ var g = &grammar{
 		},
 		{
 			name: "SQLFrom",
-			pos:  position{line: 921, col: 1, offset: 26094},
+			pos:  position{line: 994, col: 1, offset: 28359},
 			expr: &choiceExpr{
-				pos: position{line: 922, col: 5, offset: 26106},
+				pos: position{line: 995, col: 5, offset: 28371},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 922, col: 5, offset: 26106},
+						pos: position{line: 995, col: 5, offset: 28371},
 						run: (*parser).callonSQLFrom2,
 						expr: &seqExpr{
-							pos: position{line: 922, col: 5, offset: 26106},
+							pos: position{line: 995, col: 5, offset: 28371},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 922, col: 5, offset: 26106},
+									pos:  position{line: 995, col: 5, offset: 28371},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 922, col: 7, offset: 26108},
+									pos:  position{line: 995, col: 7, offset: 28373},
 									name: "FROM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 922, col: 12, offset: 26113},
+									pos:  position{line: 995, col: 12, offset: 28378},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 922, col: 14, offset: 26115},
+									pos:   position{line: 995, col: 14, offset: 28380},
 									label: "table",
 									expr: &ruleRefExpr{
-										pos:  position{line: 922, col: 20, offset: 26121},
+										pos:  position{line: 995, col: 20, offset: 28386},
 										name: "SQLTable",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 922, col: 29, offset: 26130},
+									pos:   position{line: 995, col: 29, offset: 28395},
 									label: "alias",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 922, col: 35, offset: 26136},
+										pos: position{line: 995, col: 35, offset: 28401},
 										expr: &ruleRefExpr{
-											pos:  position{line: 922, col: 35, offset: 26136},
+											pos:  position{line: 995, col: 35, offset: 28401},
 											name: "SQLAlias",
 										},
 									},
@@ -6958,25 +7650,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 925, col: 5, offset: 26231},
+						pos: position{line: 998, col: 5, offset: 28496},
 						run: (*parser).callonSQLFrom12,
 						expr: &seqExpr{
-							pos: position{line: 925, col: 5, offset: 26231},
+							pos: position{line: 998, col: 5, offset: 28496},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 925, col: 5, offset: 26231},
+									pos:  position{line: 998, col: 5, offset: 28496},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 925, col: 7, offset: 26233},
+									pos:  position{line: 998, col: 7, offset: 28498},
 									name: "FROM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 925, col: 12, offset: 26238},
+									pos:  position{line: 998, col: 12, offset: 28503},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 925, col: 14, offset: 26240},
+									pos:        position{line: 998, col: 14, offset: 28505},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -6988,33 +7680,33 @@ var g = &grammar{
 		},
 		{
 			name: "SQLAlias",
-			pos:  position{line: 927, col: 1, offset: 26265},
+			pos:  position{line: 1000, col: 1, offset: 28530},
 			expr: &choiceExpr{
-				pos: position{line: 928, col: 5, offset: 26278},
+				pos: position{line: 1001, col: 5, offset: 28543},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 928, col: 5, offset: 26278},
+						pos: position{line: 1001, col: 5, offset: 28543},
 						run: (*parser).callonSQLAlias2,
 						expr: &seqExpr{
-							pos: position{line: 928, col: 5, offset: 26278},
+							pos: position{line: 1001, col: 5, offset: 28543},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 928, col: 5, offset: 26278},
+									pos:  position{line: 1001, col: 5, offset: 28543},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 928, col: 7, offset: 26280},
+									pos:  position{line: 1001, col: 7, offset: 28545},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 928, col: 10, offset: 26283},
+									pos:  position{line: 1001, col: 10, offset: 28548},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 928, col: 12, offset: 26285},
+									pos:   position{line: 1001, col: 12, offset: 28550},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 928, col: 15, offset: 26288},
+										pos:  position{line: 1001, col: 15, offset: 28553},
 										name: "Lval",
 									},
 								},
@@ -7022,36 +7714,36 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 929, col: 5, offset: 26316},
+						pos: position{line: 1002, col: 5, offset: 28581},
 						run: (*parser).callonSQLAlias9,
 						expr: &seqExpr{
-							pos: position{line: 929, col: 5, offset: 26316},
+							pos: position{line: 1002, col: 5, offset: 28581},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 929, col: 5, offset: 26316},
+									pos:  position{line: 1002, col: 5, offset: 28581},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 929, col: 7, offset: 26318},
+									pos: position{line: 1002, col: 7, offset: 28583},
 									expr: &seqExpr{
-										pos: position{line: 929, col: 9, offset: 26320},
+										pos: position{line: 1002, col: 9, offset: 28585},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 929, col: 9, offset: 26320},
+												pos:  position{line: 1002, col: 9, offset: 28585},
 												name: "SQLTokenSentinels",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 929, col: 27, offset: 26338},
+												pos:  position{line: 1002, col: 27, offset: 28603},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 929, col: 30, offset: 26341},
+									pos:   position{line: 1002, col: 30, offset: 28606},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 929, col: 33, offset: 26344},
+										pos:  position{line: 1002, col: 33, offset: 28609},
 										name: "Lval",
 									},
 								},
@@ -7063,42 +7755,42 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTable",
-			pos:  position{line: 931, col: 1, offset: 26369},
+			pos:  position{line: 1004, col: 1, offset: 28634},
 			expr: &ruleRefExpr{
-				pos:  position{line: 932, col: 5, offset: 26382},
+				pos:  position{line: 1005, col: 5, offset: 28647},
 				name: "Expr",
 			},
 		},
 		{
 			name: "SQLJoins",
-			pos:  position{line: 934, col: 1, offset: 26388},
+			pos:  position{line: 1007, col: 1, offset: 28653},
 			expr: &actionExpr{
-				pos: position{line: 935, col: 5, offset: 26401},
+				pos: position{line: 1008, col: 5, offset: 28666},
 				run: (*parser).callonSQLJoins1,
 				expr: &seqExpr{
-					pos: position{line: 935, col: 5, offset: 26401},
+					pos: position{line: 1008, col: 5, offset: 28666},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 935, col: 5, offset: 26401},
+							pos:   position{line: 1008, col: 5, offset: 28666},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 935, col: 11, offset: 26407},
+								pos:  position{line: 1008, col: 11, offset: 28672},
 								name: "SQLJoin",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 935, col: 19, offset: 26415},
+							pos:   position{line: 1008, col: 19, offset: 28680},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 935, col: 24, offset: 26420},
+								pos: position{line: 1008, col: 24, offset: 28685},
 								expr: &actionExpr{
-									pos: position{line: 935, col: 25, offset: 26421},
+									pos: position{line: 1008, col: 25, offset: 28686},
 									run: (*parser).callonSQLJoins7,
 									expr: &labeledExpr{
-										pos:   position{line: 935, col: 25, offset: 26421},
+										pos:   position{line: 1008, col: 25, offset: 28686},
 										label: "join",
 										expr: &ruleRefExpr{
-											pos:  position{line: 935, col: 30, offset: 26426},
+											pos:  position{line: 1008, col: 30, offset: 28691},
 											name: "SQLJoin",
 										},
 									},
@@ -7111,90 +7803,90 @@ var g = &grammar{
 		},
 		{
 			name: "SQLJoin",
-			pos:  position{line: 939, col: 1, offset: 26541},
+			pos:  position{line: 1012, col: 1, offset: 28806},
 			expr: &actionExpr{
-				pos: position{line: 940, col: 5, offset: 26553},
+				pos: position{line: 1013, col: 5, offset: 28818},
 				run: (*parser).callonSQLJoin1,
 				expr: &seqExpr{
-					pos: position{line: 940, col: 5, offset: 26553},
+					pos: position{line: 1013, col: 5, offset: 28818},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 940, col: 5, offset: 26553},
+							pos:   position{line: 1013, col: 5, offset: 28818},
 							label: "style",
 							expr: &ruleRefExpr{
-								pos:  position{line: 940, col: 11, offset: 26559},
+								pos:  position{line: 1013, col: 11, offset: 28824},
 								name: "SQLJoinStyle",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 940, col: 24, offset: 26572},
+							pos:  position{line: 1013, col: 24, offset: 28837},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 940, col: 26, offset: 26574},
+							pos:  position{line: 1013, col: 26, offset: 28839},
 							name: "JOIN",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 940, col: 31, offset: 26579},
+							pos:  position{line: 1013, col: 31, offset: 28844},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 940, col: 33, offset: 26581},
+							pos:   position{line: 1013, col: 33, offset: 28846},
 							label: "table",
 							expr: &ruleRefExpr{
-								pos:  position{line: 940, col: 39, offset: 26587},
+								pos:  position{line: 1013, col: 39, offset: 28852},
 								name: "SQLTable",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 940, col: 48, offset: 26596},
+							pos:   position{line: 1013, col: 48, offset: 28861},
 							label: "alias",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 940, col: 54, offset: 26602},
+								pos: position{line: 1013, col: 54, offset: 28867},
 								expr: &ruleRefExpr{
-									pos:  position{line: 940, col: 54, offset: 26602},
+									pos:  position{line: 1013, col: 54, offset: 28867},
 									name: "SQLAlias",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 940, col: 64, offset: 26612},
+							pos:  position{line: 1013, col: 64, offset: 28877},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 940, col: 66, offset: 26614},
+							pos:  position{line: 1013, col: 66, offset: 28879},
 							name: "ON",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 940, col: 69, offset: 26617},
+							pos:  position{line: 1013, col: 69, offset: 28882},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 940, col: 71, offset: 26619},
+							pos:   position{line: 1013, col: 71, offset: 28884},
 							label: "leftKey",
 							expr: &ruleRefExpr{
-								pos:  position{line: 940, col: 79, offset: 26627},
+								pos:  position{line: 1013, col: 79, offset: 28892},
 								name: "JoinKey",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 940, col: 87, offset: 26635},
+							pos:  position{line: 1013, col: 87, offset: 28900},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 940, col: 90, offset: 26638},
+							pos:        position{line: 1013, col: 90, offset: 28903},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 940, col: 94, offset: 26642},
+							pos:  position{line: 1013, col: 94, offset: 28907},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 940, col: 97, offset: 26645},
+							pos:   position{line: 1013, col: 97, offset: 28910},
 							label: "rightKey",
 							expr: &ruleRefExpr{
-								pos:  position{line: 940, col: 106, offset: 26654},
+								pos:  position{line: 1013, col: 106, offset: 28919},
 								name: "JoinKey",
 							},
 						},
@@ -7204,40 +7896,40 @@ var g = &grammar{
 		},
 		{
 			name: "SQLJoinStyle",
-			pos:  position{line: 955, col: 1, offset: 26885},
+			pos:  position{line: 1028, col: 1, offset: 29150},
 			expr: &choiceExpr{
-				pos: position{line: 956, col: 5, offset: 26902},
+				pos: position{line: 1029, col: 5, offset: 29167},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 956, col: 5, offset: 26902},
+						pos: position{line: 1029, col: 5, offset: 29167},
 						run: (*parser).callonSQLJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 956, col: 5, offset: 26902},
+							pos: position{line: 1029, col: 5, offset: 29167},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 956, col: 5, offset: 26902},
+									pos:  position{line: 1029, col: 5, offset: 29167},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 956, col: 7, offset: 26904},
+									pos:   position{line: 1029, col: 7, offset: 29169},
 									label: "style",
 									expr: &choiceExpr{
-										pos: position{line: 956, col: 14, offset: 26911},
+										pos: position{line: 1029, col: 14, offset: 29176},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 956, col: 14, offset: 26911},
+												pos:  position{line: 1029, col: 14, offset: 29176},
 												name: "ANTI",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 956, col: 21, offset: 26918},
+												pos:  position{line: 1029, col: 21, offset: 29183},
 												name: "INNER",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 956, col: 29, offset: 26926},
+												pos:  position{line: 1029, col: 29, offset: 29191},
 												name: "LEFT",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 956, col: 36, offset: 26933},
+												pos:  position{line: 1029, col: 36, offset: 29198},
 												name: "RIGHT",
 											},
 										},
@@ -7247,10 +7939,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 957, col: 5, offset: 26966},
+						pos: position{line: 1030, col: 5, offset: 29231},
 						run: (*parser).callonSQLJoinStyle11,
 						expr: &litMatcher{
-							pos:        position{line: 957, col: 5, offset: 26966},
+							pos:        position{line: 1030, col: 5, offset: 29231},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -7260,30 +7952,30 @@ var g = &grammar{
 		},
 		{
 			name: "SQLWhere",
-			pos:  position{line: 959, col: 1, offset: 26994},
+			pos:  position{line: 1032, col: 1, offset: 29259},
 			expr: &actionExpr{
-				pos: position{line: 960, col: 5, offset: 27007},
+				pos: position{line: 1033, col: 5, offset: 29272},
 				run: (*parser).callonSQLWhere1,
 				expr: &seqExpr{
-					pos: position{line: 960, col: 5, offset: 27007},
+					pos: position{line: 1033, col: 5, offset: 29272},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 960, col: 5, offset: 27007},
+							pos:  position{line: 1033, col: 5, offset: 29272},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 960, col: 7, offset: 27009},
+							pos:  position{line: 1033, col: 7, offset: 29274},
 							name: "WHERE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 960, col: 13, offset: 27015},
+							pos:  position{line: 1033, col: 13, offset: 29280},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 960, col: 15, offset: 27017},
+							pos:   position{line: 1033, col: 15, offset: 29282},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 960, col: 20, offset: 27022},
+								pos:  position{line: 1033, col: 20, offset: 29287},
 								name: "LogicalOrExpr",
 							},
 						},
@@ -7293,38 +7985,38 @@ var g = &grammar{
 		},
 		{
 			name: "SQLGroupBy",
-			pos:  position{line: 962, col: 1, offset: 27058},
+			pos:  position{line: 1035, col: 1, offset: 29323},
 			expr: &actionExpr{
-				pos: position{line: 963, col: 5, offset: 27073},
+				pos: position{line: 1036, col: 5, offset: 29338},
 				run: (*parser).callonSQLGroupBy1,
 				expr: &seqExpr{
-					pos: position{line: 963, col: 5, offset: 27073},
+					pos: position{line: 1036, col: 5, offset: 29338},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 963, col: 5, offset: 27073},
+							pos:  position{line: 1036, col: 5, offset: 29338},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 963, col: 7, offset: 27075},
+							pos:  position{line: 1036, col: 7, offset: 29340},
 							name: "GROUP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 963, col: 13, offset: 27081},
+							pos:  position{line: 1036, col: 13, offset: 29346},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 963, col: 15, offset: 27083},
+							pos:  position{line: 1036, col: 15, offset: 29348},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 963, col: 18, offset: 27086},
+							pos:  position{line: 1036, col: 18, offset: 29351},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 963, col: 20, offset: 27088},
+							pos:   position{line: 1036, col: 20, offset: 29353},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 963, col: 28, offset: 27096},
+								pos:  position{line: 1036, col: 28, offset: 29361},
 								name: "FieldExprs",
 							},
 						},
@@ -7334,30 +8026,30 @@ var g = &grammar{
 		},
 		{
 			name: "SQLHaving",
-			pos:  position{line: 965, col: 1, offset: 27132},
+			pos:  position{line: 1038, col: 1, offset: 29397},
 			expr: &actionExpr{
-				pos: position{line: 966, col: 5, offset: 27146},
+				pos: position{line: 1039, col: 5, offset: 29411},
 				run: (*parser).callonSQLHaving1,
 				expr: &seqExpr{
-					pos: position{line: 966, col: 5, offset: 27146},
+					pos: position{line: 1039, col: 5, offset: 29411},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 966, col: 5, offset: 27146},
+							pos:  position{line: 1039, col: 5, offset: 29411},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 966, col: 7, offset: 27148},
+							pos:  position{line: 1039, col: 7, offset: 29413},
 							name: "HAVING",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 966, col: 14, offset: 27155},
+							pos:  position{line: 1039, col: 14, offset: 29420},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 966, col: 16, offset: 27157},
+							pos:   position{line: 1039, col: 16, offset: 29422},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 966, col: 21, offset: 27162},
+								pos:  position{line: 1039, col: 21, offset: 29427},
 								name: "LogicalOrExpr",
 							},
 						},
@@ -7367,46 +8059,46 @@ var g = &grammar{
 		},
 		{
 			name: "SQLOrderBy",
-			pos:  position{line: 968, col: 1, offset: 27198},
+			pos:  position{line: 1041, col: 1, offset: 29463},
 			expr: &actionExpr{
-				pos: position{line: 969, col: 5, offset: 27213},
+				pos: position{line: 1042, col: 5, offset: 29478},
 				run: (*parser).callonSQLOrderBy1,
 				expr: &seqExpr{
-					pos: position{line: 969, col: 5, offset: 27213},
+					pos: position{line: 1042, col: 5, offset: 29478},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 969, col: 5, offset: 27213},
+							pos:  position{line: 1042, col: 5, offset: 29478},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 969, col: 7, offset: 27215},
+							pos:  position{line: 1042, col: 7, offset: 29480},
 							name: "ORDER",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 969, col: 13, offset: 27221},
+							pos:  position{line: 1042, col: 13, offset: 29486},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 969, col: 15, offset: 27223},
+							pos:  position{line: 1042, col: 15, offset: 29488},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 969, col: 18, offset: 27226},
+							pos:  position{line: 1042, col: 18, offset: 29491},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 969, col: 20, offset: 27228},
+							pos:   position{line: 1042, col: 20, offset: 29493},
 							label: "keys",
 							expr: &ruleRefExpr{
-								pos:  position{line: 969, col: 25, offset: 27233},
+								pos:  position{line: 1042, col: 25, offset: 29498},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 969, col: 31, offset: 27239},
+							pos:   position{line: 1042, col: 31, offset: 29504},
 							label: "order",
 							expr: &ruleRefExpr{
-								pos:  position{line: 969, col: 37, offset: 27245},
+								pos:  position{line: 1042, col: 37, offset: 29510},
 								name: "SQLOrder",
 							},
 						},
@@ -7416,32 +8108,32 @@ var g = &grammar{
 		},
 		{
 			name: "SQLOrder",
-			pos:  position{line: 973, col: 1, offset: 27355},
+			pos:  position{line: 1046, col: 1, offset: 29620},
 			expr: &choiceExpr{
-				pos: position{line: 974, col: 5, offset: 27368},
+				pos: position{line: 1047, col: 5, offset: 29633},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 974, col: 5, offset: 27368},
+						pos: position{line: 1047, col: 5, offset: 29633},
 						run: (*parser).callonSQLOrder2,
 						expr: &seqExpr{
-							pos: position{line: 974, col: 5, offset: 27368},
+							pos: position{line: 1047, col: 5, offset: 29633},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 974, col: 5, offset: 27368},
+									pos:  position{line: 1047, col: 5, offset: 29633},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 974, col: 7, offset: 27370},
+									pos:   position{line: 1047, col: 7, offset: 29635},
 									label: "dir",
 									expr: &choiceExpr{
-										pos: position{line: 974, col: 12, offset: 27375},
+										pos: position{line: 1047, col: 12, offset: 29640},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 974, col: 12, offset: 27375},
+												pos:  position{line: 1047, col: 12, offset: 29640},
 												name: "ASC",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 974, col: 18, offset: 27381},
+												pos:  position{line: 1047, col: 18, offset: 29646},
 												name: "DESC",
 											},
 										},
@@ -7451,10 +8143,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 975, col: 5, offset: 27411},
+						pos: position{line: 1048, col: 5, offset: 29676},
 						run: (*parser).callonSQLOrder9,
 						expr: &litMatcher{
-							pos:        position{line: 975, col: 5, offset: 27411},
+							pos:        position{line: 1048, col: 5, offset: 29676},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -7464,33 +8156,33 @@ var g = &grammar{
 		},
 		{
 			name: "SQLLimit",
-			pos:  position{line: 977, col: 1, offset: 27437},
+			pos:  position{line: 1050, col: 1, offset: 29702},
 			expr: &choiceExpr{
-				pos: position{line: 978, col: 5, offset: 27450},
+				pos: position{line: 1051, col: 5, offset: 29715},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 978, col: 5, offset: 27450},
+						pos: position{line: 1051, col: 5, offset: 29715},
 						run: (*parser).callonSQLLimit2,
 						expr: &seqExpr{
-							pos: position{line: 978, col: 5, offset: 27450},
+							pos: position{line: 1051, col: 5, offset: 29715},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 978, col: 5, offset: 27450},
+									pos:  position{line: 1051, col: 5, offset: 29715},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 978, col: 7, offset: 27452},
+									pos:  position{line: 1051, col: 7, offset: 29717},
 									name: "LIMIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 978, col: 13, offset: 27458},
+									pos:  position{line: 1051, col: 13, offset: 29723},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 978, col: 15, offset: 27460},
+									pos:   position{line: 1051, col: 15, offset: 29725},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 978, col: 21, offset: 27466},
+										pos:  position{line: 1051, col: 21, offset: 29731},
 										name: "UInt",
 									},
 								},
@@ -7498,10 +8190,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 979, col: 5, offset: 27497},
+						pos: position{line: 1052, col: 5, offset: 29762},
 						run: (*parser).callonSQLLimit9,
 						expr: &litMatcher{
-							pos:        position{line: 979, col: 5, offset: 27497},
+							pos:        position{line: 1052, col: 5, offset: 29762},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -7511,12 +8203,12 @@ var g = &grammar{
 		},
 		{
 			name: "SELECT",
-			pos:  position{line: 981, col: 1, offset: 27519},
+			pos:  position{line: 1054, col: 1, offset: 29784},
 			expr: &actionExpr{
-				pos: position{line: 981, col: 10, offset: 27528},
+				pos: position{line: 1054, col: 10, offset: 29793},
 				run: (*parser).callonSELECT1,
 				expr: &litMatcher{
-					pos:        position{line: 981, col: 10, offset: 27528},
+					pos:        position{line: 1054, col: 10, offset: 29793},
 					val:        "select",
 					ignoreCase: true,
 				},
@@ -7524,12 +8216,12 @@ var g = &grammar{
 		},
 		{
 			name: "AS",
-			pos:  position{line: 982, col: 1, offset: 27563},
+			pos:  position{line: 1055, col: 1, offset: 29828},
 			expr: &actionExpr{
-				pos: position{line: 982, col: 6, offset: 27568},
+				pos: position{line: 1055, col: 6, offset: 29833},
 				run: (*parser).callonAS1,
 				expr: &litMatcher{
-					pos:        position{line: 982, col: 6, offset: 27568},
+					pos:        position{line: 1055, col: 6, offset: 29833},
 					val:        "as",
 					ignoreCase: true,
 				},
@@ -7537,12 +8229,12 @@ var g = &grammar{
 		},
 		{
 			name: "FROM",
-			pos:  position{line: 983, col: 1, offset: 27595},
+			pos:  position{line: 1056, col: 1, offset: 29860},
 			expr: &actionExpr{
-				pos: position{line: 983, col: 8, offset: 27602},
+				pos: position{line: 1056, col: 8, offset: 29867},
 				run: (*parser).callonFROM1,
 				expr: &litMatcher{
-					pos:        position{line: 983, col: 8, offset: 27602},
+					pos:        position{line: 1056, col: 8, offset: 29867},
 					val:        "from",
 					ignoreCase: true,
 				},
@@ -7550,12 +8242,12 @@ var g = &grammar{
 		},
 		{
 			name: "JOIN",
-			pos:  position{line: 984, col: 1, offset: 27633},
+			pos:  position{line: 1057, col: 1, offset: 29898},
 			expr: &actionExpr{
-				pos: position{line: 984, col: 8, offset: 27640},
+				pos: position{line: 1057, col: 8, offset: 29905},
 				run: (*parser).callonJOIN1,
 				expr: &litMatcher{
-					pos:        position{line: 984, col: 8, offset: 27640},
+					pos:        position{line: 1057, col: 8, offset: 29905},
 					val:        "join",
 					ignoreCase: true,
 				},
@@ -7563,12 +8255,12 @@ var g = &grammar{
 		},
 		{
 			name: "WHERE",
-			pos:  position{line: 985, col: 1, offset: 27671},
+			pos:  position{line: 1058, col: 1, offset: 29936},
 			expr: &actionExpr{
-				pos: position{line: 985, col: 9, offset: 27679},
+				pos: position{line: 1058, col: 9, offset: 29944},
 				run: (*parser).callonWHERE1,
 				expr: &litMatcher{
-					pos:        position{line: 985, col: 9, offset: 27679},
+					pos:        position{line: 1058, col: 9, offset: 29944},
 					val:        "where",
 					ignoreCase: true,
 				},
@@ -7576,12 +8268,12 @@ var g = &grammar{
 		},
 		{
 			name: "GROUP",
-			pos:  position{line: 986, col: 1, offset: 27712},
+			pos:  position{line: 1059, col: 1, offset: 29977},
 			expr: &actionExpr{
-				pos: position{line: 986, col: 9, offset: 27720},
+				pos: position{line: 1059, col: 9, offset: 29985},
 				run: (*parser).callonGROUP1,
 				expr: &litMatcher{
-					pos:        position{line: 986, col: 9, offset: 27720},
+					pos:        position{line: 1059, col: 9, offset: 29985},
 					val:        "group",
 					ignoreCase: true,
 				},
@@ -7589,12 +8281,12 @@ var g = &grammar{
 		},
 		{
 			name: "BY",
-			pos:  position{line: 987, col: 1, offset: 27753},
+			pos:  position{line: 1060, col: 1, offset: 30018},
 			expr: &actionExpr{
-				pos: position{line: 987, col: 6, offset: 27758},
+				pos: position{line: 1060, col: 6, offset: 30023},
 				run: (*parser).callonBY1,
 				expr: &litMatcher{
-					pos:        position{line: 987, col: 6, offset: 27758},
+					pos:        position{line: 1060, col: 6, offset: 30023},
 					val:        "by",
 					ignoreCase: true,
 				},
@@ -7602,12 +8294,12 @@ var g = &grammar{
 		},
 		{
 			name: "HAVING",
-			pos:  position{line: 988, col: 1, offset: 27785},
+			pos:  position{line: 1061, col: 1, offset: 30050},
 			expr: &actionExpr{
-				pos: position{line: 988, col: 10, offset: 27794},
+				pos: position{line: 1061, col: 10, offset: 30059},
 				run: (*parser).callonHAVING1,
 				expr: &litMatcher{
-					pos:        position{line: 988, col: 10, offset: 27794},
+					pos:        position{line: 1061, col: 10, offset: 30059},
 					val:        "having",
 					ignoreCase: true,
 				},
@@ -7615,12 +8307,12 @@ var g = &grammar{
 		},
 		{
 			name: "ORDER",
-			pos:  position{line: 989, col: 1, offset: 27829},
+			pos:  position{line: 1062, col: 1, offset: 30094},
 			expr: &actionExpr{
-				pos: position{line: 989, col: 9, offset: 27837},
+				pos: position{line: 1062, col: 9, offset: 30102},
 				run: (*parser).callonORDER1,
 				expr: &litMatcher{
-					pos:        position{line: 989, col: 9, offset: 27837},
+					pos:        position{line: 1062, col: 9, offset: 30102},
 					val:        "order",
 					ignoreCase: true,
 				},
@@ -7628,12 +8320,12 @@ var g = &grammar{
 		},
 		{
 			name: "ON",
-			pos:  position{line: 990, col: 1, offset: 27870},
+			pos:  position{line: 1063, col: 1, offset: 30135},
 			expr: &actionExpr{
-				pos: position{line: 990, col: 6, offset: 27875},
+				pos: position{line: 1063, col: 6, offset: 30140},
 				run: (*parser).callonON1,
 				expr: &litMatcher{
-					pos:        position{line: 990, col: 6, offset: 27875},
+					pos:        position{line: 1063, col: 6, offset: 30140},
 					val:        "on",
 					ignoreCase: true,
 				},
@@ -7641,12 +8333,12 @@ var g = &grammar{
 		},
 		{
 			name: "LIMIT",
-			pos:  position{line: 991, col: 1, offset: 27902},
+			pos:  position{line: 1064, col: 1, offset: 30167},
 			expr: &actionExpr{
-				pos: position{line: 991, col: 9, offset: 27910},
+				pos: position{line: 1064, col: 9, offset: 30175},
 				run: (*parser).callonLIMIT1,
 				expr: &litMatcher{
-					pos:        position{line: 991, col: 9, offset: 27910},
+					pos:        position{line: 1064, col: 9, offset: 30175},
 					val:        "limit",
 					ignoreCase: true,
 				},
@@ -7654,12 +8346,12 @@ var g = &grammar{
 		},
 		{
 			name: "ASC",
-			pos:  position{line: 992, col: 1, offset: 27943},
+			pos:  position{line: 1065, col: 1, offset: 30208},
 			expr: &actionExpr{
-				pos: position{line: 992, col: 7, offset: 27949},
+				pos: position{line: 1065, col: 7, offset: 30214},
 				run: (*parser).callonASC1,
 				expr: &litMatcher{
-					pos:        position{line: 992, col: 7, offset: 27949},
+					pos:        position{line: 1065, col: 7, offset: 30214},
 					val:        "asc",
 					ignoreCase: true,
 				},
@@ -7667,12 +8359,12 @@ var g = &grammar{
 		},
 		{
 			name: "DESC",
-			pos:  position{line: 993, col: 1, offset: 27978},
+			pos:  position{line: 1066, col: 1, offset: 30243},
 			expr: &actionExpr{
-				pos: position{line: 993, col: 8, offset: 27985},
+				pos: position{line: 1066, col: 8, offset: 30250},
 				run: (*parser).callonDESC1,
 				expr: &litMatcher{
-					pos:        position{line: 993, col: 8, offset: 27985},
+					pos:        position{line: 1066, col: 8, offset: 30250},
 					val:        "desc",
 					ignoreCase: true,
 				},
@@ -7680,12 +8372,12 @@ var g = &grammar{
 		},
 		{
 			name: "ANTI",
-			pos:  position{line: 994, col: 1, offset: 28016},
+			pos:  position{line: 1067, col: 1, offset: 30281},
 			expr: &actionExpr{
-				pos: position{line: 994, col: 8, offset: 28023},
+				pos: position{line: 1067, col: 8, offset: 30288},
 				run: (*parser).callonANTI1,
 				expr: &litMatcher{
-					pos:        position{line: 994, col: 8, offset: 28023},
+					pos:        position{line: 1067, col: 8, offset: 30288},
 					val:        "anti",
 					ignoreCase: true,
 				},
@@ -7693,12 +8385,12 @@ var g = &grammar{
 		},
 		{
 			name: "LEFT",
-			pos:  position{line: 995, col: 1, offset: 28054},
+			pos:  position{line: 1068, col: 1, offset: 30319},
 			expr: &actionExpr{
-				pos: position{line: 995, col: 8, offset: 28061},
+				pos: position{line: 1068, col: 8, offset: 30326},
 				run: (*parser).callonLEFT1,
 				expr: &litMatcher{
-					pos:        position{line: 995, col: 8, offset: 28061},
+					pos:        position{line: 1068, col: 8, offset: 30326},
 					val:        "left",
 					ignoreCase: true,
 				},
@@ -7706,12 +8398,12 @@ var g = &grammar{
 		},
 		{
 			name: "RIGHT",
-			pos:  position{line: 996, col: 1, offset: 28092},
+			pos:  position{line: 1069, col: 1, offset: 30357},
 			expr: &actionExpr{
-				pos: position{line: 996, col: 9, offset: 28100},
+				pos: position{line: 1069, col: 9, offset: 30365},
 				run: (*parser).callonRIGHT1,
 				expr: &litMatcher{
-					pos:        position{line: 996, col: 9, offset: 28100},
+					pos:        position{line: 1069, col: 9, offset: 30365},
 					val:        "right",
 					ignoreCase: true,
 				},
@@ -7719,12 +8411,12 @@ var g = &grammar{
 		},
 		{
 			name: "INNER",
-			pos:  position{line: 997, col: 1, offset: 28133},
+			pos:  position{line: 1070, col: 1, offset: 30398},
 			expr: &actionExpr{
-				pos: position{line: 997, col: 9, offset: 28141},
+				pos: position{line: 1070, col: 9, offset: 30406},
 				run: (*parser).callonINNER1,
 				expr: &litMatcher{
-					pos:        position{line: 997, col: 9, offset: 28141},
+					pos:        position{line: 1070, col: 9, offset: 30406},
 					val:        "inner",
 					ignoreCase: true,
 				},
@@ -7732,48 +8424,48 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTokenSentinels",
-			pos:  position{line: 999, col: 1, offset: 28175},
+			pos:  position{line: 1072, col: 1, offset: 30440},
 			expr: &choiceExpr{
-				pos: position{line: 1000, col: 5, offset: 28197},
+				pos: position{line: 1073, col: 5, offset: 30462},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 5, offset: 28197},
+						pos:  position{line: 1073, col: 5, offset: 30462},
 						name: "SELECT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 14, offset: 28206},
+						pos:  position{line: 1073, col: 14, offset: 30471},
 						name: "AS",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 19, offset: 28211},
+						pos:  position{line: 1073, col: 19, offset: 30476},
 						name: "FROM",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 27, offset: 28219},
+						pos:  position{line: 1073, col: 27, offset: 30484},
 						name: "JOIN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 34, offset: 28226},
+						pos:  position{line: 1073, col: 34, offset: 30491},
 						name: "WHERE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 42, offset: 28234},
+						pos:  position{line: 1073, col: 42, offset: 30499},
 						name: "GROUP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 50, offset: 28242},
+						pos:  position{line: 1073, col: 50, offset: 30507},
 						name: "HAVING",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 59, offset: 28251},
+						pos:  position{line: 1073, col: 59, offset: 30516},
 						name: "ORDER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 67, offset: 28259},
+						pos:  position{line: 1073, col: 67, offset: 30524},
 						name: "LIMIT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1000, col: 75, offset: 28267},
+						pos:  position{line: 1073, col: 75, offset: 30532},
 						name: "ON",
 					},
 				},
@@ -7781,52 +8473,52 @@ var g = &grammar{
 		},
 		{
 			name: "Literal",
-			pos:  position{line: 1004, col: 1, offset: 28293},
+			pos:  position{line: 1077, col: 1, offset: 30558},
 			expr: &choiceExpr{
-				pos: position{line: 1005, col: 5, offset: 28305},
+				pos: position{line: 1078, col: 5, offset: 30570},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 5, offset: 28305},
+						pos:  position{line: 1078, col: 5, offset: 30570},
 						name: "TypeLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1006, col: 5, offset: 28321},
+						pos:  position{line: 1079, col: 5, offset: 30586},
 						name: "TemplateLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1007, col: 5, offset: 28341},
+						pos:  position{line: 1080, col: 5, offset: 30606},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1008, col: 5, offset: 28359},
+						pos:  position{line: 1081, col: 5, offset: 30624},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1009, col: 5, offset: 28378},
+						pos:  position{line: 1082, col: 5, offset: 30643},
 						name: "BytesLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1010, col: 5, offset: 28395},
+						pos:  position{line: 1083, col: 5, offset: 30660},
 						name: "Duration",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1011, col: 5, offset: 28408},
+						pos:  position{line: 1084, col: 5, offset: 30673},
 						name: "Time",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1012, col: 5, offset: 28417},
+						pos:  position{line: 1085, col: 5, offset: 30682},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1013, col: 5, offset: 28434},
+						pos:  position{line: 1086, col: 5, offset: 30699},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1014, col: 5, offset: 28453},
+						pos:  position{line: 1087, col: 5, offset: 30718},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1015, col: 5, offset: 28472},
+						pos:  position{line: 1088, col: 5, offset: 30737},
 						name: "NullLiteral",
 					},
 				},
@@ -7834,28 +8526,28 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 1017, col: 1, offset: 28485},
+			pos:  position{line: 1090, col: 1, offset: 30750},
 			expr: &choiceExpr{
-				pos: position{line: 1018, col: 5, offset: 28503},
+				pos: position{line: 1091, col: 5, offset: 30768},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1018, col: 5, offset: 28503},
+						pos: position{line: 1091, col: 5, offset: 30768},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1018, col: 5, offset: 28503},
+							pos: position{line: 1091, col: 5, offset: 30768},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1018, col: 5, offset: 28503},
+									pos:   position{line: 1091, col: 5, offset: 30768},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1018, col: 7, offset: 28505},
+										pos:  position{line: 1091, col: 7, offset: 30770},
 										name: "IP6Net",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1018, col: 14, offset: 28512},
+									pos: position{line: 1091, col: 14, offset: 30777},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1018, col: 15, offset: 28513},
+										pos:  position{line: 1091, col: 15, offset: 30778},
 										name: "IdentifierRest",
 									},
 								},
@@ -7863,13 +8555,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1021, col: 5, offset: 28628},
+						pos: position{line: 1094, col: 5, offset: 30893},
 						run: (*parser).callonSubnetLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1021, col: 5, offset: 28628},
+							pos:   position{line: 1094, col: 5, offset: 30893},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1021, col: 7, offset: 28630},
+								pos:  position{line: 1094, col: 7, offset: 30895},
 								name: "IP4Net",
 							},
 						},
@@ -7879,28 +8571,28 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 1025, col: 1, offset: 28734},
+			pos:  position{line: 1098, col: 1, offset: 30999},
 			expr: &choiceExpr{
-				pos: position{line: 1026, col: 5, offset: 28753},
+				pos: position{line: 1099, col: 5, offset: 31018},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1026, col: 5, offset: 28753},
+						pos: position{line: 1099, col: 5, offset: 31018},
 						run: (*parser).callonAddressLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1026, col: 5, offset: 28753},
+							pos: position{line: 1099, col: 5, offset: 31018},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1026, col: 5, offset: 28753},
+									pos:   position{line: 1099, col: 5, offset: 31018},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1026, col: 7, offset: 28755},
+										pos:  position{line: 1099, col: 7, offset: 31020},
 										name: "IP6",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1026, col: 11, offset: 28759},
+									pos: position{line: 1099, col: 11, offset: 31024},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1026, col: 12, offset: 28760},
+										pos:  position{line: 1099, col: 12, offset: 31025},
 										name: "IdentifierRest",
 									},
 								},
@@ -7908,13 +8600,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1029, col: 5, offset: 28874},
+						pos: position{line: 1102, col: 5, offset: 31139},
 						run: (*parser).callonAddressLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1029, col: 5, offset: 28874},
+							pos:   position{line: 1102, col: 5, offset: 31139},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1029, col: 7, offset: 28876},
+								pos:  position{line: 1102, col: 7, offset: 31141},
 								name: "IP",
 							},
 						},
@@ -7924,15 +8616,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 1033, col: 1, offset: 28975},
+			pos:  position{line: 1106, col: 1, offset: 31240},
 			expr: &actionExpr{
-				pos: position{line: 1034, col: 5, offset: 28992},
+				pos: position{line: 1107, col: 5, offset: 31257},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1034, col: 5, offset: 28992},
+					pos:   position{line: 1107, col: 5, offset: 31257},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1034, col: 7, offset: 28994},
+						pos:  position{line: 1107, col: 7, offset: 31259},
 						name: "FloatString",
 					},
 				},
@@ -7940,15 +8632,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 1038, col: 1, offset: 29107},
+			pos:  position{line: 1111, col: 1, offset: 31372},
 			expr: &actionExpr{
-				pos: position{line: 1039, col: 5, offset: 29126},
+				pos: position{line: 1112, col: 5, offset: 31391},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1039, col: 5, offset: 29126},
+					pos:   position{line: 1112, col: 5, offset: 31391},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1039, col: 7, offset: 29128},
+						pos:  position{line: 1112, col: 7, offset: 31393},
 						name: "IntString",
 					},
 				},
@@ -7956,24 +8648,24 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 1043, col: 1, offset: 29237},
+			pos:  position{line: 1116, col: 1, offset: 31502},
 			expr: &choiceExpr{
-				pos: position{line: 1044, col: 5, offset: 29256},
+				pos: position{line: 1117, col: 5, offset: 31521},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1044, col: 5, offset: 29256},
+						pos: position{line: 1117, col: 5, offset: 31521},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &litMatcher{
-							pos:        position{line: 1044, col: 5, offset: 29256},
+							pos:        position{line: 1117, col: 5, offset: 31521},
 							val:        "true",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1045, col: 5, offset: 29369},
+						pos: position{line: 1118, col: 5, offset: 31634},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &litMatcher{
-							pos:        position{line: 1045, col: 5, offset: 29369},
+							pos:        position{line: 1118, col: 5, offset: 31634},
 							val:        "false",
 							ignoreCase: false,
 						},
@@ -7983,12 +8675,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 1047, col: 1, offset: 29480},
+			pos:  position{line: 1120, col: 1, offset: 31745},
 			expr: &actionExpr{
-				pos: position{line: 1048, col: 5, offset: 29496},
+				pos: position{line: 1121, col: 5, offset: 31761},
 				run: (*parser).callonNullLiteral1,
 				expr: &litMatcher{
-					pos:        position{line: 1048, col: 5, offset: 29496},
+					pos:        position{line: 1121, col: 5, offset: 31761},
 					val:        "null",
 					ignoreCase: false,
 				},
@@ -7996,22 +8688,22 @@ var g = &grammar{
 		},
 		{
 			name: "BytesLiteral",
-			pos:  position{line: 1050, col: 1, offset: 29602},
+			pos:  position{line: 1123, col: 1, offset: 31867},
 			expr: &actionExpr{
-				pos: position{line: 1051, col: 5, offset: 29619},
+				pos: position{line: 1124, col: 5, offset: 31884},
 				run: (*parser).callonBytesLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1051, col: 5, offset: 29619},
+					pos: position{line: 1124, col: 5, offset: 31884},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1051, col: 5, offset: 29619},
+							pos:        position{line: 1124, col: 5, offset: 31884},
 							val:        "0x",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1051, col: 10, offset: 29624},
+							pos: position{line: 1124, col: 10, offset: 31889},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1051, col: 10, offset: 29624},
+								pos:  position{line: 1124, col: 10, offset: 31889},
 								name: "HexDigit",
 							},
 						},
@@ -8021,28 +8713,28 @@ var g = &grammar{
 		},
 		{
 			name: "TypeLiteral",
-			pos:  position{line: 1055, col: 1, offset: 29739},
+			pos:  position{line: 1128, col: 1, offset: 32004},
 			expr: &actionExpr{
-				pos: position{line: 1056, col: 5, offset: 29755},
+				pos: position{line: 1129, col: 5, offset: 32020},
 				run: (*parser).callonTypeLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1056, col: 5, offset: 29755},
+					pos: position{line: 1129, col: 5, offset: 32020},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1056, col: 5, offset: 29755},
+							pos:        position{line: 1129, col: 5, offset: 32020},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1056, col: 9, offset: 29759},
+							pos:   position{line: 1129, col: 9, offset: 32024},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1056, col: 13, offset: 29763},
+								pos:  position{line: 1129, col: 13, offset: 32028},
 								name: "Type",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1056, col: 18, offset: 29768},
+							pos:        position{line: 1129, col: 18, offset: 32033},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -8052,22 +8744,22 @@ var g = &grammar{
 		},
 		{
 			name: "CastType",
-			pos:  position{line: 1060, col: 1, offset: 29857},
+			pos:  position{line: 1133, col: 1, offset: 32122},
 			expr: &choiceExpr{
-				pos: position{line: 1061, col: 5, offset: 29870},
+				pos: position{line: 1134, col: 5, offset: 32135},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1061, col: 5, offset: 29870},
+						pos:  position{line: 1134, col: 5, offset: 32135},
 						name: "TypeLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 1062, col: 5, offset: 29886},
+						pos: position{line: 1135, col: 5, offset: 32151},
 						run: (*parser).callonCastType3,
 						expr: &labeledExpr{
-							pos:   position{line: 1062, col: 5, offset: 29886},
+							pos:   position{line: 1135, col: 5, offset: 32151},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1062, col: 9, offset: 29890},
+								pos:  position{line: 1135, col: 9, offset: 32155},
 								name: "PrimitiveType",
 							},
 						},
@@ -8077,20 +8769,20 @@ var g = &grammar{
 		},
 		{
 			name: "Type",
-			pos:  position{line: 1066, col: 1, offset: 29989},
+			pos:  position{line: 1139, col: 1, offset: 32254},
 			expr: &choiceExpr{
-				pos: position{line: 1067, col: 5, offset: 29998},
+				pos: position{line: 1140, col: 5, offset: 32263},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1067, col: 5, offset: 29998},
+						pos:  position{line: 1140, col: 5, offset: 32263},
 						name: "TypeLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1068, col: 5, offset: 30014},
+						pos:  position{line: 1141, col: 5, offset: 32279},
 						name: "AmbiguousType",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1069, col: 5, offset: 30032},
+						pos:  position{line: 1142, col: 5, offset: 32297},
 						name: "ComplexType",
 					},
 				},
@@ -8098,28 +8790,28 @@ var g = &grammar{
 		},
 		{
 			name: "AmbiguousType",
-			pos:  position{line: 1071, col: 1, offset: 30045},
+			pos:  position{line: 1144, col: 1, offset: 32310},
 			expr: &choiceExpr{
-				pos: position{line: 1072, col: 5, offset: 30063},
+				pos: position{line: 1145, col: 5, offset: 32328},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1072, col: 5, offset: 30063},
+						pos: position{line: 1145, col: 5, offset: 32328},
 						run: (*parser).callonAmbiguousType2,
 						expr: &seqExpr{
-							pos: position{line: 1072, col: 5, offset: 30063},
+							pos: position{line: 1145, col: 5, offset: 32328},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1072, col: 5, offset: 30063},
+									pos:   position{line: 1145, col: 5, offset: 32328},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1072, col: 10, offset: 30068},
+										pos:  position{line: 1145, col: 10, offset: 32333},
 										name: "PrimitiveType",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1072, col: 24, offset: 30082},
+									pos: position{line: 1145, col: 24, offset: 32347},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1072, col: 25, offset: 30083},
+										pos:  position{line: 1145, col: 25, offset: 32348},
 										name: "IdentifierRest",
 									},
 								},
@@ -8127,42 +8819,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1073, col: 5, offset: 30123},
+						pos: position{line: 1146, col: 5, offset: 32388},
 						run: (*parser).callonAmbiguousType8,
 						expr: &seqExpr{
-							pos: position{line: 1073, col: 5, offset: 30123},
+							pos: position{line: 1146, col: 5, offset: 32388},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1073, col: 5, offset: 30123},
+									pos:   position{line: 1146, col: 5, offset: 32388},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1073, col: 10, offset: 30128},
+										pos:  position{line: 1146, col: 10, offset: 32393},
 										name: "IdentifierName",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1073, col: 25, offset: 30143},
+									pos:   position{line: 1146, col: 25, offset: 32408},
 									label: "opt",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1073, col: 29, offset: 30147},
+										pos: position{line: 1146, col: 29, offset: 32412},
 										expr: &seqExpr{
-											pos: position{line: 1073, col: 30, offset: 30148},
+											pos: position{line: 1146, col: 30, offset: 32413},
 											exprs: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 1073, col: 30, offset: 30148},
+													pos:  position{line: 1146, col: 30, offset: 32413},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 1073, col: 33, offset: 30151},
+													pos:        position{line: 1146, col: 33, offset: 32416},
 													val:        "=",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1073, col: 37, offset: 30155},
+													pos:  position{line: 1146, col: 37, offset: 32420},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1073, col: 40, offset: 30158},
+													pos:  position{line: 1146, col: 40, offset: 32423},
 													name: "Type",
 												},
 											},
@@ -8173,42 +8865,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1079, col: 5, offset: 30390},
+						pos: position{line: 1152, col: 5, offset: 32655},
 						run: (*parser).callonAmbiguousType19,
 						expr: &labeledExpr{
-							pos:   position{line: 1079, col: 5, offset: 30390},
+							pos:   position{line: 1152, col: 5, offset: 32655},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1079, col: 10, offset: 30395},
+								pos:  position{line: 1152, col: 10, offset: 32660},
 								name: "QuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1082, col: 5, offset: 30495},
+						pos: position{line: 1155, col: 5, offset: 32760},
 						run: (*parser).callonAmbiguousType22,
 						expr: &seqExpr{
-							pos: position{line: 1082, col: 5, offset: 30495},
+							pos: position{line: 1155, col: 5, offset: 32760},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1082, col: 5, offset: 30495},
+									pos:        position{line: 1155, col: 5, offset: 32760},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1082, col: 9, offset: 30499},
+									pos:  position{line: 1155, col: 9, offset: 32764},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1082, col: 12, offset: 30502},
+									pos:   position{line: 1155, col: 12, offset: 32767},
 									label: "u",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1082, col: 14, offset: 30504},
+										pos:  position{line: 1155, col: 14, offset: 32769},
 										name: "TypeUnion",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1082, col: 25, offset: 30515},
+									pos:        position{line: 1155, col: 25, offset: 32780},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -8220,15 +8912,15 @@ var g = &grammar{
 		},
 		{
 			name: "TypeUnion",
-			pos:  position{line: 1084, col: 1, offset: 30538},
+			pos:  position{line: 1157, col: 1, offset: 32803},
 			expr: &actionExpr{
-				pos: position{line: 1085, col: 5, offset: 30552},
+				pos: position{line: 1158, col: 5, offset: 32817},
 				run: (*parser).callonTypeUnion1,
 				expr: &labeledExpr{
-					pos:   position{line: 1085, col: 5, offset: 30552},
+					pos:   position{line: 1158, col: 5, offset: 32817},
 					label: "types",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1085, col: 11, offset: 30558},
+						pos:  position{line: 1158, col: 11, offset: 32823},
 						name: "TypeList",
 					},
 				},
@@ -8236,28 +8928,28 @@ var g = &grammar{
 		},
 		{
 			name: "TypeList",
-			pos:  position{line: 1089, col: 1, offset: 30654},
+			pos:  position{line: 1162, col: 1, offset: 32919},
 			expr: &actionExpr{
-				pos: position{line: 1090, col: 5, offset: 30667},
+				pos: position{line: 1163, col: 5, offset: 32932},
 				run: (*parser).callonTypeList1,
 				expr: &seqExpr{
-					pos: position{line: 1090, col: 5, offset: 30667},
+					pos: position{line: 1163, col: 5, offset: 32932},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1090, col: 5, offset: 30667},
+							pos:   position{line: 1163, col: 5, offset: 32932},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1090, col: 11, offset: 30673},
+								pos:  position{line: 1163, col: 11, offset: 32938},
 								name: "Type",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1090, col: 16, offset: 30678},
+							pos:   position{line: 1163, col: 16, offset: 32943},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1090, col: 21, offset: 30683},
+								pos: position{line: 1163, col: 21, offset: 32948},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1090, col: 21, offset: 30683},
+									pos:  position{line: 1163, col: 21, offset: 32948},
 									name: "TypeListTail",
 								},
 							},
@@ -8268,31 +8960,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeListTail",
-			pos:  position{line: 1094, col: 1, offset: 30777},
+			pos:  position{line: 1167, col: 1, offset: 33042},
 			expr: &actionExpr{
-				pos: position{line: 1094, col: 16, offset: 30792},
+				pos: position{line: 1167, col: 16, offset: 33057},
 				run: (*parser).callonTypeListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1094, col: 16, offset: 30792},
+					pos: position{line: 1167, col: 16, offset: 33057},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1094, col: 16, offset: 30792},
+							pos:  position{line: 1167, col: 16, offset: 33057},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1094, col: 19, offset: 30795},
+							pos:        position{line: 1167, col: 19, offset: 33060},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1094, col: 23, offset: 30799},
+							pos:  position{line: 1167, col: 23, offset: 33064},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1094, col: 26, offset: 30802},
+							pos:   position{line: 1167, col: 26, offset: 33067},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1094, col: 30, offset: 30806},
+								pos:  position{line: 1167, col: 30, offset: 33071},
 								name: "Type",
 							},
 						},
@@ -8302,39 +8994,39 @@ var g = &grammar{
 		},
 		{
 			name: "ComplexType",
-			pos:  position{line: 1096, col: 1, offset: 30832},
+			pos:  position{line: 1169, col: 1, offset: 33097},
 			expr: &choiceExpr{
-				pos: position{line: 1097, col: 5, offset: 30848},
+				pos: position{line: 1170, col: 5, offset: 33113},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1097, col: 5, offset: 30848},
+						pos: position{line: 1170, col: 5, offset: 33113},
 						run: (*parser).callonComplexType2,
 						expr: &seqExpr{
-							pos: position{line: 1097, col: 5, offset: 30848},
+							pos: position{line: 1170, col: 5, offset: 33113},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1097, col: 5, offset: 30848},
+									pos:        position{line: 1170, col: 5, offset: 33113},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1097, col: 9, offset: 30852},
+									pos:  position{line: 1170, col: 9, offset: 33117},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1097, col: 12, offset: 30855},
+									pos:   position{line: 1170, col: 12, offset: 33120},
 									label: "fields",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1097, col: 19, offset: 30862},
+										pos:  position{line: 1170, col: 19, offset: 33127},
 										name: "TypeFieldList",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1097, col: 33, offset: 30876},
+									pos:  position{line: 1170, col: 33, offset: 33141},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1097, col: 36, offset: 30879},
+									pos:        position{line: 1170, col: 36, offset: 33144},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -8342,34 +9034,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1100, col: 5, offset: 30974},
+						pos: position{line: 1173, col: 5, offset: 33239},
 						run: (*parser).callonComplexType10,
 						expr: &seqExpr{
-							pos: position{line: 1100, col: 5, offset: 30974},
+							pos: position{line: 1173, col: 5, offset: 33239},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1100, col: 5, offset: 30974},
+									pos:        position{line: 1173, col: 5, offset: 33239},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1100, col: 9, offset: 30978},
+									pos:  position{line: 1173, col: 9, offset: 33243},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1100, col: 12, offset: 30981},
+									pos:   position{line: 1173, col: 12, offset: 33246},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1100, col: 16, offset: 30985},
+										pos:  position{line: 1173, col: 16, offset: 33250},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1100, col: 21, offset: 30990},
+									pos:  position{line: 1173, col: 21, offset: 33255},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1100, col: 24, offset: 30993},
+									pos:        position{line: 1173, col: 24, offset: 33258},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -8377,34 +9069,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1103, col: 5, offset: 31082},
+						pos: position{line: 1176, col: 5, offset: 33347},
 						run: (*parser).callonComplexType18,
 						expr: &seqExpr{
-							pos: position{line: 1103, col: 5, offset: 31082},
+							pos: position{line: 1176, col: 5, offset: 33347},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1103, col: 5, offset: 31082},
+									pos:        position{line: 1176, col: 5, offset: 33347},
 									val:        "|[",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1103, col: 10, offset: 31087},
+									pos:  position{line: 1176, col: 10, offset: 33352},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1103, col: 14, offset: 31091},
+									pos:   position{line: 1176, col: 14, offset: 33356},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1103, col: 18, offset: 31095},
+										pos:  position{line: 1176, col: 18, offset: 33360},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1103, col: 23, offset: 31100},
+									pos:  position{line: 1176, col: 23, offset: 33365},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1103, col: 26, offset: 31103},
+									pos:        position{line: 1176, col: 26, offset: 33368},
 									val:        "]|",
 									ignoreCase: false,
 								},
@@ -8412,55 +9104,55 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1106, col: 5, offset: 31191},
+						pos: position{line: 1179, col: 5, offset: 33456},
 						run: (*parser).callonComplexType26,
 						expr: &seqExpr{
-							pos: position{line: 1106, col: 5, offset: 31191},
+							pos: position{line: 1179, col: 5, offset: 33456},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1106, col: 5, offset: 31191},
+									pos:        position{line: 1179, col: 5, offset: 33456},
 									val:        "|{",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 10, offset: 31196},
+									pos:  position{line: 1179, col: 10, offset: 33461},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1106, col: 13, offset: 31199},
+									pos:   position{line: 1179, col: 13, offset: 33464},
 									label: "keyType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1106, col: 21, offset: 31207},
+										pos:  position{line: 1179, col: 21, offset: 33472},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 26, offset: 31212},
+									pos:  position{line: 1179, col: 26, offset: 33477},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1106, col: 29, offset: 31215},
+									pos:        position{line: 1179, col: 29, offset: 33480},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 33, offset: 31219},
+									pos:  position{line: 1179, col: 33, offset: 33484},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1106, col: 36, offset: 31222},
+									pos:   position{line: 1179, col: 36, offset: 33487},
 									label: "valType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1106, col: 44, offset: 31230},
+										pos:  position{line: 1179, col: 44, offset: 33495},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1106, col: 49, offset: 31235},
+									pos:  position{line: 1179, col: 49, offset: 33500},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1106, col: 52, offset: 31238},
+									pos:        position{line: 1179, col: 52, offset: 33503},
 									val:        "}|",
 									ignoreCase: false,
 								},
@@ -8472,15 +9164,15 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateLiteral",
-			pos:  position{line: 1110, col: 1, offset: 31352},
+			pos:  position{line: 1183, col: 1, offset: 33617},
 			expr: &actionExpr{
-				pos: position{line: 1111, col: 5, offset: 31372},
+				pos: position{line: 1184, col: 5, offset: 33637},
 				run: (*parser).callonTemplateLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1111, col: 5, offset: 31372},
+					pos:   position{line: 1184, col: 5, offset: 33637},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1111, col: 7, offset: 31374},
+						pos:  position{line: 1184, col: 7, offset: 33639},
 						name: "TemplateLiteralParts",
 					},
 				},
@@ -8488,34 +9180,34 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateLiteralParts",
-			pos:  position{line: 1118, col: 1, offset: 31590},
+			pos:  position{line: 1191, col: 1, offset: 33855},
 			expr: &choiceExpr{
-				pos: position{line: 1119, col: 5, offset: 31615},
+				pos: position{line: 1192, col: 5, offset: 33880},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1119, col: 5, offset: 31615},
+						pos: position{line: 1192, col: 5, offset: 33880},
 						run: (*parser).callonTemplateLiteralParts2,
 						expr: &seqExpr{
-							pos: position{line: 1119, col: 5, offset: 31615},
+							pos: position{line: 1192, col: 5, offset: 33880},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1119, col: 5, offset: 31615},
+									pos:        position{line: 1192, col: 5, offset: 33880},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1119, col: 9, offset: 31619},
+									pos:   position{line: 1192, col: 9, offset: 33884},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1119, col: 11, offset: 31621},
+										pos: position{line: 1192, col: 11, offset: 33886},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1119, col: 11, offset: 31621},
+											pos:  position{line: 1192, col: 11, offset: 33886},
 											name: "TemplateDoubleQuotedPart",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1119, col: 37, offset: 31647},
+									pos:        position{line: 1192, col: 37, offset: 33912},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -8523,29 +9215,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1120, col: 5, offset: 31673},
+						pos: position{line: 1193, col: 5, offset: 33938},
 						run: (*parser).callonTemplateLiteralParts9,
 						expr: &seqExpr{
-							pos: position{line: 1120, col: 5, offset: 31673},
+							pos: position{line: 1193, col: 5, offset: 33938},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1120, col: 5, offset: 31673},
+									pos:        position{line: 1193, col: 5, offset: 33938},
 									val:        "'",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1120, col: 9, offset: 31677},
+									pos:   position{line: 1193, col: 9, offset: 33942},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1120, col: 11, offset: 31679},
+										pos: position{line: 1193, col: 11, offset: 33944},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1120, col: 11, offset: 31679},
+											pos:  position{line: 1193, col: 11, offset: 33944},
 											name: "TemplateSingleQuotedPart",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1120, col: 37, offset: 31705},
+									pos:        position{line: 1193, col: 37, offset: 33970},
 									val:        "'",
 									ignoreCase: false,
 								},
@@ -8557,24 +9249,24 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateDoubleQuotedPart",
-			pos:  position{line: 1122, col: 1, offset: 31728},
+			pos:  position{line: 1195, col: 1, offset: 33993},
 			expr: &choiceExpr{
-				pos: position{line: 1123, col: 5, offset: 31757},
+				pos: position{line: 1196, col: 5, offset: 34022},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1123, col: 5, offset: 31757},
+						pos:  position{line: 1196, col: 5, offset: 34022},
 						name: "TemplateExpr",
 					},
 					&actionExpr{
-						pos: position{line: 1124, col: 5, offset: 31774},
+						pos: position{line: 1197, col: 5, offset: 34039},
 						run: (*parser).callonTemplateDoubleQuotedPart3,
 						expr: &labeledExpr{
-							pos:   position{line: 1124, col: 5, offset: 31774},
+							pos:   position{line: 1197, col: 5, offset: 34039},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1124, col: 7, offset: 31776},
+								pos: position{line: 1197, col: 7, offset: 34041},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1124, col: 7, offset: 31776},
+									pos:  position{line: 1197, col: 7, offset: 34041},
 									name: "TemplateDoubleQuotedChar",
 								},
 							},
@@ -8585,26 +9277,26 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateDoubleQuotedChar",
-			pos:  position{line: 1128, col: 1, offset: 31913},
+			pos:  position{line: 1201, col: 1, offset: 34178},
 			expr: &choiceExpr{
-				pos: position{line: 1129, col: 5, offset: 31942},
+				pos: position{line: 1202, col: 5, offset: 34207},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1129, col: 5, offset: 31942},
+						pos: position{line: 1202, col: 5, offset: 34207},
 						run: (*parser).callonTemplateDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1129, col: 5, offset: 31942},
+							pos: position{line: 1202, col: 5, offset: 34207},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1129, col: 5, offset: 31942},
+									pos:        position{line: 1202, col: 5, offset: 34207},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1129, col: 10, offset: 31947},
+									pos:   position{line: 1202, col: 10, offset: 34212},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1129, col: 12, offset: 31949},
+										pos:        position{line: 1202, col: 12, offset: 34214},
 										val:        "${",
 										ignoreCase: false,
 									},
@@ -8613,24 +9305,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1130, col: 5, offset: 31976},
+						pos: position{line: 1203, col: 5, offset: 34241},
 						run: (*parser).callonTemplateDoubleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1130, col: 5, offset: 31976},
+							pos: position{line: 1203, col: 5, offset: 34241},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1130, col: 5, offset: 31976},
+									pos: position{line: 1203, col: 5, offset: 34241},
 									expr: &litMatcher{
-										pos:        position{line: 1130, col: 8, offset: 31979},
+										pos:        position{line: 1203, col: 8, offset: 34244},
 										val:        "${",
 										ignoreCase: false,
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1130, col: 15, offset: 31986},
+									pos:   position{line: 1203, col: 15, offset: 34251},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1130, col: 17, offset: 31988},
+										pos:  position{line: 1203, col: 17, offset: 34253},
 										name: "DoubleQuotedChar",
 									},
 								},
@@ -8642,24 +9334,24 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateSingleQuotedPart",
-			pos:  position{line: 1132, col: 1, offset: 32024},
+			pos:  position{line: 1205, col: 1, offset: 34289},
 			expr: &choiceExpr{
-				pos: position{line: 1133, col: 5, offset: 32053},
+				pos: position{line: 1206, col: 5, offset: 34318},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1133, col: 5, offset: 32053},
+						pos:  position{line: 1206, col: 5, offset: 34318},
 						name: "TemplateExpr",
 					},
 					&actionExpr{
-						pos: position{line: 1134, col: 5, offset: 32070},
+						pos: position{line: 1207, col: 5, offset: 34335},
 						run: (*parser).callonTemplateSingleQuotedPart3,
 						expr: &labeledExpr{
-							pos:   position{line: 1134, col: 5, offset: 32070},
+							pos:   position{line: 1207, col: 5, offset: 34335},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1134, col: 7, offset: 32072},
+								pos: position{line: 1207, col: 7, offset: 34337},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1134, col: 7, offset: 32072},
+									pos:  position{line: 1207, col: 7, offset: 34337},
 									name: "TemplateSingleQuotedChar",
 								},
 							},
@@ -8670,26 +9362,26 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateSingleQuotedChar",
-			pos:  position{line: 1138, col: 1, offset: 32209},
+			pos:  position{line: 1211, col: 1, offset: 34474},
 			expr: &choiceExpr{
-				pos: position{line: 1139, col: 5, offset: 32238},
+				pos: position{line: 1212, col: 5, offset: 34503},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1139, col: 5, offset: 32238},
+						pos: position{line: 1212, col: 5, offset: 34503},
 						run: (*parser).callonTemplateSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1139, col: 5, offset: 32238},
+							pos: position{line: 1212, col: 5, offset: 34503},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1139, col: 5, offset: 32238},
+									pos:        position{line: 1212, col: 5, offset: 34503},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1139, col: 10, offset: 32243},
+									pos:   position{line: 1212, col: 10, offset: 34508},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1139, col: 12, offset: 32245},
+										pos:        position{line: 1212, col: 12, offset: 34510},
 										val:        "${",
 										ignoreCase: false,
 									},
@@ -8698,24 +9390,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1140, col: 5, offset: 32272},
+						pos: position{line: 1213, col: 5, offset: 34537},
 						run: (*parser).callonTemplateSingleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1140, col: 5, offset: 32272},
+							pos: position{line: 1213, col: 5, offset: 34537},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1140, col: 5, offset: 32272},
+									pos: position{line: 1213, col: 5, offset: 34537},
 									expr: &litMatcher{
-										pos:        position{line: 1140, col: 8, offset: 32275},
+										pos:        position{line: 1213, col: 8, offset: 34540},
 										val:        "${",
 										ignoreCase: false,
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1140, col: 15, offset: 32282},
+									pos:   position{line: 1213, col: 15, offset: 34547},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1140, col: 17, offset: 32284},
+										pos:  position{line: 1213, col: 17, offset: 34549},
 										name: "SingleQuotedChar",
 									},
 								},
@@ -8727,36 +9419,36 @@ var g = &grammar{
 		},
 		{
 			name: "TemplateExpr",
-			pos:  position{line: 1142, col: 1, offset: 32320},
+			pos:  position{line: 1215, col: 1, offset: 34585},
 			expr: &actionExpr{
-				pos: position{line: 1143, col: 5, offset: 32337},
+				pos: position{line: 1216, col: 5, offset: 34602},
 				run: (*parser).callonTemplateExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1143, col: 5, offset: 32337},
+					pos: position{line: 1216, col: 5, offset: 34602},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1143, col: 5, offset: 32337},
+							pos:        position{line: 1216, col: 5, offset: 34602},
 							val:        "${",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1143, col: 10, offset: 32342},
+							pos:  position{line: 1216, col: 10, offset: 34607},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1143, col: 13, offset: 32345},
+							pos:   position{line: 1216, col: 13, offset: 34610},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1143, col: 15, offset: 32347},
+								pos:  position{line: 1216, col: 15, offset: 34612},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1143, col: 20, offset: 32352},
+							pos:  position{line: 1216, col: 20, offset: 34617},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1143, col: 23, offset: 32355},
+							pos:        position{line: 1216, col: 23, offset: 34620},
 							val:        "}",
 							ignoreCase: false,
 						},
@@ -8766,110 +9458,160 @@ var g = &grammar{
 		},
 		{
 			name: "PrimitiveType",
-			pos:  position{line: 1158, col: 1, offset: 32651},
+			pos:  position{line: 1231, col: 1, offset: 34916},
 			expr: &actionExpr{
-				pos: position{line: 1159, col: 5, offset: 32669},
+				pos: position{line: 1232, col: 5, offset: 34934},
 				run: (*parser).callonPrimitiveType1,
 				expr: &choiceExpr{
-					pos: position{line: 1159, col: 9, offset: 32673},
+					pos: position{line: 1232, col: 9, offset: 34938},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1159, col: 9, offset: 32673},
+							pos:        position{line: 1232, col: 9, offset: 34938},
 							val:        "uint8",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1159, col: 19, offset: 32683},
+							pos:        position{line: 1232, col: 19, offset: 34948},
 							val:        "uint16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1159, col: 30, offset: 32694},
+							pos:        position{line: 1232, col: 30, offset: 34959},
 							val:        "uint32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1159, col: 41, offset: 32705},
+							pos:        position{line: 1232, col: 41, offset: 34970},
 							val:        "uint64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1160, col: 9, offset: 32722},
+							pos:        position{line: 1232, col: 52, offset: 34981},
+							val:        "uint128",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1232, col: 64, offset: 34993},
+							val:        "uint256",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1233, col: 9, offset: 35011},
 							val:        "int8",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1160, col: 18, offset: 32731},
+							pos:        position{line: 1233, col: 18, offset: 35020},
 							val:        "int16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1160, col: 28, offset: 32741},
+							pos:        position{line: 1233, col: 28, offset: 35030},
 							val:        "int32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1160, col: 38, offset: 32751},
+							pos:        position{line: 1233, col: 38, offset: 35040},
 							val:        "int64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1161, col: 9, offset: 32767},
+							pos:        position{line: 1233, col: 48, offset: 35050},
+							val:        "int128",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1233, col: 59, offset: 35061},
+							val:        "int256",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1234, col: 9, offset: 35078},
 							val:        "float16",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1161, col: 21, offset: 32779},
+							pos:        position{line: 1234, col: 21, offset: 35090},
 							val:        "float32",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1161, col: 33, offset: 32791},
+							pos:        position{line: 1234, col: 33, offset: 35102},
 							val:        "float64",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1162, col: 9, offset: 32809},
+							pos:        position{line: 1234, col: 45, offset: 35114},
+							val:        "float128",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1234, col: 58, offset: 35127},
+							val:        "float256",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1235, col: 9, offset: 35146},
+							val:        "decimal32",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1235, col: 23, offset: 35160},
+							val:        "decimal64",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1235, col: 37, offset: 35174},
+							val:        "decimal128",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1235, col: 52, offset: 35189},
+							val:        "decimal256",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1236, col: 9, offset: 35210},
 							val:        "bool",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1162, col: 18, offset: 32818},
+							pos:        position{line: 1236, col: 18, offset: 35219},
 							val:        "string",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1163, col: 9, offset: 32835},
+							pos:        position{line: 1237, col: 9, offset: 35236},
 							val:        "duration",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1163, col: 22, offset: 32848},
+							pos:        position{line: 1237, col: 22, offset: 35249},
 							val:        "time",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1164, col: 9, offset: 32863},
+							pos:        position{line: 1238, col: 9, offset: 35264},
 							val:        "bytes",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1165, col: 9, offset: 32879},
+							pos:        position{line: 1239, col: 9, offset: 35280},
 							val:        "ip",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1165, col: 16, offset: 32886},
+							pos:        position{line: 1239, col: 16, offset: 35287},
 							val:        "net",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1166, col: 9, offset: 32900},
+							pos:        position{line: 1240, col: 9, offset: 35301},
 							val:        "type",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1166, col: 18, offset: 32909},
+							pos:        position{line: 1240, col: 18, offset: 35310},
 							val:        "null",
 							ignoreCase: false,
 						},
@@ -8879,31 +9621,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldList",
-			pos:  position{line: 1170, col: 1, offset: 33025},
+			pos:  position{line: 1244, col: 1, offset: 35426},
 			expr: &choiceExpr{
-				pos: position{line: 1171, col: 5, offset: 33043},
+				pos: position{line: 1245, col: 5, offset: 35444},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1171, col: 5, offset: 33043},
+						pos: position{line: 1245, col: 5, offset: 35444},
 						run: (*parser).callonTypeFieldList2,
 						expr: &seqExpr{
-							pos: position{line: 1171, col: 5, offset: 33043},
+							pos: position{line: 1245, col: 5, offset: 35444},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1171, col: 5, offset: 33043},
+									pos:   position{line: 1245, col: 5, offset: 35444},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1171, col: 11, offset: 33049},
+										pos:  position{line: 1245, col: 11, offset: 35450},
 										name: "TypeField",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1171, col: 21, offset: 33059},
+									pos:   position{line: 1245, col: 21, offset: 35460},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1171, col: 26, offset: 33064},
+										pos: position{line: 1245, col: 26, offset: 35465},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1171, col: 26, offset: 33064},
+											pos:  position{line: 1245, col: 26, offset: 35465},
 											name: "TypeFieldListTail",
 										},
 									},
@@ -8912,10 +9654,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1174, col: 5, offset: 33166},
+						pos: position{line: 1248, col: 5, offset: 35567},
 						run: (*parser).callonTypeFieldList9,
 						expr: &litMatcher{
-							pos:        position{line: 1174, col: 5, offset: 33166},
+							pos:        position{line: 1248, col: 5, offset: 35567},
 							val:        "",
 							ignoreCase: false,
 						},
@@ -8925,31 +9667,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldListTail",
-			pos:  position{line: 1176, col: 1, offset: 33190},
+			pos:  position{line: 1250, col: 1, offset: 35591},
 			expr: &actionExpr{
-				pos: position{line: 1176, col: 21, offset: 33210},
+				pos: position{line: 1250, col: 21, offset: 35611},
 				run: (*parser).callonTypeFieldListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1176, col: 21, offset: 33210},
+					pos: position{line: 1250, col: 21, offset: 35611},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1176, col: 21, offset: 33210},
+							pos:  position{line: 1250, col: 21, offset: 35611},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1176, col: 24, offset: 33213},
+							pos:        position{line: 1250, col: 24, offset: 35614},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1176, col: 28, offset: 33217},
+							pos:  position{line: 1250, col: 28, offset: 35618},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1176, col: 31, offset: 33220},
+							pos:   position{line: 1250, col: 31, offset: 35621},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1176, col: 35, offset: 33224},
+								pos:  position{line: 1250, col: 35, offset: 35625},
 								name: "TypeField",
 							},
 						},
@@ -8959,39 +9701,39 @@ var g = &grammar{
 		},
 		{
 			name: "TypeField",
-			pos:  position{line: 1178, col: 1, offset: 33255},
+			pos:  position{line: 1252, col: 1, offset: 35656},
 			expr: &actionExpr{
-				pos: position{line: 1179, col: 5, offset: 33269},
+				pos: position{line: 1253, col: 5, offset: 35670},
 				run: (*parser).callonTypeField1,
 				expr: &seqExpr{
-					pos: position{line: 1179, col: 5, offset: 33269},
+					pos: position{line: 1253, col: 5, offset: 35670},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1179, col: 5, offset: 33269},
+							pos:   position{line: 1253, col: 5, offset: 35670},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1179, col: 10, offset: 33274},
+								pos:  position{line: 1253, col: 10, offset: 35675},
 								name: "FieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1179, col: 20, offset: 33284},
+							pos:  position{line: 1253, col: 20, offset: 35685},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1179, col: 23, offset: 33287},
+							pos:        position{line: 1253, col: 23, offset: 35688},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1179, col: 27, offset: 33291},
+							pos:  position{line: 1253, col: 27, offset: 35692},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1179, col: 30, offset: 33294},
+							pos:   position{line: 1253, col: 30, offset: 35695},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1179, col: 34, offset: 33298},
+								pos:  position{line: 1253, col: 34, offset: 35699},
 								name: "Type",
 							},
 						},
@@ -9001,16 +9743,16 @@ var g = &grammar{
 		},
 		{
 			name: "FieldName",
-			pos:  position{line: 1183, col: 1, offset: 33380},
+			pos:  position{line: 1257, col: 1, offset: 35781},
 			expr: &choiceExpr{
-				pos: position{line: 1184, col: 5, offset: 33394},
+				pos: position{line: 1258, col: 5, offset: 35795},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1184, col: 5, offset: 33394},
+						pos:  position{line: 1258, col: 5, offset: 35795},
 						name: "IdentifierName",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1185, col: 5, offset: 33413},
+						pos:  position{line: 1259, col: 5, offset: 35814},
 						name: "QuotedString",
 					},
 				},
@@ -9018,32 +9760,32 @@ var g = &grammar{
 		},
 		{
 			name: "AndToken",
-			pos:  position{line: 1187, col: 1, offset: 33427},
+			pos:  position{line: 1261, col: 1, offset: 35828},
 			expr: &actionExpr{
-				pos: position{line: 1187, col: 12, offset: 33438},
+				pos: position{line: 1261, col: 12, offset: 35839},
 				run: (*parser).callonAndToken1,
 				expr: &seqExpr{
-					pos: position{line: 1187, col: 12, offset: 33438},
+					pos: position{line: 1261, col: 12, offset: 35839},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 1187, col: 13, offset: 33439},
+							pos: position{line: 1261, col: 13, offset: 35840},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1187, col: 13, offset: 33439},
+									pos:        position{line: 1261, col: 13, offset: 35840},
 									val:        "and",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1187, col: 21, offset: 33447},
+									pos:        position{line: 1261, col: 21, offset: 35848},
 									val:        "AND",
 									ignoreCase: false,
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1187, col: 28, offset: 33454},
+							pos: position{line: 1261, col: 28, offset: 35855},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1187, col: 29, offset: 33455},
+								pos:  position{line: 1261, col: 29, offset: 35856},
 								name: "IdentifierRest",
 							},
 						},
@@ -9053,32 +9795,32 @@ var g = &grammar{
 		},
 		{
 			name: "OrToken",
-			pos:  position{line: 1188, col: 1, offset: 33492},
+			pos:  position{line: 1262, col: 1, offset: 35893},
 			expr: &actionExpr{
-				pos: position{line: 1188, col: 11, offset: 33502},
+				pos: position{line: 1262, col: 11, offset: 35903},
 				run: (*parser).callonOrToken1,
 				expr: &seqExpr{
-					pos: position{line: 1188, col: 11, offset: 33502},
+					pos: position{line: 1262, col: 11, offset: 35903},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 1188, col: 12, offset: 33503},
+							pos: position{line: 1262, col: 12, offset: 35904},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1188, col: 12, offset: 33503},
+									pos:        position{line: 1262, col: 12, offset: 35904},
 									val:        "or",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1188, col: 19, offset: 33510},
+									pos:        position{line: 1262, col: 19, offset: 35911},
 									val:        "OR",
 									ignoreCase: false,
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1188, col: 25, offset: 33516},
+							pos: position{line: 1262, col: 25, offset: 35917},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1188, col: 26, offset: 33517},
+								pos:  position{line: 1262, col: 26, offset: 35918},
 								name: "IdentifierRest",
 							},
 						},
@@ -9088,22 +9830,22 @@ var g = &grammar{
 		},
 		{
 			name: "InToken",
-			pos:  position{line: 1189, col: 1, offset: 33553},
+			pos:  position{line: 1263, col: 1, offset: 35954},
 			expr: &actionExpr{
-				pos: position{line: 1189, col: 11, offset: 33563},
+				pos: position{line: 1263, col: 11, offset: 35964},
 				run: (*parser).callonInToken1,
 				expr: &seqExpr{
-					pos: position{line: 1189, col: 11, offset: 33563},
+					pos: position{line: 1263, col: 11, offset: 35964},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1189, col: 11, offset: 33563},
+							pos:        position{line: 1263, col: 11, offset: 35964},
 							val:        "in",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 1189, col: 16, offset: 33568},
+							pos: position{line: 1263, col: 16, offset: 35969},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1189, col: 17, offset: 33569},
+								pos:  position{line: 1263, col: 17, offset: 35970},
 								name: "IdentifierRest",
 							},
 						},
@@ -9113,32 +9855,32 @@ var g = &grammar{
 		},
 		{
 			name: "NotToken",
-			pos:  position{line: 1190, col: 1, offset: 33605},
+			pos:  position{line: 1264, col: 1, offset: 36006},
 			expr: &actionExpr{
-				pos: position{line: 1190, col: 12, offset: 33616},
+				pos: position{line: 1264, col: 12, offset: 36017},
 				run: (*parser).callonNotToken1,
 				expr: &seqExpr{
-					pos: position{line: 1190, col: 12, offset: 33616},
+					pos: position{line: 1264, col: 12, offset: 36017},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 1190, col: 13, offset: 33617},
+							pos: position{line: 1264, col: 13, offset: 36018},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1190, col: 13, offset: 33617},
+									pos:        position{line: 1264, col: 13, offset: 36018},
 									val:        "not",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1190, col: 21, offset: 33625},
+									pos:        position{line: 1264, col: 21, offset: 36026},
 									val:        "NOT",
 									ignoreCase: false,
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1190, col: 28, offset: 33632},
+							pos: position{line: 1264, col: 28, offset: 36033},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1190, col: 29, offset: 33633},
+								pos:  position{line: 1264, col: 29, offset: 36034},
 								name: "IdentifierRest",
 							},
 						},
@@ -9148,22 +9890,22 @@ var g = &grammar{
 		},
 		{
 			name: "ByToken",
-			pos:  position{line: 1191, col: 1, offset: 33670},
+			pos:  position{line: 1265, col: 1, offset: 36071},
 			expr: &actionExpr{
-				pos: position{line: 1191, col: 11, offset: 33680},
+				pos: position{line: 1265, col: 11, offset: 36081},
 				run: (*parser).callonByToken1,
 				expr: &seqExpr{
-					pos: position{line: 1191, col: 11, offset: 33680},
+					pos: position{line: 1265, col: 11, offset: 36081},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1191, col: 11, offset: 33680},
+							pos:        position{line: 1265, col: 11, offset: 36081},
 							val:        "by",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 1191, col: 16, offset: 33685},
+							pos: position{line: 1265, col: 16, offset: 36086},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1191, col: 17, offset: 33686},
+								pos:  position{line: 1265, col: 17, offset: 36087},
 								name: "IdentifierRest",
 							},
 						},
@@ -9173,9 +9915,9 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 1193, col: 1, offset: 33723},
+			pos:  position{line: 1267, col: 1, offset: 36124},
 			expr: &charClassMatcher{
-				pos:        position{line: 1193, col: 19, offset: 33741},
+				pos:        position{line: 1267, col: 19, offset: 36142},
 				val:        "[A-Za-z_$]",
 				chars:      []rune{'_', '$'},
 				ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -9185,16 +9927,16 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierRest",
-			pos:  position{line: 1195, col: 1, offset: 33753},
+			pos:  position{line: 1269, col: 1, offset: 36154},
 			expr: &choiceExpr{
-				pos: position{line: 1195, col: 18, offset: 33770},
+				pos: position{line: 1269, col: 18, offset: 36171},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1195, col: 18, offset: 33770},
+						pos:  position{line: 1269, col: 18, offset: 36171},
 						name: "IdentifierStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1195, col: 36, offset: 33788},
+						pos:        position{line: 1269, col: 36, offset: 36189},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -9205,15 +9947,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 1197, col: 1, offset: 33795},
+			pos:  position{line: 1271, col: 1, offset: 36196},
 			expr: &actionExpr{
-				pos: position{line: 1198, col: 5, offset: 33810},
+				pos: position{line: 1272, col: 5, offset: 36211},
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 1198, col: 5, offset: 33810},
+					pos:   position{line: 1272, col: 5, offset: 36211},
 					label: "id",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1198, col: 8, offset: 33813},
+						pos:  position{line: 1272, col: 8, offset: 36214},
 						name: "IdentifierName",
 					},
 				},
@@ -9221,29 +9963,29 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 1200, col: 1, offset: 33894},
+			pos:  position{line: 1274, col: 1, offset: 36295},
 			expr: &choiceExpr{
-				pos: position{line: 1201, col: 5, offset: 33913},
+				pos: position{line: 1275, col: 5, offset: 36314},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1201, col: 5, offset: 33913},
+						pos: position{line: 1275, col: 5, offset: 36314},
 						run: (*parser).callonIdentifierName2,
 						expr: &seqExpr{
-							pos: position{line: 1201, col: 5, offset: 33913},
+							pos: position{line: 1275, col: 5, offset: 36314},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1201, col: 5, offset: 33913},
+									pos: position{line: 1275, col: 5, offset: 36314},
 									expr: &seqExpr{
-										pos: position{line: 1201, col: 7, offset: 33915},
+										pos: position{line: 1275, col: 7, offset: 36316},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1201, col: 7, offset: 33915},
+												pos:  position{line: 1275, col: 7, offset: 36316},
 												name: "IDGuard",
 											},
 											&notExpr{
-												pos: position{line: 1201, col: 15, offset: 33923},
+												pos: position{line: 1275, col: 15, offset: 36324},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1201, col: 16, offset: 33924},
+													pos:  position{line: 1275, col: 16, offset: 36325},
 													name: "IdentifierRest",
 												},
 											},
@@ -9251,13 +9993,13 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1201, col: 32, offset: 33940},
+									pos:  position{line: 1275, col: 32, offset: 36341},
 									name: "IdentifierStart",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1201, col: 48, offset: 33956},
+									pos: position{line: 1275, col: 48, offset: 36357},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1201, col: 48, offset: 33956},
+										pos:  position{line: 1275, col: 48, offset: 36357},
 										name: "IdentifierRest",
 									},
 								},
@@ -9265,30 +10007,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1202, col: 5, offset: 34008},
+						pos: position{line: 1276, col: 5, offset: 36409},
 						run: (*parser).callonIdentifierName12,
 						expr: &litMatcher{
-							pos:        position{line: 1202, col: 5, offset: 34008},
+							pos:        position{line: 1276, col: 5, offset: 36409},
 							val:        "$",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1203, col: 5, offset: 34047},
+						pos: position{line: 1277, col: 5, offset: 36448},
 						run: (*parser).callonIdentifierName14,
 						expr: &seqExpr{
-							pos: position{line: 1203, col: 5, offset: 34047},
+							pos: position{line: 1277, col: 5, offset: 36448},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1203, col: 5, offset: 34047},
+									pos:        position{line: 1277, col: 5, offset: 36448},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1203, col: 10, offset: 34052},
+									pos:   position{line: 1277, col: 10, offset: 36453},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1203, col: 13, offset: 34055},
+										pos:  position{line: 1277, col: 13, offset: 36456},
 										name: "IDGuard",
 									},
 								},
@@ -9296,39 +10038,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1205, col: 5, offset: 34146},
+						pos: position{line: 1279, col: 5, offset: 36547},
 						run: (*parser).callonIdentifierName19,
 						expr: &litMatcher{
-							pos:        position{line: 1205, col: 5, offset: 34146},
+							pos:        position{line: 1279, col: 5, offset: 36547},
 							val:        "type",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1206, col: 5, offset: 34188},
+						pos: position{line: 1280, col: 5, offset: 36589},
 						run: (*parser).callonIdentifierName21,
 						expr: &seqExpr{
-							pos: position{line: 1206, col: 5, offset: 34188},
+							pos: position{line: 1280, col: 5, offset: 36589},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1206, col: 5, offset: 34188},
+									pos:   position{line: 1280, col: 5, offset: 36589},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1206, col: 8, offset: 34191},
+										pos:  position{line: 1280, col: 8, offset: 36592},
 										name: "SQLTokenSentinels",
 									},
 								},
 								&andExpr{
-									pos: position{line: 1206, col: 26, offset: 34209},
+									pos: position{line: 1280, col: 26, offset: 36610},
 									expr: &seqExpr{
-										pos: position{line: 1206, col: 28, offset: 34211},
+										pos: position{line: 1280, col: 28, offset: 36612},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1206, col: 28, offset: 34211},
+												pos:  position{line: 1280, col: 28, offset: 36612},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1206, col: 31, offset: 34214},
+												pos:        position{line: 1280, col: 31, offset: 36615},
 												val:        "(",
 												ignoreCase: false,
 											},
@@ -9343,50 +10085,50 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierNames",
-			pos:  position{line: 1208, col: 1, offset: 34239},
+			pos:  position{line: 1282, col: 1, offset: 36640},
 			expr: &actionExpr{
-				pos: position{line: 1209, col: 5, offset: 34259},
+				pos: position{line: 1283, col: 5, offset: 36660},
 				run: (*parser).callonIdentifierNames1,
 				expr: &seqExpr{
-					pos: position{line: 1209, col: 5, offset: 34259},
+					pos: position{line: 1283, col: 5, offset: 36660},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1209, col: 5, offset: 34259},
+							pos:   position{line: 1283, col: 5, offset: 36660},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1209, col: 11, offset: 34265},
+								pos:  position{line: 1283, col: 11, offset: 36666},
 								name: "IdentifierName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1209, col: 26, offset: 34280},
+							pos:   position{line: 1283, col: 26, offset: 36681},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1209, col: 31, offset: 34285},
+								pos: position{line: 1283, col: 31, offset: 36686},
 								expr: &actionExpr{
-									pos: position{line: 1209, col: 32, offset: 34286},
+									pos: position{line: 1283, col: 32, offset: 36687},
 									run: (*parser).callonIdentifierNames7,
 									expr: &seqExpr{
-										pos: position{line: 1209, col: 32, offset: 34286},
+										pos: position{line: 1283, col: 32, offset: 36687},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1209, col: 32, offset: 34286},
+												pos:  position{line: 1283, col: 32, offset: 36687},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1209, col: 35, offset: 34289},
+												pos:        position{line: 1283, col: 35, offset: 36690},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1209, col: 39, offset: 34293},
+												pos:  position{line: 1283, col: 39, offset: 36694},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1209, col: 42, offset: 34296},
+												pos:   position{line: 1283, col: 42, offset: 36697},
 												label: "id",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1209, col: 45, offset: 34299},
+													pos:  position{line: 1283, col: 45, offset: 36700},
 													name: "IdentifierName",
 												},
 											},
@@ -9401,24 +10143,24 @@ var g = &grammar{
 		},
 		{
 			name: "IDGuard",
-			pos:  position{line: 1213, col: 1, offset: 34414},
+			pos:  position{line: 1287, col: 1, offset: 36815},
 			expr: &choiceExpr{
-				pos: position{line: 1214, col: 5, offset: 34426},
+				pos: position{line: 1288, col: 5, offset: 36827},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1214, col: 5, offset: 34426},
+						pos:  position{line: 1288, col: 5, offset: 36827},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1215, col: 5, offset: 34445},
+						pos:  position{line: 1289, col: 5, offset: 36846},
 						name: "NullLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1216, col: 5, offset: 34461},
+						pos:  position{line: 1290, col: 5, offset: 36862},
 						name: "NaN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1217, col: 5, offset: 34469},
+						pos:  position{line: 1291, col: 5, offset: 36870},
 						name: "Infinity",
 					},
 				},
@@ -9426,24 +10168,24 @@ var g = &grammar{
 		},
 		{
 			name: "Time",
-			pos:  position{line: 1219, col: 1, offset: 34479},
+			pos:  position{line: 1293, col: 1, offset: 36880},
 			expr: &actionExpr{
-				pos: position{line: 1220, col: 5, offset: 34488},
+				pos: position{line: 1294, col: 5, offset: 36889},
 				run: (*parser).callonTime1,
 				expr: &seqExpr{
-					pos: position{line: 1220, col: 5, offset: 34488},
+					pos: position{line: 1294, col: 5, offset: 36889},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1220, col: 5, offset: 34488},
+							pos:  position{line: 1294, col: 5, offset: 36889},
 							name: "FullDate",
 						},
 						&litMatcher{
-							pos:        position{line: 1220, col: 14, offset: 34497},
+							pos:        position{line: 1294, col: 14, offset: 36898},
 							val:        "T",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1220, col: 18, offset: 34501},
+							pos:  position{line: 1294, col: 18, offset: 36902},
 							name: "FullTime",
 						},
 					},
@@ -9452,30 +10194,30 @@ var g = &grammar{
 		},
 		{
 			name: "FullDate",
-			pos:  position{line: 1224, col: 1, offset: 34621},
+			pos:  position{line: 1298, col: 1, offset: 37022},
 			expr: &seqExpr{
-				pos: position{line: 1224, col: 12, offset: 34632},
+				pos: position{line: 1298, col: 12, offset: 37033},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1224, col: 12, offset: 34632},
+						pos:  position{line: 1298, col: 12, offset: 37033},
 						name: "D4",
 					},
 					&litMatcher{
-						pos:        position{line: 1224, col: 15, offset: 34635},
+						pos:        position{line: 1298, col: 15, offset: 37036},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1224, col: 19, offset: 34639},
+						pos:  position{line: 1298, col: 19, offset: 37040},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1224, col: 22, offset: 34642},
+						pos:        position{line: 1298, col: 22, offset: 37043},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1224, col: 26, offset: 34646},
+						pos:  position{line: 1298, col: 26, offset: 37047},
 						name: "D2",
 					},
 				},
@@ -9483,33 +10225,33 @@ var g = &grammar{
 		},
 		{
 			name: "D4",
-			pos:  position{line: 1226, col: 1, offset: 34650},
+			pos:  position{line: 1300, col: 1, offset: 37051},
 			expr: &seqExpr{
-				pos: position{line: 1226, col: 6, offset: 34655},
+				pos: position{line: 1300, col: 6, offset: 37056},
 				exprs: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1226, col: 6, offset: 34655},
+						pos:        position{line: 1300, col: 6, offset: 37056},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1226, col: 11, offset: 34660},
+						pos:        position{line: 1300, col: 11, offset: 37061},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1226, col: 16, offset: 34665},
+						pos:        position{line: 1300, col: 16, offset: 37066},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1226, col: 21, offset: 34670},
+						pos:        position{line: 1300, col: 21, offset: 37071},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -9520,19 +10262,19 @@ var g = &grammar{
 		},
 		{
 			name: "D2",
-			pos:  position{line: 1227, col: 1, offset: 34676},
+			pos:  position{line: 1301, col: 1, offset: 37077},
 			expr: &seqExpr{
-				pos: position{line: 1227, col: 6, offset: 34681},
+				pos: position{line: 1301, col: 6, offset: 37082},
 				exprs: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1227, col: 6, offset: 34681},
+						pos:        position{line: 1301, col: 6, offset: 37082},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1227, col: 11, offset: 34686},
+						pos:        position{line: 1301, col: 11, offset: 37087},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -9543,16 +10285,16 @@ var g = &grammar{
 		},
 		{
 			name: "FullTime",
-			pos:  position{line: 1229, col: 1, offset: 34693},
+			pos:  position{line: 1303, col: 1, offset: 37094},
 			expr: &seqExpr{
-				pos: position{line: 1229, col: 12, offset: 34704},
+				pos: position{line: 1303, col: 12, offset: 37105},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1229, col: 12, offset: 34704},
+						pos:  position{line: 1303, col: 12, offset: 37105},
 						name: "PartialTime",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1229, col: 24, offset: 34716},
+						pos:  position{line: 1303, col: 24, offset: 37117},
 						name: "TimeOffset",
 					},
 				},
@@ -9560,46 +10302,46 @@ var g = &grammar{
 		},
 		{
 			name: "PartialTime",
-			pos:  position{line: 1231, col: 1, offset: 34728},
+			pos:  position{line: 1305, col: 1, offset: 37129},
 			expr: &seqExpr{
-				pos: position{line: 1231, col: 15, offset: 34742},
+				pos: position{line: 1305, col: 15, offset: 37143},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1231, col: 15, offset: 34742},
+						pos:  position{line: 1305, col: 15, offset: 37143},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1231, col: 18, offset: 34745},
+						pos:        position{line: 1305, col: 18, offset: 37146},
 						val:        ":",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1231, col: 22, offset: 34749},
+						pos:  position{line: 1305, col: 22, offset: 37150},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1231, col: 25, offset: 34752},
+						pos:        position{line: 1305, col: 25, offset: 37153},
 						val:        ":",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1231, col: 29, offset: 34756},
+						pos:  position{line: 1305, col: 29, offset: 37157},
 						name: "D2",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1231, col: 32, offset: 34759},
+						pos: position{line: 1305, col: 32, offset: 37160},
 						expr: &seqExpr{
-							pos: position{line: 1231, col: 33, offset: 34760},
+							pos: position{line: 1305, col: 33, offset: 37161},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1231, col: 33, offset: 34760},
+									pos:        position{line: 1305, col: 33, offset: 37161},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1231, col: 37, offset: 34764},
+									pos: position{line: 1305, col: 37, offset: 37165},
 									expr: &charClassMatcher{
-										pos:        position{line: 1231, col: 37, offset: 34764},
+										pos:        position{line: 1305, col: 37, offset: 37165},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -9614,60 +10356,60 @@ var g = &grammar{
 		},
 		{
 			name: "TimeOffset",
-			pos:  position{line: 1233, col: 1, offset: 34774},
+			pos:  position{line: 1307, col: 1, offset: 37175},
 			expr: &choiceExpr{
-				pos: position{line: 1234, col: 5, offset: 34789},
+				pos: position{line: 1308, col: 5, offset: 37190},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1234, col: 5, offset: 34789},
+						pos:        position{line: 1308, col: 5, offset: 37190},
 						val:        "Z",
 						ignoreCase: false,
 					},
 					&seqExpr{
-						pos: position{line: 1235, col: 5, offset: 34797},
+						pos: position{line: 1309, col: 5, offset: 37198},
 						exprs: []interface{}{
 							&choiceExpr{
-								pos: position{line: 1235, col: 6, offset: 34798},
+								pos: position{line: 1309, col: 6, offset: 37199},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 1235, col: 6, offset: 34798},
+										pos:        position{line: 1309, col: 6, offset: 37199},
 										val:        "+",
 										ignoreCase: false,
 									},
 									&litMatcher{
-										pos:        position{line: 1235, col: 12, offset: 34804},
+										pos:        position{line: 1309, col: 12, offset: 37205},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1235, col: 17, offset: 34809},
+								pos:  position{line: 1309, col: 17, offset: 37210},
 								name: "D2",
 							},
 							&litMatcher{
-								pos:        position{line: 1235, col: 20, offset: 34812},
+								pos:        position{line: 1309, col: 20, offset: 37213},
 								val:        ":",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1235, col: 24, offset: 34816},
+								pos:  position{line: 1309, col: 24, offset: 37217},
 								name: "D2",
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1235, col: 27, offset: 34819},
+								pos: position{line: 1309, col: 27, offset: 37220},
 								expr: &seqExpr{
-									pos: position{line: 1235, col: 28, offset: 34820},
+									pos: position{line: 1309, col: 28, offset: 37221},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1235, col: 28, offset: 34820},
+											pos:        position{line: 1309, col: 28, offset: 37221},
 											val:        ".",
 											ignoreCase: false,
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1235, col: 32, offset: 34824},
+											pos: position{line: 1309, col: 32, offset: 37225},
 											expr: &charClassMatcher{
-												pos:        position{line: 1235, col: 32, offset: 34824},
+												pos:        position{line: 1309, col: 32, offset: 37225},
 												val:        "[0-9]",
 												ranges:     []rune{'0', '9'},
 												ignoreCase: false,
@@ -9684,32 +10426,32 @@ var g = &grammar{
 		},
 		{
 			name: "Duration",
-			pos:  position{line: 1237, col: 1, offset: 34834},
+			pos:  position{line: 1311, col: 1, offset: 37235},
 			expr: &actionExpr{
-				pos: position{line: 1238, col: 5, offset: 34847},
+				pos: position{line: 1312, col: 5, offset: 37248},
 				run: (*parser).callonDuration1,
 				expr: &seqExpr{
-					pos: position{line: 1238, col: 5, offset: 34847},
+					pos: position{line: 1312, col: 5, offset: 37248},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 1238, col: 5, offset: 34847},
+							pos: position{line: 1312, col: 5, offset: 37248},
 							expr: &litMatcher{
-								pos:        position{line: 1238, col: 5, offset: 34847},
+								pos:        position{line: 1312, col: 5, offset: 37248},
 								val:        "-",
 								ignoreCase: false,
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1238, col: 10, offset: 34852},
+							pos: position{line: 1312, col: 10, offset: 37253},
 							expr: &seqExpr{
-								pos: position{line: 1238, col: 11, offset: 34853},
+								pos: position{line: 1312, col: 11, offset: 37254},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1238, col: 11, offset: 34853},
+										pos:  position{line: 1312, col: 11, offset: 37254},
 										name: "Decimal",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1238, col: 19, offset: 34861},
+										pos:  position{line: 1312, col: 19, offset: 37262},
 										name: "TimeUnit",
 									},
 								},
@@ -9721,26 +10463,26 @@ var g = &grammar{
 		},
 		{
 			name: "Decimal",
-			pos:  position{line: 1242, col: 1, offset: 34987},
+			pos:  position{line: 1316, col: 1, offset: 37388},
 			expr: &seqExpr{
-				pos: position{line: 1242, col: 11, offset: 34997},
+				pos: position{line: 1316, col: 11, offset: 37398},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1242, col: 11, offset: 34997},
+						pos:  position{line: 1316, col: 11, offset: 37398},
 						name: "UInt",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1242, col: 16, offset: 35002},
+						pos: position{line: 1316, col: 16, offset: 37403},
 						expr: &seqExpr{
-							pos: position{line: 1242, col: 17, offset: 35003},
+							pos: position{line: 1316, col: 17, offset: 37404},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1242, col: 17, offset: 35003},
+									pos:        position{line: 1316, col: 17, offset: 37404},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1242, col: 21, offset: 35007},
+									pos:  position{line: 1316, col: 21, offset: 37408},
 									name: "UInt",
 								},
 							},
@@ -9751,52 +10493,52 @@ var g = &grammar{
 		},
 		{
 			name: "TimeUnit",
-			pos:  position{line: 1244, col: 1, offset: 35015},
+			pos:  position{line: 1318, col: 1, offset: 37416},
 			expr: &choiceExpr{
-				pos: position{line: 1245, col: 5, offset: 35028},
+				pos: position{line: 1319, col: 5, offset: 37429},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1245, col: 5, offset: 35028},
+						pos:        position{line: 1319, col: 5, offset: 37429},
 						val:        "ns",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1246, col: 5, offset: 35037},
+						pos:        position{line: 1320, col: 5, offset: 37438},
 						val:        "us",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1247, col: 5, offset: 35046},
+						pos:        position{line: 1321, col: 5, offset: 37447},
 						val:        "ms",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1248, col: 5, offset: 35055},
+						pos:        position{line: 1322, col: 5, offset: 37456},
 						val:        "s",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1249, col: 5, offset: 35063},
+						pos:        position{line: 1323, col: 5, offset: 37464},
 						val:        "m",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1250, col: 5, offset: 35071},
+						pos:        position{line: 1324, col: 5, offset: 37472},
 						val:        "h",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1251, col: 5, offset: 35079},
+						pos:        position{line: 1325, col: 5, offset: 37480},
 						val:        "d",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1252, col: 5, offset: 35087},
+						pos:        position{line: 1326, col: 5, offset: 37488},
 						val:        "w",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1253, col: 5, offset: 35095},
+						pos:        position{line: 1327, col: 5, offset: 37496},
 						val:        "y",
 						ignoreCase: false,
 					},
@@ -9805,42 +10547,42 @@ var g = &grammar{
 		},
 		{
 			name: "IP",
-			pos:  position{line: 1255, col: 1, offset: 35100},
+			pos:  position{line: 1329, col: 1, offset: 37501},
 			expr: &actionExpr{
-				pos: position{line: 1256, col: 5, offset: 35107},
+				pos: position{line: 1330, col: 5, offset: 37508},
 				run: (*parser).callonIP1,
 				expr: &seqExpr{
-					pos: position{line: 1256, col: 5, offset: 35107},
+					pos: position{line: 1330, col: 5, offset: 37508},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1256, col: 5, offset: 35107},
+							pos:  position{line: 1330, col: 5, offset: 37508},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1256, col: 10, offset: 35112},
+							pos:        position{line: 1330, col: 10, offset: 37513},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1256, col: 14, offset: 35116},
+							pos:  position{line: 1330, col: 14, offset: 37517},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1256, col: 19, offset: 35121},
+							pos:        position{line: 1330, col: 19, offset: 37522},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1256, col: 23, offset: 35125},
+							pos:  position{line: 1330, col: 23, offset: 37526},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1256, col: 28, offset: 35130},
+							pos:        position{line: 1330, col: 28, offset: 37531},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1256, col: 32, offset: 35134},
+							pos:  position{line: 1330, col: 32, offset: 37535},
 							name: "UInt",
 						},
 					},
@@ -9849,42 +10591,42 @@ var g = &grammar{
 		},
 		{
 			name: "IP6",
-			pos:  position{line: 1258, col: 1, offset: 35171},
+			pos:  position{line: 1332, col: 1, offset: 37572},
 			expr: &actionExpr{
-				pos: position{line: 1259, col: 5, offset: 35179},
+				pos: position{line: 1333, col: 5, offset: 37580},
 				run: (*parser).callonIP61,
 				expr: &seqExpr{
-					pos: position{line: 1259, col: 5, offset: 35179},
+					pos: position{line: 1333, col: 5, offset: 37580},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1259, col: 5, offset: 35179},
+							pos: position{line: 1333, col: 5, offset: 37580},
 							expr: &seqExpr{
-								pos: position{line: 1259, col: 8, offset: 35182},
+								pos: position{line: 1333, col: 8, offset: 37583},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1259, col: 8, offset: 35182},
+										pos:  position{line: 1333, col: 8, offset: 37583},
 										name: "Hex",
 									},
 									&litMatcher{
-										pos:        position{line: 1259, col: 12, offset: 35186},
+										pos:        position{line: 1333, col: 12, offset: 37587},
 										val:        ":",
 										ignoreCase: false,
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1259, col: 16, offset: 35190},
+										pos:  position{line: 1333, col: 16, offset: 37591},
 										name: "Hex",
 									},
 									&notExpr{
-										pos: position{line: 1259, col: 20, offset: 35194},
+										pos: position{line: 1333, col: 20, offset: 37595},
 										expr: &choiceExpr{
-											pos: position{line: 1259, col: 22, offset: 35196},
+											pos: position{line: 1333, col: 22, offset: 37597},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 1259, col: 22, offset: 35196},
+													pos:  position{line: 1333, col: 22, offset: 37597},
 													name: "HexDigit",
 												},
 												&litMatcher{
-													pos:        position{line: 1259, col: 33, offset: 35207},
+													pos:        position{line: 1333, col: 33, offset: 37608},
 													val:        ":",
 													ignoreCase: false,
 												},
@@ -9895,10 +10637,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1259, col: 39, offset: 35213},
+							pos:   position{line: 1333, col: 39, offset: 37614},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1259, col: 41, offset: 35215},
+								pos:  position{line: 1333, col: 41, offset: 37616},
 								name: "IP6Variations",
 							},
 						},
@@ -9908,32 +10650,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Variations",
-			pos:  position{line: 1263, col: 1, offset: 35379},
+			pos:  position{line: 1337, col: 1, offset: 37780},
 			expr: &choiceExpr{
-				pos: position{line: 1264, col: 5, offset: 35397},
+				pos: position{line: 1338, col: 5, offset: 37798},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1264, col: 5, offset: 35397},
+						pos: position{line: 1338, col: 5, offset: 37798},
 						run: (*parser).callonIP6Variations2,
 						expr: &seqExpr{
-							pos: position{line: 1264, col: 5, offset: 35397},
+							pos: position{line: 1338, col: 5, offset: 37798},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1264, col: 5, offset: 35397},
+									pos:   position{line: 1338, col: 5, offset: 37798},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1264, col: 7, offset: 35399},
+										pos: position{line: 1338, col: 7, offset: 37800},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1264, col: 7, offset: 35399},
+											pos:  position{line: 1338, col: 7, offset: 37800},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1264, col: 17, offset: 35409},
+									pos:   position{line: 1338, col: 17, offset: 37810},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1264, col: 19, offset: 35411},
+										pos:  position{line: 1338, col: 19, offset: 37812},
 										name: "IP6Tail",
 									},
 								},
@@ -9941,51 +10683,51 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1267, col: 5, offset: 35475},
+						pos: position{line: 1341, col: 5, offset: 37876},
 						run: (*parser).callonIP6Variations9,
 						expr: &seqExpr{
-							pos: position{line: 1267, col: 5, offset: 35475},
+							pos: position{line: 1341, col: 5, offset: 37876},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1267, col: 5, offset: 35475},
+									pos:   position{line: 1341, col: 5, offset: 37876},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1267, col: 7, offset: 35477},
+										pos:  position{line: 1341, col: 7, offset: 37878},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1267, col: 11, offset: 35481},
+									pos:   position{line: 1341, col: 11, offset: 37882},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1267, col: 13, offset: 35483},
+										pos: position{line: 1341, col: 13, offset: 37884},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1267, col: 13, offset: 35483},
+											pos:  position{line: 1341, col: 13, offset: 37884},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1267, col: 23, offset: 35493},
+									pos:        position{line: 1341, col: 23, offset: 37894},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1267, col: 28, offset: 35498},
+									pos:   position{line: 1341, col: 28, offset: 37899},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1267, col: 30, offset: 35500},
+										pos: position{line: 1341, col: 30, offset: 37901},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1267, col: 30, offset: 35500},
+											pos:  position{line: 1341, col: 30, offset: 37901},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1267, col: 40, offset: 35510},
+									pos:   position{line: 1341, col: 40, offset: 37911},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1267, col: 42, offset: 35512},
+										pos:  position{line: 1341, col: 42, offset: 37913},
 										name: "IP6Tail",
 									},
 								},
@@ -9993,32 +10735,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1270, col: 5, offset: 35611},
+						pos: position{line: 1344, col: 5, offset: 38012},
 						run: (*parser).callonIP6Variations22,
 						expr: &seqExpr{
-							pos: position{line: 1270, col: 5, offset: 35611},
+							pos: position{line: 1344, col: 5, offset: 38012},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1270, col: 5, offset: 35611},
+									pos:        position{line: 1344, col: 5, offset: 38012},
 									val:        "::",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1270, col: 10, offset: 35616},
+									pos:   position{line: 1344, col: 10, offset: 38017},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1270, col: 12, offset: 35618},
+										pos: position{line: 1344, col: 12, offset: 38019},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1270, col: 12, offset: 35618},
+											pos:  position{line: 1344, col: 12, offset: 38019},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1270, col: 22, offset: 35628},
+									pos:   position{line: 1344, col: 22, offset: 38029},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1270, col: 24, offset: 35630},
+										pos:  position{line: 1344, col: 24, offset: 38031},
 										name: "IP6Tail",
 									},
 								},
@@ -10026,32 +10768,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1273, col: 5, offset: 35701},
+						pos: position{line: 1347, col: 5, offset: 38102},
 						run: (*parser).callonIP6Variations30,
 						expr: &seqExpr{
-							pos: position{line: 1273, col: 5, offset: 35701},
+							pos: position{line: 1347, col: 5, offset: 38102},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1273, col: 5, offset: 35701},
+									pos:   position{line: 1347, col: 5, offset: 38102},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1273, col: 7, offset: 35703},
+										pos:  position{line: 1347, col: 7, offset: 38104},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1273, col: 11, offset: 35707},
+									pos:   position{line: 1347, col: 11, offset: 38108},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1273, col: 13, offset: 35709},
+										pos: position{line: 1347, col: 13, offset: 38110},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1273, col: 13, offset: 35709},
+											pos:  position{line: 1347, col: 13, offset: 38110},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1273, col: 23, offset: 35719},
+									pos:        position{line: 1347, col: 23, offset: 38120},
 									val:        "::",
 									ignoreCase: false,
 								},
@@ -10059,10 +10801,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1276, col: 5, offset: 35787},
+						pos: position{line: 1350, col: 5, offset: 38188},
 						run: (*parser).callonIP6Variations38,
 						expr: &litMatcher{
-							pos:        position{line: 1276, col: 5, offset: 35787},
+							pos:        position{line: 1350, col: 5, offset: 38188},
 							val:        "::",
 							ignoreCase: false,
 						},
@@ -10072,16 +10814,16 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Tail",
-			pos:  position{line: 1280, col: 1, offset: 35824},
+			pos:  position{line: 1354, col: 1, offset: 38225},
 			expr: &choiceExpr{
-				pos: position{line: 1281, col: 5, offset: 35836},
+				pos: position{line: 1355, col: 5, offset: 38237},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1281, col: 5, offset: 35836},
+						pos:  position{line: 1355, col: 5, offset: 38237},
 						name: "IP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1282, col: 5, offset: 35843},
+						pos:  position{line: 1356, col: 5, offset: 38244},
 						name: "Hex",
 					},
 				},
@@ -10089,23 +10831,23 @@ var g = &grammar{
 		},
 		{
 			name: "ColonHex",
-			pos:  position{line: 1284, col: 1, offset: 35848},
+			pos:  position{line: 1358, col: 1, offset: 38249},
 			expr: &actionExpr{
-				pos: position{line: 1284, col: 12, offset: 35859},
+				pos: position{line: 1358, col: 12, offset: 38260},
 				run: (*parser).callonColonHex1,
 				expr: &seqExpr{
-					pos: position{line: 1284, col: 12, offset: 35859},
+					pos: position{line: 1358, col: 12, offset: 38260},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1284, col: 12, offset: 35859},
+							pos:        position{line: 1358, col: 12, offset: 38260},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1284, col: 16, offset: 35863},
+							pos:   position{line: 1358, col: 16, offset: 38264},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1284, col: 18, offset: 35865},
+								pos:  position{line: 1358, col: 18, offset: 38266},
 								name: "Hex",
 							},
 						},
@@ -10115,23 +10857,23 @@ var g = &grammar{
 		},
 		{
 			name: "HexColon",
-			pos:  position{line: 1286, col: 1, offset: 35903},
+			pos:  position{line: 1360, col: 1, offset: 38304},
 			expr: &actionExpr{
-				pos: position{line: 1286, col: 12, offset: 35914},
+				pos: position{line: 1360, col: 12, offset: 38315},
 				run: (*parser).callonHexColon1,
 				expr: &seqExpr{
-					pos: position{line: 1286, col: 12, offset: 35914},
+					pos: position{line: 1360, col: 12, offset: 38315},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1286, col: 12, offset: 35914},
+							pos:   position{line: 1360, col: 12, offset: 38315},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1286, col: 14, offset: 35916},
+								pos:  position{line: 1360, col: 14, offset: 38317},
 								name: "Hex",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1286, col: 18, offset: 35920},
+							pos:        position{line: 1360, col: 18, offset: 38321},
 							val:        ":",
 							ignoreCase: false,
 						},
@@ -10141,31 +10883,31 @@ var g = &grammar{
 		},
 		{
 			name: "IP4Net",
-			pos:  position{line: 1288, col: 1, offset: 35958},
+			pos:  position{line: 1362, col: 1, offset: 38359},
 			expr: &actionExpr{
-				pos: position{line: 1289, col: 5, offset: 35969},
+				pos: position{line: 1363, col: 5, offset: 38370},
 				run: (*parser).callonIP4Net1,
 				expr: &seqExpr{
-					pos: position{line: 1289, col: 5, offset: 35969},
+					pos: position{line: 1363, col: 5, offset: 38370},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1289, col: 5, offset: 35969},
+							pos:   position{line: 1363, col: 5, offset: 38370},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1289, col: 7, offset: 35971},
+								pos:  position{line: 1363, col: 7, offset: 38372},
 								name: "IP",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1289, col: 10, offset: 35974},
+							pos:        position{line: 1363, col: 10, offset: 38375},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1289, col: 14, offset: 35978},
+							pos:   position{line: 1363, col: 14, offset: 38379},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1289, col: 16, offset: 35980},
+								pos:  position{line: 1363, col: 16, offset: 38381},
 								name: "UInt",
 							},
 						},
@@ -10175,31 +10917,31 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Net",
-			pos:  position{line: 1293, col: 1, offset: 36053},
+			pos:  position{line: 1367, col: 1, offset: 38454},
 			expr: &actionExpr{
-				pos: position{line: 1294, col: 5, offset: 36064},
+				pos: position{line: 1368, col: 5, offset: 38465},
 				run: (*parser).callonIP6Net1,
 				expr: &seqExpr{
-					pos: position{line: 1294, col: 5, offset: 36064},
+					pos: position{line: 1368, col: 5, offset: 38465},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1294, col: 5, offset: 36064},
+							pos:   position{line: 1368, col: 5, offset: 38465},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1294, col: 7, offset: 36066},
+								pos:  position{line: 1368, col: 7, offset: 38467},
 								name: "IP6",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1294, col: 11, offset: 36070},
+							pos:        position{line: 1368, col: 11, offset: 38471},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1294, col: 15, offset: 36074},
+							pos:   position{line: 1368, col: 15, offset: 38475},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1294, col: 17, offset: 36076},
+								pos:  position{line: 1368, col: 17, offset: 38477},
 								name: "UInt",
 							},
 						},
@@ -10209,15 +10951,15 @@ var g = &grammar{
 		},
 		{
 			name: "UInt",
-			pos:  position{line: 1298, col: 1, offset: 36139},
+			pos:  position{line: 1372, col: 1, offset: 38540},
 			expr: &actionExpr{
-				pos: position{line: 1299, col: 4, offset: 36147},
+				pos: position{line: 1373, col: 4, offset: 38548},
 				run: (*parser).callonUInt1,
 				expr: &labeledExpr{
-					pos:   position{line: 1299, col: 4, offset: 36147},
+					pos:   position{line: 1373, col: 4, offset: 38548},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1299, col: 6, offset: 36149},
+						pos:  position{line: 1373, col: 6, offset: 38550},
 						name: "UIntString",
 					},
 				},
@@ -10225,16 +10967,16 @@ var g = &grammar{
 		},
 		{
 			name: "IntString",
-			pos:  position{line: 1301, col: 1, offset: 36189},
+			pos:  position{line: 1375, col: 1, offset: 38590},
 			expr: &choiceExpr{
-				pos: position{line: 1302, col: 5, offset: 36203},
+				pos: position{line: 1376, col: 5, offset: 38604},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1302, col: 5, offset: 36203},
+						pos:  position{line: 1376, col: 5, offset: 38604},
 						name: "UIntString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1303, col: 5, offset: 36218},
+						pos:  position{line: 1377, col: 5, offset: 38619},
 						name: "MinusIntString",
 					},
 				},
@@ -10242,14 +10984,14 @@ var g = &grammar{
 		},
 		{
 			name: "UIntString",
-			pos:  position{line: 1305, col: 1, offset: 36234},
+			pos:  position{line: 1379, col: 1, offset: 38635},
 			expr: &actionExpr{
-				pos: position{line: 1305, col: 14, offset: 36247},
+				pos: position{line: 1379, col: 14, offset: 38648},
 				run: (*parser).callonUIntString1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1305, col: 14, offset: 36247},
+					pos: position{line: 1379, col: 14, offset: 38648},
 					expr: &charClassMatcher{
-						pos:        position{line: 1305, col: 14, offset: 36247},
+						pos:        position{line: 1379, col: 14, offset: 38648},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10260,20 +11002,20 @@ var g = &grammar{
 		},
 		{
 			name: "MinusIntString",
-			pos:  position{line: 1307, col: 1, offset: 36286},
+			pos:  position{line: 1381, col: 1, offset: 38687},
 			expr: &actionExpr{
-				pos: position{line: 1308, col: 5, offset: 36305},
+				pos: position{line: 1382, col: 5, offset: 38706},
 				run: (*parser).callonMinusIntString1,
 				expr: &seqExpr{
-					pos: position{line: 1308, col: 5, offset: 36305},
+					pos: position{line: 1382, col: 5, offset: 38706},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1308, col: 5, offset: 36305},
+							pos:        position{line: 1382, col: 5, offset: 38706},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1308, col: 9, offset: 36309},
+							pos:  position{line: 1382, col: 9, offset: 38710},
 							name: "UIntString",
 						},
 					},
@@ -10282,28 +11024,28 @@ var g = &grammar{
 		},
 		{
 			name: "FloatString",
-			pos:  position{line: 1310, col: 1, offset: 36352},
+			pos:  position{line: 1384, col: 1, offset: 38753},
 			expr: &choiceExpr{
-				pos: position{line: 1311, col: 5, offset: 36368},
+				pos: position{line: 1385, col: 5, offset: 38769},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1311, col: 5, offset: 36368},
+						pos: position{line: 1385, col: 5, offset: 38769},
 						run: (*parser).callonFloatString2,
 						expr: &seqExpr{
-							pos: position{line: 1311, col: 5, offset: 36368},
+							pos: position{line: 1385, col: 5, offset: 38769},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 1311, col: 5, offset: 36368},
+									pos: position{line: 1385, col: 5, offset: 38769},
 									expr: &litMatcher{
-										pos:        position{line: 1311, col: 5, offset: 36368},
+										pos:        position{line: 1385, col: 5, offset: 38769},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1311, col: 10, offset: 36373},
+									pos: position{line: 1385, col: 10, offset: 38774},
 									expr: &charClassMatcher{
-										pos:        position{line: 1311, col: 10, offset: 36373},
+										pos:        position{line: 1385, col: 10, offset: 38774},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -10311,14 +11053,14 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1311, col: 17, offset: 36380},
+									pos:        position{line: 1385, col: 17, offset: 38781},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1311, col: 21, offset: 36384},
+									pos: position{line: 1385, col: 21, offset: 38785},
 									expr: &charClassMatcher{
-										pos:        position{line: 1311, col: 21, offset: 36384},
+										pos:        position{line: 1385, col: 21, offset: 38785},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -10326,9 +11068,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1311, col: 28, offset: 36391},
+									pos: position{line: 1385, col: 28, offset: 38792},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1311, col: 28, offset: 36391},
+										pos:  position{line: 1385, col: 28, offset: 38792},
 										name: "ExponentPart",
 									},
 								},
@@ -10336,28 +11078,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1314, col: 5, offset: 36450},
+						pos: position{line: 1388, col: 5, offset: 38851},
 						run: (*parser).callonFloatString13,
 						expr: &seqExpr{
-							pos: position{line: 1314, col: 5, offset: 36450},
+							pos: position{line: 1388, col: 5, offset: 38851},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 1314, col: 5, offset: 36450},
+									pos: position{line: 1388, col: 5, offset: 38851},
 									expr: &litMatcher{
-										pos:        position{line: 1314, col: 5, offset: 36450},
+										pos:        position{line: 1388, col: 5, offset: 38851},
 										val:        "-",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1314, col: 10, offset: 36455},
+									pos:        position{line: 1388, col: 10, offset: 38856},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1314, col: 14, offset: 36459},
+									pos: position{line: 1388, col: 14, offset: 38860},
 									expr: &charClassMatcher{
-										pos:        position{line: 1314, col: 14, offset: 36459},
+										pos:        position{line: 1388, col: 14, offset: 38860},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -10365,9 +11107,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1314, col: 21, offset: 36466},
+									pos: position{line: 1388, col: 21, offset: 38867},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1314, col: 21, offset: 36466},
+										pos:  position{line: 1388, col: 21, offset: 38867},
 										name: "ExponentPart",
 									},
 								},
@@ -10375,17 +11117,17 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1317, col: 5, offset: 36525},
+						pos: position{line: 1391, col: 5, offset: 38926},
 						run: (*parser).callonFloatString22,
 						expr: &choiceExpr{
-							pos: position{line: 1317, col: 7, offset: 36527},
+							pos: position{line: 1391, col: 7, offset: 38928},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1317, col: 7, offset: 36527},
+									pos:  position{line: 1391, col: 7, offset: 38928},
 									name: "NaN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1317, col: 13, offset: 36533},
+									pos:  position{line: 1391, col: 13, offset: 38934},
 									name: "Infinity",
 								},
 							},
@@ -10396,19 +11138,19 @@ var g = &grammar{
 		},
 		{
 			name: "ExponentPart",
-			pos:  position{line: 1320, col: 1, offset: 36577},
+			pos:  position{line: 1394, col: 1, offset: 38978},
 			expr: &seqExpr{
-				pos: position{line: 1320, col: 16, offset: 36592},
+				pos: position{line: 1394, col: 16, offset: 38993},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1320, col: 16, offset: 36592},
+						pos:        position{line: 1394, col: 16, offset: 38993},
 						val:        "e",
 						ignoreCase: true,
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1320, col: 21, offset: 36597},
+						pos: position{line: 1394, col: 21, offset: 38998},
 						expr: &charClassMatcher{
-							pos:        position{line: 1320, col: 21, offset: 36597},
+							pos:        position{line: 1394, col: 21, offset: 38998},
 							val:        "[+-]",
 							chars:      []rune{'+', '-'},
 							ignoreCase: false,
@@ -10416,7 +11158,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1320, col: 27, offset: 36603},
+						pos:  position{line: 1394, col: 27, offset: 39004},
 						name: "UIntString",
 					},
 				},
@@ -10424,31 +11166,31 @@ var g = &grammar{
 		},
 		{
 			name: "NaN",
-			pos:  position{line: 1322, col: 1, offset: 36615},
+			pos:  position{line: 1396, col: 1, offset: 39016},
 			expr: &litMatcher{
-				pos:        position{line: 1322, col: 7, offset: 36621},
+				pos:        position{line: 1396, col: 7, offset: 39022},
 				val:        "NaN",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "Infinity",
-			pos:  position{line: 1324, col: 1, offset: 36628},
+			pos:  position{line: 1398, col: 1, offset: 39029},
 			expr: &seqExpr{
-				pos: position{line: 1324, col: 12, offset: 36639},
+				pos: position{line: 1398, col: 12, offset: 39040},
 				exprs: []interface{}{
 					&zeroOrOneExpr{
-						pos: position{line: 1324, col: 12, offset: 36639},
+						pos: position{line: 1398, col: 12, offset: 39040},
 						expr: &choiceExpr{
-							pos: position{line: 1324, col: 13, offset: 36640},
+							pos: position{line: 1398, col: 13, offset: 39041},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1324, col: 13, offset: 36640},
+									pos:        position{line: 1398, col: 13, offset: 39041},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1324, col: 19, offset: 36646},
+									pos:        position{line: 1398, col: 19, offset: 39047},
 									val:        "+",
 									ignoreCase: false,
 								},
@@ -10456,7 +11198,7 @@ var g = &grammar{
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1324, col: 25, offset: 36652},
+						pos:        position{line: 1398, col: 25, offset: 39053},
 						val:        "Inf",
 						ignoreCase: false,
 					},
@@ -10465,14 +11207,14 @@ var g = &grammar{
 		},
 		{
 			name: "Hex",
-			pos:  position{line: 1326, col: 1, offset: 36659},
+			pos:  position{line: 1400, col: 1, offset: 39060},
 			expr: &actionExpr{
-				pos: position{line: 1326, col: 7, offset: 36665},
+				pos: position{line: 1400, col: 7, offset: 39066},
 				run: (*parser).callonHex1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1326, col: 7, offset: 36665},
+					pos: position{line: 1400, col: 7, offset: 39066},
 					expr: &ruleRefExpr{
-						pos:  position{line: 1326, col: 7, offset: 36665},
+						pos:  position{line: 1400, col: 7, offset: 39066},
 						name: "HexDigit",
 					},
 				},
@@ -10480,9 +11222,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 1328, col: 1, offset: 36707},
+			pos:  position{line: 1402, col: 1, offset: 39108},
 			expr: &charClassMatcher{
-				pos:        position{line: 1328, col: 12, offset: 36718},
+				pos:        position{line: 1402, col: 12, offset: 39119},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -10491,34 +11233,34 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedString",
-			pos:  position{line: 1330, col: 1, offset: 36731},
+			pos:  position{line: 1404, col: 1, offset: 39132},
 			expr: &choiceExpr{
-				pos: position{line: 1331, col: 5, offset: 36748},
+				pos: position{line: 1405, col: 5, offset: 39149},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1331, col: 5, offset: 36748},
+						pos: position{line: 1405, col: 5, offset: 39149},
 						run: (*parser).callonQuotedString2,
 						expr: &seqExpr{
-							pos: position{line: 1331, col: 5, offset: 36748},
+							pos: position{line: 1405, col: 5, offset: 39149},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1331, col: 5, offset: 36748},
+									pos:        position{line: 1405, col: 5, offset: 39149},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1331, col: 9, offset: 36752},
+									pos:   position{line: 1405, col: 9, offset: 39153},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1331, col: 11, offset: 36754},
+										pos: position{line: 1405, col: 11, offset: 39155},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1331, col: 11, offset: 36754},
+											pos:  position{line: 1405, col: 11, offset: 39155},
 											name: "DoubleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1331, col: 29, offset: 36772},
+									pos:        position{line: 1405, col: 29, offset: 39173},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -10526,29 +11268,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1332, col: 5, offset: 36809},
+						pos: position{line: 1406, col: 5, offset: 39210},
 						run: (*parser).callonQuotedString9,
 						expr: &seqExpr{
-							pos: position{line: 1332, col: 5, offset: 36809},
+							pos: position{line: 1406, col: 5, offset: 39210},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1332, col: 5, offset: 36809},
+									pos:        position{line: 1406, col: 5, offset: 39210},
 									val:        "'",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1332, col: 9, offset: 36813},
+									pos:   position{line: 1406, col: 9, offset: 39214},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1332, col: 11, offset: 36815},
+										pos: position{line: 1406, col: 11, offset: 39216},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1332, col: 11, offset: 36815},
+											pos:  position{line: 1406, col: 11, offset: 39216},
 											name: "SingleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1332, col: 29, offset: 36833},
+									pos:        position{line: 1406, col: 29, offset: 39234},
 									val:        "'",
 									ignoreCase: false,
 								},
@@ -10560,55 +11302,55 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedChar",
-			pos:  position{line: 1334, col: 1, offset: 36867},
+			pos:  position{line: 1408, col: 1, offset: 39268},
 			expr: &choiceExpr{
-				pos: position{line: 1335, col: 5, offset: 36888},
+				pos: position{line: 1409, col: 5, offset: 39289},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1335, col: 5, offset: 36888},
+						pos: position{line: 1409, col: 5, offset: 39289},
 						run: (*parser).callonDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1335, col: 5, offset: 36888},
+							pos: position{line: 1409, col: 5, offset: 39289},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1335, col: 5, offset: 36888},
+									pos: position{line: 1409, col: 5, offset: 39289},
 									expr: &choiceExpr{
-										pos: position{line: 1335, col: 7, offset: 36890},
+										pos: position{line: 1409, col: 7, offset: 39291},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1335, col: 7, offset: 36890},
+												pos:        position{line: 1409, col: 7, offset: 39291},
 												val:        "\"",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1335, col: 13, offset: 36896},
+												pos:  position{line: 1409, col: 13, offset: 39297},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1335, col: 26, offset: 36909,
+									line: 1409, col: 26, offset: 39310,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1336, col: 5, offset: 36946},
+						pos: position{line: 1410, col: 5, offset: 39347},
 						run: (*parser).callonDoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1336, col: 5, offset: 36946},
+							pos: position{line: 1410, col: 5, offset: 39347},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1336, col: 5, offset: 36946},
+									pos:        position{line: 1410, col: 5, offset: 39347},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1336, col: 10, offset: 36951},
+									pos:   position{line: 1410, col: 10, offset: 39352},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1336, col: 12, offset: 36953},
+										pos:  position{line: 1410, col: 12, offset: 39354},
 										name: "EscapeSequence",
 									},
 								},
@@ -10620,28 +11362,28 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWord",
-			pos:  position{line: 1338, col: 1, offset: 36987},
+			pos:  position{line: 1412, col: 1, offset: 39388},
 			expr: &actionExpr{
-				pos: position{line: 1339, col: 5, offset: 36999},
+				pos: position{line: 1413, col: 5, offset: 39400},
 				run: (*parser).callonKeyWord1,
 				expr: &seqExpr{
-					pos: position{line: 1339, col: 5, offset: 36999},
+					pos: position{line: 1413, col: 5, offset: 39400},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1339, col: 5, offset: 36999},
+							pos:   position{line: 1413, col: 5, offset: 39400},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1339, col: 10, offset: 37004},
+								pos:  position{line: 1413, col: 10, offset: 39405},
 								name: "KeyWordStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1339, col: 23, offset: 37017},
+							pos:   position{line: 1413, col: 23, offset: 39418},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1339, col: 28, offset: 37022},
+								pos: position{line: 1413, col: 28, offset: 39423},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1339, col: 28, offset: 37022},
+									pos:  position{line: 1413, col: 28, offset: 39423},
 									name: "KeyWordRest",
 								},
 							},
@@ -10652,16 +11394,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordStart",
-			pos:  position{line: 1341, col: 1, offset: 37084},
+			pos:  position{line: 1415, col: 1, offset: 39485},
 			expr: &choiceExpr{
-				pos: position{line: 1342, col: 5, offset: 37101},
+				pos: position{line: 1416, col: 5, offset: 39502},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1342, col: 5, offset: 37101},
+						pos:  position{line: 1416, col: 5, offset: 39502},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1343, col: 5, offset: 37118},
+						pos:  position{line: 1417, col: 5, offset: 39519},
 						name: "KeyWordEsc",
 					},
 				},
@@ -10669,12 +11411,12 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordChars",
-			pos:  position{line: 1345, col: 1, offset: 37130},
+			pos:  position{line: 1419, col: 1, offset: 39531},
 			expr: &actionExpr{
-				pos: position{line: 1345, col: 16, offset: 37145},
+				pos: position{line: 1419, col: 16, offset: 39546},
 				run: (*parser).callonKeyWordChars1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1345, col: 16, offset: 37145},
+					pos:        position{line: 1419, col: 16, offset: 39546},
 					val:        "[a-zA-Z_.:/%#@~]",
 					chars:      []rune{'_', '.', ':', '/', '%', '#', '@', '~'},
 					ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -10685,16 +11427,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordRest",
-			pos:  position{line: 1347, col: 1, offset: 37194},
+			pos:  position{line: 1421, col: 1, offset: 39595},
 			expr: &choiceExpr{
-				pos: position{line: 1348, col: 5, offset: 37210},
+				pos: position{line: 1422, col: 5, offset: 39611},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1348, col: 5, offset: 37210},
+						pos:  position{line: 1422, col: 5, offset: 39611},
 						name: "KeyWordStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1349, col: 5, offset: 37227},
+						pos:        position{line: 1423, col: 5, offset: 39628},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10705,30 +11447,30 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordEsc",
-			pos:  position{line: 1351, col: 1, offset: 37234},
+			pos:  position{line: 1425, col: 1, offset: 39635},
 			expr: &actionExpr{
-				pos: position{line: 1351, col: 14, offset: 37247},
+				pos: position{line: 1425, col: 14, offset: 39648},
 				run: (*parser).callonKeyWordEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1351, col: 14, offset: 37247},
+					pos: position{line: 1425, col: 14, offset: 39648},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1351, col: 14, offset: 37247},
+							pos:        position{line: 1425, col: 14, offset: 39648},
 							val:        "\\",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1351, col: 19, offset: 37252},
+							pos:   position{line: 1425, col: 19, offset: 39653},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1351, col: 22, offset: 37255},
+								pos: position{line: 1425, col: 22, offset: 39656},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1351, col: 22, offset: 37255},
+										pos:  position{line: 1425, col: 22, offset: 39656},
 										name: "KeywordEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1351, col: 38, offset: 37271},
+										pos:  position{line: 1425, col: 38, offset: 39672},
 										name: "EscapeSequence",
 									},
 								},
@@ -10740,42 +11482,42 @@ var g = &grammar{
 		},
 		{
 			name: "GlobPattern",
-			pos:  position{line: 1353, col: 1, offset: 37307},
+			pos:  position{line: 1427, col: 1, offset: 39708},
 			expr: &actionExpr{
-				pos: position{line: 1354, col: 5, offset: 37323},
+				pos: position{line: 1428, col: 5, offset: 39724},
 				run: (*parser).callonGlobPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1354, col: 5, offset: 37323},
+					pos: position{line: 1428, col: 5, offset: 39724},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 1354, col: 5, offset: 37323},
+							pos: position{line: 1428, col: 5, offset: 39724},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1354, col: 6, offset: 37324},
+								pos:  position{line: 1428, col: 6, offset: 39725},
 								name: "GlobProperStart",
 							},
 						},
 						&andExpr{
-							pos: position{line: 1354, col: 22, offset: 37340},
+							pos: position{line: 1428, col: 22, offset: 39741},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1354, col: 23, offset: 37341},
+								pos:  position{line: 1428, col: 23, offset: 39742},
 								name: "GlobHasStar",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1354, col: 35, offset: 37353},
+							pos:   position{line: 1428, col: 35, offset: 39754},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1354, col: 40, offset: 37358},
+								pos:  position{line: 1428, col: 40, offset: 39759},
 								name: "GlobStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1354, col: 50, offset: 37368},
+							pos:   position{line: 1428, col: 50, offset: 39769},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1354, col: 55, offset: 37373},
+								pos: position{line: 1428, col: 55, offset: 39774},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1354, col: 55, offset: 37373},
+									pos:  position{line: 1428, col: 55, offset: 39774},
 									name: "GlobRest",
 								},
 							},
@@ -10786,27 +11528,27 @@ var g = &grammar{
 		},
 		{
 			name: "GlobProperStart",
-			pos:  position{line: 1358, col: 1, offset: 37442},
+			pos:  position{line: 1432, col: 1, offset: 39843},
 			expr: &choiceExpr{
-				pos: position{line: 1358, col: 19, offset: 37460},
+				pos: position{line: 1432, col: 19, offset: 39861},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1358, col: 19, offset: 37460},
+						pos:  position{line: 1432, col: 19, offset: 39861},
 						name: "KeyWordStart",
 					},
 					&seqExpr{
-						pos: position{line: 1358, col: 34, offset: 37475},
+						pos: position{line: 1432, col: 34, offset: 39876},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1358, col: 34, offset: 37475},
+								pos: position{line: 1432, col: 34, offset: 39876},
 								expr: &litMatcher{
-									pos:        position{line: 1358, col: 34, offset: 37475},
+									pos:        position{line: 1432, col: 34, offset: 39876},
 									val:        "*",
 									ignoreCase: false,
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1358, col: 39, offset: 37480},
+								pos:  position{line: 1432, col: 39, offset: 39881},
 								name: "KeyWordRest",
 							},
 						},
@@ -10816,19 +11558,19 @@ var g = &grammar{
 		},
 		{
 			name: "GlobHasStar",
-			pos:  position{line: 1359, col: 1, offset: 37492},
+			pos:  position{line: 1433, col: 1, offset: 39893},
 			expr: &seqExpr{
-				pos: position{line: 1359, col: 15, offset: 37506},
+				pos: position{line: 1433, col: 15, offset: 39907},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 1359, col: 15, offset: 37506},
+						pos: position{line: 1433, col: 15, offset: 39907},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1359, col: 15, offset: 37506},
+							pos:  position{line: 1433, col: 15, offset: 39907},
 							name: "KeyWordRest",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1359, col: 28, offset: 37519},
+						pos:        position{line: 1433, col: 28, offset: 39920},
 						val:        "*",
 						ignoreCase: false,
 					},
@@ -10837,23 +11579,23 @@ var g = &grammar{
 		},
 		{
 			name: "GlobStart",
-			pos:  position{line: 1361, col: 1, offset: 37524},
+			pos:  position{line: 1435, col: 1, offset: 39925},
 			expr: &choiceExpr{
-				pos: position{line: 1362, col: 5, offset: 37538},
+				pos: position{line: 1436, col: 5, offset: 39939},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1362, col: 5, offset: 37538},
+						pos:  position{line: 1436, col: 5, offset: 39939},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1363, col: 5, offset: 37555},
+						pos:  position{line: 1437, col: 5, offset: 39956},
 						name: "GlobEsc",
 					},
 					&actionExpr{
-						pos: position{line: 1364, col: 5, offset: 37567},
+						pos: position{line: 1438, col: 5, offset: 39968},
 						run: (*parser).callonGlobStart4,
 						expr: &litMatcher{
-							pos:        position{line: 1364, col: 5, offset: 37567},
+							pos:        position{line: 1438, col: 5, offset: 39968},
 							val:        "*",
 							ignoreCase: false,
 						},
@@ -10863,16 +11605,16 @@ var g = &grammar{
 		},
 		{
 			name: "GlobRest",
-			pos:  position{line: 1366, col: 1, offset: 37591},
+			pos:  position{line: 1440, col: 1, offset: 39992},
 			expr: &choiceExpr{
-				pos: position{line: 1367, col: 5, offset: 37604},
+				pos: position{line: 1441, col: 5, offset: 40005},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1367, col: 5, offset: 37604},
+						pos:  position{line: 1441, col: 5, offset: 40005},
 						name: "GlobStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1368, col: 5, offset: 37618},
+						pos:        position{line: 1442, col: 5, offset: 40019},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10883,30 +11625,30 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEsc",
-			pos:  position{line: 1370, col: 1, offset: 37625},
+			pos:  position{line: 1444, col: 1, offset: 40026},
 			expr: &actionExpr{
-				pos: position{line: 1370, col: 11, offset: 37635},
+				pos: position{line: 1444, col: 11, offset: 40036},
 				run: (*parser).callonGlobEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1370, col: 11, offset: 37635},
+					pos: position{line: 1444, col: 11, offset: 40036},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1370, col: 11, offset: 37635},
+							pos:        position{line: 1444, col: 11, offset: 40036},
 							val:        "\\",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1370, col: 16, offset: 37640},
+							pos:   position{line: 1444, col: 16, offset: 40041},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1370, col: 19, offset: 37643},
+								pos: position{line: 1444, col: 19, offset: 40044},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1370, col: 19, offset: 37643},
+										pos:  position{line: 1444, col: 19, offset: 40044},
 										name: "GlobEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1370, col: 32, offset: 37656},
+										pos:  position{line: 1444, col: 32, offset: 40057},
 										name: "EscapeSequence",
 									},
 								},
@@ -10918,30 +11660,30 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEscape",
-			pos:  position{line: 1372, col: 1, offset: 37692},
+			pos:  position{line: 1446, col: 1, offset: 40093},
 			expr: &choiceExpr{
-				pos: position{line: 1373, col: 5, offset: 37707},
+				pos: position{line: 1447, col: 5, offset: 40108},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1373, col: 5, offset: 37707},
+						pos: position{line: 1447, col: 5, offset: 40108},
 						run: (*parser).callonGlobEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1373, col: 5, offset: 37707},
+							pos:        position{line: 1447, col: 5, offset: 40108},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1374, col: 5, offset: 37735},
+						pos: position{line: 1448, col: 5, offset: 40136},
 						run: (*parser).callonGlobEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1374, col: 5, offset: 37735},
+							pos:        position{line: 1448, col: 5, offset: 40136},
 							val:        "*",
 							ignoreCase: false,
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1375, col: 5, offset: 37765},
+						pos:        position{line: 1449, col: 5, offset: 40166},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -10952,55 +11694,55 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedChar",
-			pos:  position{line: 1378, col: 1, offset: 37772},
+			pos:  position{line: 1452, col: 1, offset: 40173},
 			expr: &choiceExpr{
-				pos: position{line: 1379, col: 5, offset: 37793},
+				pos: position{line: 1453, col: 5, offset: 40194},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1379, col: 5, offset: 37793},
+						pos: position{line: 1453, col: 5, offset: 40194},
 						run: (*parser).callonSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1379, col: 5, offset: 37793},
+							pos: position{line: 1453, col: 5, offset: 40194},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1379, col: 5, offset: 37793},
+									pos: position{line: 1453, col: 5, offset: 40194},
 									expr: &choiceExpr{
-										pos: position{line: 1379, col: 7, offset: 37795},
+										pos: position{line: 1453, col: 7, offset: 40196},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1379, col: 7, offset: 37795},
+												pos:        position{line: 1453, col: 7, offset: 40196},
 												val:        "'",
 												ignoreCase: false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1379, col: 13, offset: 37801},
+												pos:  position{line: 1453, col: 13, offset: 40202},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1379, col: 26, offset: 37814,
+									line: 1453, col: 26, offset: 40215,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1380, col: 5, offset: 37851},
+						pos: position{line: 1454, col: 5, offset: 40252},
 						run: (*parser).callonSingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1380, col: 5, offset: 37851},
+							pos: position{line: 1454, col: 5, offset: 40252},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1380, col: 5, offset: 37851},
+									pos:        position{line: 1454, col: 5, offset: 40252},
 									val:        "\\",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1380, col: 10, offset: 37856},
+									pos:   position{line: 1454, col: 10, offset: 40257},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1380, col: 12, offset: 37858},
+										pos:  position{line: 1454, col: 12, offset: 40259},
 										name: "EscapeSequence",
 									},
 								},
@@ -11012,16 +11754,16 @@ var g = &grammar{
 		},
 		{
 			name: "EscapeSequence",
-			pos:  position{line: 1382, col: 1, offset: 37892},
+			pos:  position{line: 1456, col: 1, offset: 40293},
 			expr: &choiceExpr{
-				pos: position{line: 1383, col: 5, offset: 37911},
+				pos: position{line: 1457, col: 5, offset: 40312},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1383, col: 5, offset: 37911},
+						pos:  position{line: 1457, col: 5, offset: 40312},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1384, col: 5, offset: 37932},
+						pos:  position{line: 1458, col: 5, offset: 40333},
 						name: "UnicodeEscape",
 					},
 				},
@@ -11029,79 +11771,79 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 1386, col: 1, offset: 37947},
+			pos:  position{line: 1460, col: 1, offset: 40348},
 			expr: &choiceExpr{
-				pos: position{line: 1387, col: 5, offset: 37968},
+				pos: position{line: 1461, col: 5, offset: 40369},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1387, col: 5, offset: 37968},
+						pos:        position{line: 1461, col: 5, offset: 40369},
 						val:        "'",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 1388, col: 5, offset: 37976},
+						pos: position{line: 1462, col: 5, offset: 40377},
 						run: (*parser).callonSingleCharEscape3,
 						expr: &litMatcher{
-							pos:        position{line: 1388, col: 5, offset: 37976},
+							pos:        position{line: 1462, col: 5, offset: 40377},
 							val:        "\"",
 							ignoreCase: false,
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1389, col: 5, offset: 38016},
+						pos:        position{line: 1463, col: 5, offset: 40417},
 						val:        "\\",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 1390, col: 5, offset: 38025},
+						pos: position{line: 1464, col: 5, offset: 40426},
 						run: (*parser).callonSingleCharEscape6,
 						expr: &litMatcher{
-							pos:        position{line: 1390, col: 5, offset: 38025},
+							pos:        position{line: 1464, col: 5, offset: 40426},
 							val:        "b",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1391, col: 5, offset: 38054},
+						pos: position{line: 1465, col: 5, offset: 40455},
 						run: (*parser).callonSingleCharEscape8,
 						expr: &litMatcher{
-							pos:        position{line: 1391, col: 5, offset: 38054},
+							pos:        position{line: 1465, col: 5, offset: 40455},
 							val:        "f",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1392, col: 5, offset: 38083},
+						pos: position{line: 1466, col: 5, offset: 40484},
 						run: (*parser).callonSingleCharEscape10,
 						expr: &litMatcher{
-							pos:        position{line: 1392, col: 5, offset: 38083},
+							pos:        position{line: 1466, col: 5, offset: 40484},
 							val:        "n",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1393, col: 5, offset: 38112},
+						pos: position{line: 1467, col: 5, offset: 40513},
 						run: (*parser).callonSingleCharEscape12,
 						expr: &litMatcher{
-							pos:        position{line: 1393, col: 5, offset: 38112},
+							pos:        position{line: 1467, col: 5, offset: 40513},
 							val:        "r",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1394, col: 5, offset: 38141},
+						pos: position{line: 1468, col: 5, offset: 40542},
 						run: (*parser).callonSingleCharEscape14,
 						expr: &litMatcher{
-							pos:        position{line: 1394, col: 5, offset: 38141},
+							pos:        position{line: 1468, col: 5, offset: 40542},
 							val:        "t",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1395, col: 5, offset: 38170},
+						pos: position{line: 1469, col: 5, offset: 40571},
 						run: (*parser).callonSingleCharEscape16,
 						expr: &litMatcher{
-							pos:        position{line: 1395, col: 5, offset: 38170},
+							pos:        position{line: 1469, col: 5, offset: 40571},
 							val:        "v",
 							ignoreCase: false,
 						},
@@ -11111,30 +11853,30 @@ var g = &grammar{
 		},
 		{
 			name: "KeywordEscape",
-			pos:  position{line: 1397, col: 1, offset: 38196},
+			pos:  position{line: 1471, col: 1, offset: 40597},
 			expr: &choiceExpr{
-				pos: position{line: 1398, col: 5, offset: 38214},
+				pos: position{line: 1472, col: 5, offset: 40615},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1398, col: 5, offset: 38214},
+						pos: position{line: 1472, col: 5, offset: 40615},
 						run: (*parser).callonKeywordEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1398, col: 5, offset: 38214},
+							pos:        position{line: 1472, col: 5, offset: 40615},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1399, col: 5, offset: 38242},
+						pos: position{line: 1473, col: 5, offset: 40643},
 						run: (*parser).callonKeywordEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1399, col: 5, offset: 38242},
+							pos:        position{line: 1473, col: 5, offset: 40643},
 							val:        "*",
 							ignoreCase: false,
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1400, col: 5, offset: 38270},
+						pos:        position{line: 1474, col: 5, offset: 40671},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -11145,41 +11887,41 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeEscape",
-			pos:  position{line: 1402, col: 1, offset: 38276},
+			pos:  position{line: 1476, col: 1, offset: 40677},
 			expr: &choiceExpr{
-				pos: position{line: 1403, col: 5, offset: 38294},
+				pos: position{line: 1477, col: 5, offset: 40695},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1403, col: 5, offset: 38294},
+						pos: position{line: 1477, col: 5, offset: 40695},
 						run: (*parser).callonUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 1403, col: 5, offset: 38294},
+							pos: position{line: 1477, col: 5, offset: 40695},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1403, col: 5, offset: 38294},
+									pos:        position{line: 1477, col: 5, offset: 40695},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1403, col: 9, offset: 38298},
+									pos:   position{line: 1477, col: 9, offset: 40699},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1403, col: 16, offset: 38305},
+										pos: position{line: 1477, col: 16, offset: 40706},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1403, col: 16, offset: 38305},
+												pos:  position{line: 1477, col: 16, offset: 40706},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1403, col: 25, offset: 38314},
+												pos:  position{line: 1477, col: 25, offset: 40715},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1403, col: 34, offset: 38323},
+												pos:  position{line: 1477, col: 34, offset: 40724},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1403, col: 43, offset: 38332},
+												pos:  position{line: 1477, col: 43, offset: 40733},
 												name: "HexDigit",
 											},
 										},
@@ -11189,63 +11931,63 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1406, col: 5, offset: 38395},
+						pos: position{line: 1480, col: 5, offset: 40796},
 						run: (*parser).callonUnicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 1406, col: 5, offset: 38395},
+							pos: position{line: 1480, col: 5, offset: 40796},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1406, col: 5, offset: 38395},
+									pos:        position{line: 1480, col: 5, offset: 40796},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 1406, col: 9, offset: 38399},
+									pos:        position{line: 1480, col: 9, offset: 40800},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1406, col: 13, offset: 38403},
+									pos:   position{line: 1480, col: 13, offset: 40804},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1406, col: 20, offset: 38410},
+										pos: position{line: 1480, col: 20, offset: 40811},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1406, col: 20, offset: 38410},
+												pos:  position{line: 1480, col: 20, offset: 40811},
 												name: "HexDigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1406, col: 29, offset: 38419},
+												pos: position{line: 1480, col: 29, offset: 40820},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1406, col: 29, offset: 38419},
+													pos:  position{line: 1480, col: 29, offset: 40820},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1406, col: 39, offset: 38429},
+												pos: position{line: 1480, col: 39, offset: 40830},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1406, col: 39, offset: 38429},
+													pos:  position{line: 1480, col: 39, offset: 40830},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1406, col: 49, offset: 38439},
+												pos: position{line: 1480, col: 49, offset: 40840},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1406, col: 49, offset: 38439},
+													pos:  position{line: 1480, col: 49, offset: 40840},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1406, col: 59, offset: 38449},
+												pos: position{line: 1480, col: 59, offset: 40850},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1406, col: 59, offset: 38449},
+													pos:  position{line: 1480, col: 59, offset: 40850},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1406, col: 69, offset: 38459},
+												pos: position{line: 1480, col: 69, offset: 40860},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1406, col: 69, offset: 38459},
+													pos:  position{line: 1480, col: 69, offset: 40860},
 													name: "HexDigit",
 												},
 											},
@@ -11253,7 +11995,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1406, col: 80, offset: 38470},
+									pos:        position{line: 1480, col: 80, offset: 40871},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -11265,35 +12007,35 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpPattern",
-			pos:  position{line: 1410, col: 1, offset: 38524},
+			pos:  position{line: 1484, col: 1, offset: 40925},
 			expr: &actionExpr{
-				pos: position{line: 1411, col: 5, offset: 38542},
+				pos: position{line: 1485, col: 5, offset: 40943},
 				run: (*parser).callonRegexpPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1411, col: 5, offset: 38542},
+					pos: position{line: 1485, col: 5, offset: 40943},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1411, col: 5, offset: 38542},
+							pos:        position{line: 1485, col: 5, offset: 40943},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1411, col: 9, offset: 38546},
+							pos:   position{line: 1485, col: 9, offset: 40947},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1411, col: 14, offset: 38551},
+								pos:  position{line: 1485, col: 14, offset: 40952},
 								name: "RegexpBody",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1411, col: 25, offset: 38562},
+							pos:        position{line: 1485, col: 25, offset: 40963},
 							val:        "/",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 1411, col: 29, offset: 38566},
+							pos: position{line: 1485, col: 29, offset: 40967},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1411, col: 30, offset: 38567},
+								pos:  position{line: 1485, col: 30, offset: 40968},
 								name: "KeyWordStart",
 							},
 						},
@@ -11303,32 +12045,32 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpBody",
-			pos:  position{line: 1413, col: 1, offset: 38602},
+			pos:  position{line: 1487, col: 1, offset: 41003},
 			expr: &actionExpr{
-				pos: position{line: 1414, col: 5, offset: 38617},
+				pos: position{line: 1488, col: 5, offset: 41018},
 				run: (*parser).callonRegexpBody1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1414, col: 5, offset: 38617},
+					pos: position{line: 1488, col: 5, offset: 41018},
 					expr: &choiceExpr{
-						pos: position{line: 1414, col: 6, offset: 38618},
+						pos: position{line: 1488, col: 6, offset: 41019},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 1414, col: 6, offset: 38618},
+								pos:        position{line: 1488, col: 6, offset: 41019},
 								val:        "[^/\\\\]",
 								chars:      []rune{'/', '\\'},
 								ignoreCase: false,
 								inverted:   true,
 							},
 							&seqExpr{
-								pos: position{line: 1414, col: 15, offset: 38627},
+								pos: position{line: 1488, col: 15, offset: 41028},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 1414, col: 15, offset: 38627},
+										pos:        position{line: 1488, col: 15, offset: 41028},
 										val:        "\\",
 										ignoreCase: false,
 									},
 									&anyMatcher{
-										line: 1414, col: 20, offset: 38632,
+										line: 1488, col: 20, offset: 41033,
 									},
 								},
 							},
@@ -11339,9 +12081,9 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedChar",
-			pos:  position{line: 1416, col: 1, offset: 38668},
+			pos:  position{line: 1490, col: 1, offset: 41069},
 			expr: &charClassMatcher{
-				pos:        position{line: 1417, col: 5, offset: 38684},
+				pos:        position{line: 1491, col: 5, offset: 41085},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -11351,42 +12093,42 @@ var g = &grammar{
 		},
 		{
 			name: "_",
-			pos:  position{line: 1419, col: 1, offset: 38699},
+			pos:  position{line: 1493, col: 1, offset: 41100},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1419, col: 6, offset: 38704},
+				pos: position{line: 1493, col: 6, offset: 41105},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1419, col: 6, offset: 38704},
+					pos:  position{line: 1493, col: 6, offset: 41105},
 					name: "AnySpace",
 				},
 			},
 		},
 		{
 			name: "__",
-			pos:  position{line: 1421, col: 1, offset: 38715},
+			pos:  position{line: 1495, col: 1, offset: 41116},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1421, col: 6, offset: 38720},
+				pos: position{line: 1495, col: 6, offset: 41121},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1421, col: 6, offset: 38720},
+					pos:  position{line: 1495, col: 6, offset: 41121},
 					name: "AnySpace",
 				},
 			},
 		},
 		{
 			name: "AnySpace",
-			pos:  position{line: 1423, col: 1, offset: 38731},
+			pos:  position{line: 1497, col: 1, offset: 41132},
 			expr: &choiceExpr{
-				pos: position{line: 1424, col: 5, offset: 38744},
+				pos: position{line: 1498, col: 5, offset: 41145},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1424, col: 5, offset: 38744},
+						pos:  position{line: 1498, col: 5, offset: 41145},
 						name: "WhiteSpace",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1425, col: 5, offset: 38759},
+						pos:  position{line: 1499, col: 5, offset: 41160},
 						name: "LineTerminator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1426, col: 5, offset: 38778},
+						pos:  position{line: 1500, col: 5, offset: 41179},
 						name: "Comment",
 					},
 				},
@@ -11394,45 +12136,45 @@ var g = &grammar{
 		},
 		{
 			name: "SourceCharacter",
-			pos:  position{line: 1428, col: 1, offset: 38787},
+			pos:  position{line: 1502, col: 1, offset: 41188},
 			expr: &anyMatcher{
-				line: 1429, col: 5, offset: 38807,
+				line: 1503, col: 5, offset: 41208,
 			},
 		},
 		{
 			name:        "WhiteSpace",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 1431, col: 1, offset: 38810},
+			pos:         position{line: 1505, col: 1, offset: 41211},
 			expr: &choiceExpr{
-				pos: position{line: 1432, col: 5, offset: 38838},
+				pos: position{line: 1506, col: 5, offset: 41239},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1432, col: 5, offset: 38838},
+						pos:        position{line: 1506, col: 5, offset: 41239},
 						val:        "\t",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1433, col: 5, offset: 38847},
+						pos:        position{line: 1507, col: 5, offset: 41248},
 						val:        "\v",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1434, col: 5, offset: 38856},
+						pos:        position{line: 1508, col: 5, offset: 41257},
 						val:        "\f",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1435, col: 5, offset: 38865},
+						pos:        position{line: 1509, col: 5, offset: 41266},
 						val:        " ",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1436, col: 5, offset: 38873},
+						pos:        position{line: 1510, col: 5, offset: 41274},
 						val:        "\u00a0",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1437, col: 5, offset: 38886},
+						pos:        position{line: 1511, col: 5, offset: 41287},
 						val:        "\ufeff",
 						ignoreCase: false,
 					},
@@ -11441,9 +12183,9 @@ var g = &grammar{
 		},
 		{
 			name: "LineTerminator",
-			pos:  position{line: 1439, col: 1, offset: 38896},
+			pos:  position{line: 1513, col: 1, offset: 41297},
 			expr: &charClassMatcher{
-				pos:        position{line: 1440, col: 5, offset: 38915},
+				pos:        position{line: 1514, col: 5, offset: 41316},
 				val:        "[\\n\\r\\u2028\\u2029]",
 				chars:      []rune{'\n', '\r', '\u2028', '\u2029'},
 				ignoreCase: false,
@@ -11453,45 +12195,45 @@ var g = &grammar{
 		{
 			name:        "Comment",
 			displayName: "\"comment\"",
-			pos:         position{line: 1446, col: 1, offset: 39245},
+			pos:         position{line: 1520, col: 1, offset: 41646},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1449, col: 5, offset: 39316},
+				pos:  position{line: 1523, col: 5, offset: 41717},
 				name: "SingleLineComment",
 			},
 		},
 		{
 			name: "MultiLineComment",
-			pos:  position{line: 1451, col: 1, offset: 39335},
+			pos:  position{line: 1525, col: 1, offset: 41736},
 			expr: &seqExpr{
-				pos: position{line: 1452, col: 5, offset: 39356},
+				pos: position{line: 1526, col: 5, offset: 41757},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1452, col: 5, offset: 39356},
+						pos:        position{line: 1526, col: 5, offset: 41757},
 						val:        "/*",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1452, col: 10, offset: 39361},
+						pos: position{line: 1526, col: 10, offset: 41762},
 						expr: &seqExpr{
-							pos: position{line: 1452, col: 11, offset: 39362},
+							pos: position{line: 1526, col: 11, offset: 41763},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1452, col: 11, offset: 39362},
+									pos: position{line: 1526, col: 11, offset: 41763},
 									expr: &litMatcher{
-										pos:        position{line: 1452, col: 12, offset: 39363},
+										pos:        position{line: 1526, col: 12, offset: 41764},
 										val:        "*/",
 										ignoreCase: false,
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1452, col: 17, offset: 39368},
+									pos:  position{line: 1526, col: 17, offset: 41769},
 									name: "SourceCharacter",
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1452, col: 35, offset: 39386},
+						pos:        position{line: 1526, col: 35, offset: 41787},
 						val:        "*/",
 						ignoreCase: false,
 					},
@@ -11500,29 +12242,29 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1454, col: 1, offset: 39392},
+			pos:  position{line: 1528, col: 1, offset: 41793},
 			expr: &seqExpr{
-				pos: position{line: 1455, col: 5, offset: 39414},
+				pos: position{line: 1529, col: 5, offset: 41815},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1455, col: 5, offset: 39414},
+						pos:        position{line: 1529, col: 5, offset: 41815},
 						val:        "//",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1455, col: 10, offset: 39419},
+						pos: position{line: 1529, col: 10, offset: 41820},
 						expr: &seqExpr{
-							pos: position{line: 1455, col: 11, offset: 39420},
+							pos: position{line: 1529, col: 11, offset: 41821},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1455, col: 11, offset: 39420},
+									pos: position{line: 1529, col: 11, offset: 41821},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1455, col: 12, offset: 39421},
+										pos:  position{line: 1529, col: 12, offset: 41822},
 										name: "LineTerminator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1455, col: 27, offset: 39436},
+									pos:  position{line: 1529, col: 27, offset: 41837},
 									name: "SourceCharacter",
 								},
 							},
@@ -11533,19 +12275,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1457, col: 1, offset: 39455},
+			pos:  position{line: 1531, col: 1, offset: 41856},
 			expr: &seqExpr{
-				pos: position{line: 1457, col: 7, offset: 39461},
+				pos: position{line: 1531, col: 7, offset: 41862},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 1457, col: 7, offset: 39461},
+						pos: position{line: 1531, col: 7, offset: 41862},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1457, col: 7, offset: 39461},
+							pos:  position{line: 1531, col: 7, offset: 41862},
 							name: "WhiteSpace",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1457, col: 19, offset: 39473},
+						pos:  position{line: 1531, col: 19, offset: 41874},
 						name: "LineTerminator",
 					},
 				},
@@ -11553,16 +12295,16 @@ var g = &grammar{
 		},
 		{
 			name: "EOT",
-			pos:  position{line: 1459, col: 1, offset: 39489},
+			pos:  position{line: 1533, col: 1, offset: 41890},
 			expr: &choiceExpr{
-				pos: position{line: 1459, col: 7, offset: 39495},
+				pos: position{line: 1533, col: 7, offset: 41896},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1459, col: 7, offset: 39495},
+						pos:  position{line: 1533, col: 7, offset: 41896},
 						name: "_",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1459, col: 11, offset: 39499},
+						pos:  position{line: 1533, col: 11, offset: 41900},
 						name: "EOF",
 					},
 				},
@@ -11570,21 +12312,21 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1461, col: 1, offset: 39504},
+			pos:  position{line: 1535, col: 1, offset: 41905},
 			expr: &notExpr{
-				pos: position{line: 1461, col: 7, offset: 39510},
+				pos: position{line: 1535, col: 7, offset: 41911},
 				expr: &anyMatcher{
-					line: 1461, col: 8, offset: 39511,
+					line: 1535, col: 8, offset: 41912,
 				},
 			},
 		},
 		{
 			name: "EOKW",
-			pos:  position{line: 1463, col: 1, offset: 39514},
+			pos:  position{line: 1537, col: 1, offset: 41915},
 			expr: &notExpr{
-				pos: position{line: 1463, col: 8, offset: 39521},
+				pos: position{line: 1537, col: 8, offset: 41922},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1463, col: 9, offset: 39522},
+					pos:  position{line: 1537, col: 9, offset: 41923},
 					name: "KeyWordChars",
 				},
 			},
@@ -11602,6 +12344,17 @@ func (p *parser) callonstart1() (interface{}, error) {
 	return p.cur.onstart1(stack["ast"])
 }
 
+func (c *current) onLibrary1(decls interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Sequential", "ops": []interface{}{}, "decls": decls}, nil
+
+}
+
+func (p *parser) callonLibrary1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLibrary1(stack["decls"])
+}
+
 func (c *current) onSequential1(decls, first, rest interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "Sequential", "ops": append([]interface{}{first}, (rest.([]interface{}))...), "decls": decls}, nil
 
@@ -11690,6 +12443,39 @@ func (p *parser) callonFuncDecl1() (interface{}, error) {
 	return p.cur.onFuncDecl1(stack["id"], stack["params"], stack["expr"])
 }
 
+func (c *current) onOpDecl1(id, params, body interface{}) (interface{}, error) {
+	if params == nil {
+		params = []interface{}{}
+	}
+	return map[string]interface{}{
+
+		"kind": "OpDecl",
+
+		"name": id,
+
+		"params": params,
+
+		"body": body}, nil
+
+}
+
+func (p *parser) callonOpDecl1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpDecl1(stack["id"], stack["params"], stack["body"])
+}
+
+func (c *current) onImportDecl1(path interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "ImportDecl", "path": path}, nil
+
+}
+
+func (p *parser) callonImportDecl1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onImportDecl1(stack["path"])
+}
+
 func (c *current) onOperation2(ops interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "Parallel", "ops": ops}, nil
 
@@ -12113,11 +12899,14 @@ func (p *parser) callonAggAssignment11() (interface{}, error) {
 	return p.cur.onAggAssignment11(stack["agg"])
 }
 
-func (c *current) onAgg1(op, expr, where interface{}) (interface{}, error) {
+func (c *current) onAgg1(op, expr, params, where interface{}) (interface{}, error) {
 	var r = map[string]interface{}{"kind": "Agg", "name": op, "expr": nil, "where": where}
 	if expr != nil {
 		r["expr"] = expr
 	}
+	if len(params.([]interface{})) > 0 {
+		r["params"] = params
+	}
 	return r, nil
 
 }
@@ -12125,7 +12914,17 @@ func (c *current) onAgg1(op, expr, where interface{}) (interface{}, error) {
 func (p *parser) callonAgg1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAgg1(stack["op"], stack["expr"], stack["where"])
+	return p.cur.onAgg1(stack["op"], stack["expr"], stack["params"], stack["where"])
+}
+
+func (c *current) onAggParams2(e interface{}) (interface{}, error) {
+	return e, nil
+}
+
+func (p *parser) callonAggParams2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAggParams2(stack["e"])
 }
 
 func (c *current) onWhereClause1(expr interface{}) (interface{}, error) {
@@ -12505,7 +13304,7 @@ func (p *parser) callonJoinStyle2() (interface{}, error) {
 }
 
 func (c *current) onJoinStyle6() (interface{}, error) {
-	return "inner", nil
+	return "full", nil
 }
 
 func (p *parser) callonJoinStyle6() (interface{}, error) {
@@ -12515,7 +13314,7 @@ func (p *parser) callonJoinStyle6() (interface{}, error) {
 }
 
 func (c *current) onJoinStyle10() (interface{}, error) {
-	return "left", nil
+	return "inner", nil
 }
 
 func (p *parser) callonJoinStyle10() (interface{}, error) {
@@ -12525,7 +13324,7 @@ func (p *parser) callonJoinStyle10() (interface{}, error) {
 }
 
 func (c *current) onJoinStyle14() (interface{}, error) {
-	return "right", nil
+	return "left", nil
 }
 
 func (p *parser) callonJoinStyle14() (interface{}, error) {
@@ -12535,7 +13334,7 @@ func (p *parser) callonJoinStyle14() (interface{}, error) {
 }
 
 func (c *current) onJoinStyle18() (interface{}, error) {
-	return "inner", nil
+	return "right", nil
 }
 
 func (p *parser) callonJoinStyle18() (interface{}, error) {
@@ -12544,6 +13343,16 @@ func (p *parser) callonJoinStyle18() (interface{}, error) {
 	return p.cur.onJoinStyle18()
 }
 
+func (c *current) onJoinStyle22() (interface{}, error) {
+	return "inner", nil
+}
+
+func (p *parser) callonJoinStyle22() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinStyle22()
+}
+
 func (c *current) onJoinKey3(expr interface{}) (interface{}, error) {
 	return expr, nil
 }
@@ -12554,6 +13363,101 @@ func (p *parser) callonJoinKey3() (interface{}, error) {
 	return p.cur.onJoinKey3(stack["expr"])
 }
 
+func (c *current) onWindowOp9(e interface{}) (interface{}, error) {
+	return e, nil
+}
+
+func (p *parser) callonWindowOp9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOp9(stack["e"])
+}
+
+func (c *current) onWindowOp18(s interface{}) (interface{}, error) {
+	return s, nil
+}
+
+func (p *parser) callonWindowOp18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOp18(stack["s"])
+}
+
+func (c *current) onWindowOp25(f interface{}) (interface{}, error) {
+	return f, nil
+}
+
+func (p *parser) callonWindowOp25() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOp25(stack["f"])
+}
+
+func (c *current) onWindowOp1(aggs, keys, sort, frame interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Window", "aggs": aggs, "keys": keys, "sort": sort, "frame": frame}, nil
+
+}
+
+func (p *parser) callonWindowOp1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOp1(stack["aggs"], stack["keys"], stack["sort"], stack["frame"])
+}
+
+func (c *current) onWindowFrame1(lower, upper interface{}) (interface{}, error) {
+	return map[string]interface{}{"lower": lower, "upper": upper}, nil
+
+}
+
+func (p *parser) callonWindowFrame1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrame1(stack["lower"], stack["upper"])
+}
+
+func (c *current) onWindowBound2(typ interface{}) (interface{}, error) {
+	return map[string]interface{}{"type": typ, "count": 0, "unbounded": true}, nil
+
+}
+
+func (p *parser) callonWindowBound2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowBound2(stack["typ"])
+}
+
+func (c *current) onWindowBound8(count, typ interface{}) (interface{}, error) {
+	return map[string]interface{}{"type": typ, "count": count, "unbounded": false}, nil
+
+}
+
+func (p *parser) callonWindowBound8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowBound8(stack["count"], stack["typ"])
+}
+
+func (c *current) onWindowBound15() (interface{}, error) {
+	return map[string]interface{}{"type": "current", "count": 0, "unbounded": false}, nil
+
+}
+
+func (p *parser) callonWindowBound15() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowBound15()
+}
+
+func (c *current) onWindowDirection1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonWindowDirection1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowDirection1()
+}
+
 func (c *current) onSampleOp1(e interface{}) (interface{}, error) {
 	return map[string]interface{}{"kind": "Sequential", "decls": []interface{}{}, "ops": []interface{}{
 
@@ -13313,6 +14217,68 @@ func (p *parser) callonFunctionArgs2() (interface{}, error) {
 	return p.cur.onFunctionArgs2(stack["o"])
 }
 
+func (c *current) onFunctionArgs11(e interface{}) (interface{}, error) {
+	return e, nil
+}
+
+func (p *parser) callonFunctionArgs11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionArgs11(stack["e"])
+}
+
+func (c *current) onFunctionArgs5(first, rest interface{}) (interface{}, error) {
+	return append([]interface{}{first}, (rest.([]interface{}))...), nil
+
+}
+
+func (p *parser) callonFunctionArgs5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionArgs5(stack["first"], stack["rest"])
+}
+
+func (c *current) onFunctionArgs18() (interface{}, error) {
+	return []interface{}{}, nil
+}
+
+func (p *parser) callonFunctionArgs18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionArgs18()
+}
+
+func (c *current) onLambda1(params, expr interface{}) (interface{}, error) {
+	return map[string]interface{}{"kind": "Lambda", "params": params, "expr": expr}, nil
+
+}
+
+func (p *parser) callonLambda1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLambda1(stack["params"], stack["expr"])
+}
+
+func (c *current) onLambdaParams2(id interface{}) (interface{}, error) {
+	return []interface{}{id}, nil
+}
+
+func (p *parser) callonLambdaParams2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLambdaParams2(stack["id"])
+}
+
+func (c *current) onLambdaParams5(ids interface{}) (interface{}, error) {
+	return ids, nil
+}
+
+func (p *parser) callonLambdaParams5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLambdaParams5(stack["ids"])
+}
+
 func (c *current) onGrep1(pattern, opt interface{}) (interface{}, error) {
 	var m = map[string]interface{}{"kind": "Grep", "pattern": pattern, "expr": map[string]interface{}{"kind": "ID", "name": "this"}}
 	if opt != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/brimdata/zed/runtime/expr"
 	"github.com/brimdata/zed/runtime/expr/agg"
 	"github.com/brimdata/zed/zson"
+	"golang.org/x/exp/slices"
 )
 
 func semExpr(scope *Scope, e ast.Expr) (dag.Expr, error) {
@@ -148,11 +149,20 @@ func semExpr(scope *Scope, e ast.Expr) (dag.Expr, error) {
 		if err != nil {
 			return nil, err
 		}
+		params, err := semExprs(scope, e.Params)
+		if err != nil {
+			return nil, err
+		}
+		expr, params, err = semAggArgs(e.Name, expr, params)
+		if err != nil {
+			return nil, err
+		}
 		return &dag.Agg{
-			Kind:  "Agg",
-			Name:  e.Name,
-			Expr:  expr,
-			Where: where,
+			Kind:   "Agg",
+			Name:   e.Name,
+			Expr:   expr,
+			Where:  where,
+			Params: params,
 		}, nil
 	case *ast.RecordExpr:
 		var out []dag.RecordElem
@@ -248,6 +258,8 @@ func semExpr(scope *Scope, e ast.Expr) (dag.Expr, error) {
 			Exprs: exprs,
 			Scope: seq,
 		}, nil
+	case *ast.Lambda:
+		return nil, errors.New("lambda expression must be the last argument of filter(), map(), or reduce()")
 	}
 	return nil, fmt.Errorf("invalid expression type %T", e)
 }
@@ -446,6 +458,9 @@ func semExprNullable(scope *Scope, e ast.Expr) (dag.Expr, error) {
 }
 
 func semCall(scope *Scope, call *ast.Call) (dag.Expr, error) {
+	if e, err := semLambdaCall(scope, call); e != nil || err != nil {
+		return e, err
+	}
 	if e, err := maybeConvertAgg(scope, call); e != nil || err != nil {
 		return e, err
 	}
@@ -458,6 +473,9 @@ func semCall(scope *Scope, call *ast.Call) (dag.Expr, error) {
 	}
 	// Call could be to a user defined func. Check if we have a matching func in
 	// scope.
+	if scope.lookupOp(call.Name) != nil {
+		return nil, fmt.Errorf("%s(): user-defined operator cannot be called as a function", call.Name)
+	}
 	if e := scope.Lookup(call.Name); e != nil {
 		f, ok := e.(*dag.Func)
 		if !ok {
@@ -474,6 +492,71 @@ func semCall(scope *Scope, call *ast.Call) (dag.Expr, error) {
 	}, nil
 }
 
+// lambdaFuncs holds the number of arguments of each higher-order function
+// and the number of parameters of the lambda that is its last argument.
+var lambdaFuncs = map[string]struct{ nargs, nparams int }{
+	"filter": {2, 1},
+	"map":    {2, 1},
+	"reduce": {3, 2},
+}
+
+func semLambdaCall(scope *Scope, call *ast.Call) (dag.Expr, error) {
+	sig, ok := lambdaFuncs[call.Name]
+	if !ok || call.Name == "map" && len(call.Args) < 2 {
+		// A call to map() with one argument is the map aggregation.
+		return nil, nil
+	}
+	if scope.Lookup(call.Name) != nil || scope.lookupOp(call.Name) != nil {
+		// A user-defined function or operator hides the built-in
+		// function.
+		return nil, nil
+	}
+	if call.Where != nil {
+		return nil, fmt.Errorf("'where' clause on non-aggregation function: %s", call.Name)
+	}
+	if len(call.Args) != sig.nargs {
+		return nil, fmt.Errorf("%s(): expects %d argument(s)", call.Name, sig.nargs)
+	}
+	lambda, ok := call.Args[sig.nargs-1].(*ast.Lambda)
+	if !ok || len(lambda.Params) != sig.nparams {
+		return nil, fmt.Errorf("%s(): last argument must be a lambda expression with %d parameter(s)", call.Name, sig.nparams)
+	}
+	exprs, err := semExprs(scope, call.Args[:sig.nargs-1])
+	if err != nil {
+		return nil, fmt.Errorf("%s: bad argument: %w", call.Name, err)
+	}
+	fn, err := semLambda(scope, lambda)
+	if err != nil {
+		return nil, fmt.Errorf("%s: bad argument: %w", call.Name, err)
+	}
+	return &dag.Call{
+		Kind: "Call",
+		Name: call.Name,
+		Args: append(exprs, fn),
+	}, nil
+}
+
+func semLambda(scope *Scope, lambda *ast.Lambda) (*dag.Lambda, error) {
+	scope.Enter()
+	defer scope.Exit()
+	slot := scope.nvars()
+	for _, p := range lambda.Params {
+		if err := scope.DefineVar(p); err != nil {
+			return nil, err
+		}
+	}
+	e, err := semExpr(scope, lambda.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return &dag.Lambda{
+		Kind:   "Lambda",
+		Params: slices.Clone(lambda.Params),
+		Slot:   slot,
+		Expr:   e,
+	}, nil
+}
+
 func semExprs(scope *Scope, in []ast.Expr) ([]dag.Expr, error) {
 	exprs := make([]dag.Expr, 0, len(in))
 	for _, e := range in {
@@ -592,34 +675,68 @@ func maybeConvertAgg(scope *Scope, call *ast.Call) (dag.Expr, error) {
 		return nil, nil
 	}
 	var e dag.Expr
-	if len(call.Args) > 1 {
-		if call.Name == "min" || call.Name == "max" {
-			// min and max are special cases as they are also functions. If the
-			// number of args is greater than 1 they're probably a function so do not
-			// return an error.
-			return nil, nil
-		}
-		return nil, fmt.Errorf("%s: wrong number of arguments", call.Name)
+	var params []dag.Expr
+	if len(call.Args) > 1 && (call.Name == "min" || call.Name == "max") {
+		// min and max are special cases as they are also functions. If the
+		// number of args is greater than 1 they're probably a function so do not
+		// return an error.
+		return nil, nil
 	}
-	if len(call.Args) == 1 {
+	if len(call.Args) >= 1 {
 		var err error
 		e, err = semExpr(scope, call.Args[0])
 		if err != nil {
 			return nil, err
 		}
+		// Any remaining arguments are parameters of the aggregator,
+		// which are checked when it is compiled.
+		params, err = semExprs(scope, call.Args[1:])
+		if err != nil {
+			return nil, err
+		}
+	}
+	e, params, err := semAggArgs(call.Name, e, params)
+	if err != nil {
+		return nil, err
 	}
 	where, err := semExprNullable(scope, call.Where)
 	if err != nil {
 		return nil, err
 	}
 	return &dag.Agg{
-		Kind:  "Agg",
-		Name:  call.Name,
-		Expr:  e,
-		Where: where,
+		Kind:   "Agg",
+		Name:   call.Name,
+		Expr:   e,
+		Where:  where,
+		Params: params,
 	}, nil
 }
 
+// semAggArgs combines the two arguments of the covar and corr aggregators
+// into a record with fields x and y since an aggregator consumes a single
+// value for each input value.  It also checks that quantile has its
+// quantile parameter.
+func semAggArgs(name string, e dag.Expr, params []dag.Expr) (dag.Expr, []dag.Expr, error) {
+	switch name {
+	case "quantile":
+		if e == nil || len(params) == 0 {
+			return nil, nil, fmt.Errorf("%s: two arguments required", name)
+		}
+	case "covar", "corr":
+		if e == nil || len(params) != 1 {
+			return nil, nil, fmt.Errorf("%s: two arguments required", name)
+		}
+		return &dag.RecordExpr{
+			Kind: "RecordExpr",
+			Elems: []dag.RecordElem{
+				&dag.Field{Kind: "Field", Name: "x", Value: e},
+				&dag.Field{Kind: "Field", Name: "y", Value: params[0]},
+			},
+		}, nil, nil
+	}
+	return e, params, nil
+}
+
 func DotExprToFieldPath(e ast.Expr) *dag.This {
 	switch e := e.(type) {
 	case *ast.BinaryExpr:
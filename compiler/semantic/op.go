@@ -15,6 +15,7 @@ import (
 	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/pkg/reglob"
 	"github.com/brimdata/zed/runtime/expr/function"
+	"github.com/brimdata/zed/zson"
 	"github.com/segmentio/ksuid"
 	"golang.org/x/exp/slices"
 )
@@ -331,6 +332,8 @@ func semOp(ctx context.Context, scope *Scope, o ast.Op, ds *data.Source, head *l
 			Keys:  keys,
 			Aggs:  aggs,
 		}, nil
+	case *ast.Window:
+		return semWindow(ctx, scope, o, ds, head)
 	case *ast.Parallel:
 		var ops []dag.Op
 		for _, o := range o.Ops {
@@ -443,6 +446,11 @@ func semOp(ctx context.Context, scope *Scope, o ast.Op, ds *data.Source, head *l
 	case *ast.Pass:
 		return &dag.Pass{Kind: "Pass"}, nil
 	case *ast.OpExpr:
+		if call, ok := o.Expr.(*ast.Call); ok {
+			if op, err := semUserOp(ctx, scope, call, ds, head); op != nil || err != nil {
+				return op, err
+			}
+		}
 		return semOpExpr(scope, o.Expr)
 	case *ast.Search:
 		e, err := semExpr(scope, o.Expr)
@@ -675,6 +683,14 @@ func semDecls(scope *Scope, decls []ast.Decl) ([]dag.Def, []*dag.Func, error) {
 			consts = append(consts, c)
 		case *ast.FuncDecl:
 			fds = append(fds, d)
+		case *ast.OpDecl:
+			if err := scope.DefineOp(d); err != nil {
+				return nil, nil, err
+			}
+		case *ast.ImportDecl:
+			// compiler.ParseWithImports resolves imports at the top level
+			// of a local query and its libraries.
+			return nil, nil, fmt.Errorf("import %q: imports are allowed only at the top level of a local query", d.Path)
 		default:
 			return nil, nil, fmt.Errorf("invalid declaration type %T", d)
 		}
@@ -865,3 +881,118 @@ func semCallOp(scope *Scope, call *ast.Call) (dag.Op, error) {
 	}
 	return dag.NewFilter(c), nil
 }
+
+// semUserOp expands an invocation of a user-defined operator into the body
+// of the operator with its parameters bound to the values of the arguments,
+// which must be constants.  If call does not invoke a user-defined operator,
+// semUserOp returns nil.
+func semUserOp(ctx context.Context, scope *Scope, call *ast.Call, ds *data.Source, head *lakeparse.Commitish) (dag.Op, error) {
+	decl := scope.lookupOp(call.Name)
+	if decl == nil {
+		return nil, nil
+	}
+	name := call.Name
+	if call.Where != nil {
+		return nil, fmt.Errorf("'where' clause on user-defined operator: %s", name)
+	}
+	params := decl.ast.Params
+	if len(params) != len(call.Args) {
+		return nil, fmt.Errorf("%s(): expects %d argument(s)", name, len(params))
+	}
+	if decl.expanding {
+		return nil, fmt.Errorf("%s(): operator invoked recursively", name)
+	}
+	args := make([]dag.Def, 0, len(params))
+	for i, arg := range call.Args {
+		e, err := semExpr(scope, arg)
+		if err != nil {
+			return nil, fmt.Errorf("%s(): bad argument: %w", name, err)
+		}
+		val, err := kernel.EvalAtCompileTime(scope.zctx, e)
+		if err != nil {
+			return nil, fmt.Errorf("%s(): bad argument: %w", name, err)
+		}
+		if val.IsError() {
+			return nil, fmt.Errorf("%s(): argument for parameter %q must be a constant", name, params[i])
+		}
+		args = append(args, dag.Def{
+			Name: params[i],
+			Expr: &dag.Literal{Kind: "Literal", Value: zson.MustFormatValue(val)},
+		})
+	}
+	opScope, err := scope.opScope(decl, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): %w", name, err)
+	}
+	decl.expanding = true
+	defer func() { decl.expanding = false }()
+	return semSequential(ctx, opScope, decl.ast.Body, ds, head)
+}
+
+func semWindow(ctx context.Context, scope *Scope, w *ast.Window, ds *data.Source, head *lakeparse.Commitish) (dag.Op, error) {
+	aggs, err := semAssignments(scope, w.Aggs, true)
+	if err != nil {
+		return nil, fmt.Errorf("window: %w", err)
+	}
+	keys, err := semExprs(scope, w.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("window: %w", err)
+	}
+	window := &dag.Window{
+		Kind: "Window",
+		Aggs: aggs,
+		Keys: keys,
+	}
+	if w.Frame == nil {
+		// The default frame runs from the start of the partition
+		// through the current value.
+		upper := 0
+		window.Upper = &upper
+	} else {
+		window.Lower, err = semWindowBound(w.Frame.Lower, "preceding")
+		if err != nil {
+			return nil, err
+		}
+		window.Upper, err = semWindowBound(w.Frame.Upper, "following")
+		if err != nil {
+			return nil, err
+		}
+		if window.Lower != nil && window.Upper != nil && *window.Lower > *window.Upper {
+			return nil, errors.New("window: frame lower bound follows its upper bound")
+		}
+	}
+	if w.Sort == nil {
+		return window, nil
+	}
+	sort, err := semOp(ctx, scope, w.Sort, ds, head)
+	if err != nil {
+		return nil, err
+	}
+	return &dag.Sequential{
+		Kind: "Sequential",
+		Ops:  []dag.Op{sort, window},
+	}, nil
+}
+
+// semWindowBound returns the offset of the window frame bound b from the
+// current value or nil if b is unbounded.  An unbounded bound must extend
+// in the direction given by unbounded.
+func semWindowBound(b ast.WindowBound, unbounded string) (*int, error) {
+	var offset int
+	switch b.Type {
+	case "current":
+	case "preceding":
+		offset = -b.Count
+	case "following":
+		offset = b.Count
+	default:
+		return nil, fmt.Errorf("window: unknown frame bound %q", b.Type)
+	}
+	if b.Unbounded {
+		if b.Type != unbounded {
+			return nil, fmt.Errorf("window: frame bound cannot be unbounded %s", b.Type)
+		}
+		return nil, nil
+	}
+	return &offset, nil
+}
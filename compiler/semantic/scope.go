@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/compiler/ast"
 	"github.com/brimdata/zed/compiler/ast/dag"
 	"github.com/brimdata/zed/compiler/kernel"
 	"github.com/brimdata/zed/zson"
+	"golang.org/x/exp/slices"
 )
 
 type Scope struct {
@@ -65,6 +67,46 @@ func (s *Scope) DefineFunc(f *dag.Func) error {
 	return nil
 }
 
+// opDecl is the symbol table entry of a user-defined operator.  The scope
+// of the declaration is retained so that the body of the operator refers
+// to the symbols in scope where it is declared rather than where it is
+// invoked.
+type opDecl struct {
+	ast   *ast.OpDecl
+	stack []*Binder
+	// expanding is true while the body of the operator is analyzed so
+	// recursive invocations can be detected.
+	expanding bool
+}
+
+func (s *Scope) DefineOp(d *ast.OpDecl) error {
+	b := s.tos()
+	if _, ok := b.symbols[d.Name]; ok {
+		return fmt.Errorf("symbol %q redefined", d.Name)
+	}
+	b.symbols[d.Name] = &entry{op: &opDecl{ast: d, stack: slices.Clone(s.stack)}}
+	return nil
+}
+
+// opScope returns the scope in which the body of the operator declared by
+// decl is analyzed when it is invoked in scope s.  It comprises the scope of
+// the declaration and a frame binding the operator's parameters to args.
+// The frame accounts for the variables of s that are not in the scope of
+// the declaration so that slots of variables declared in the body do not
+// conflict with those of s.
+func (s *Scope) opScope(decl *opDecl, args []dag.Def) (*Scope, error) {
+	stack := slices.Clone(decl.stack)
+	params := NewBinder()
+	params.nvar = s.nvars() - (&Scope{stack: stack}).nvars()
+	for _, arg := range args {
+		if _, ok := params.symbols[arg.Name]; ok {
+			return nil, fmt.Errorf("symbol %q redefined", arg.Name)
+		}
+		params.Define(arg.Name, arg.Expr)
+	}
+	return &Scope{zctx: s.zctx, stack: append(stack, params)}, nil
+}
+
 func (s *Scope) DefineConst(name string, def dag.Expr) error {
 	b := s.tos()
 	if _, ok := b.symbols[name]; ok {
@@ -89,11 +131,29 @@ func (s *Scope) DefineConst(name string, def dag.Expr) error {
 	return nil
 }
 
+// Lookup returns the expression bound to name or nil if name is not bound
+// or is bound to a user-defined operator, which is not a value.
 func (s *Scope) Lookup(name string) dag.Expr {
+	if e := s.lookup(name); e != nil {
+		return e.ref
+	}
+	return nil
+}
+
+// lookupOp returns the user-defined operator bound to name or nil if name is
+// not bound to one.
+func (s *Scope) lookupOp(name string) *opDecl {
+	if e := s.lookup(name); e != nil {
+		return e.op
+	}
+	return nil
+}
+
+func (s *Scope) lookup(name string) *entry {
 	for k := len(s.stack) - 1; k >= 0; k-- {
 		if e, ok := s.stack[k].symbols[name]; ok {
 			e.refcnt++
-			return e.ref
+			return e
 		}
 	}
 	return nil
@@ -107,8 +167,10 @@ func (s *Scope) nvars() int {
 	return n
 }
 
+// entry binds a symbol to either an expression or a user-defined operator.
 type entry struct {
 	ref    dag.Expr
+	op     *opDecl
 	refcnt int
 }
 
@@ -13,7 +13,7 @@ import (
 
 func convertSQLOp(scope *Scope, sql *ast.SQLExpr) (dag.Op, error) {
 	selection, err := newSQLSelection(scope, sql.Select)
-	if err != err {
+	if err != nil {
 		return nil, err
 	}
 	var where dag.Expr
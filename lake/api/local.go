@@ -126,7 +126,7 @@ func (l *local) Query(ctx context.Context, head *lakeparse.Commitish, src string
 }
 
 func (l *local) QueryWithControl(ctx context.Context, head *lakeparse.Commitish, src string, srcfiles ...string) (zbuf.ProgressReadCloser, error) {
-	flowgraph, err := l.compiler.Parse(src, srcfiles...)
+	flowgraph, err := compiler.ParseWithImports(src, srcfiles...)
 	if err != nil {
 		return nil, err
 	}
@@ -1,26 +1,49 @@
 package anymath
 
-import "math"
+import (
+	"math"
+	"math/big"
+
+	"github.com/brimdata/zed/pkg/bigfloat"
+	"github.com/brimdata/zed/pkg/decimal"
+)
 
 type Float64 func(float64, float64) float64
 type Int64 func(int64, int64) int64
 type Uint64 func(uint64, uint64) uint64
 
+// Decimal computes a result rounded to a decimal format.
+type Decimal func(*decimal.Format, *decimal.Decimal, *decimal.Decimal) *decimal.Decimal
+
+// BigInt computes a result for a 128- or 256-bit integer type, which the
+// caller wraps to the width of the type.  A nil argument is the absence of a
+// value.
+type BigInt func(*big.Int, *big.Int) *big.Int
+
+// BigFloat computes a result rounded to a float128 or float256 format.
+type BigFloat func(*bigfloat.Format, *big.Float, *big.Float) *big.Float
+
 type Function struct {
 	Init
 	Float64
 	Int64
 	Uint64
+	Decimal
+	BigInt
+	BigFloat
 }
 
 type Init struct {
-	Float64 float64
-	Int64   int64
-	Uint64  uint64
+	Float64  float64
+	Int64    int64
+	Uint64   uint64
+	Decimal  *decimal.Decimal
+	BigInt   *big.Int
+	BigFloat *big.Float
 }
 
 var Min = &Function{
-	Init: Init{math.MaxFloat64, math.MaxInt64, math.MaxUint64},
+	Init: Init{math.MaxFloat64, math.MaxInt64, math.MaxUint64, decimal.NewInf(false), nil, new(big.Float).SetInf(false)},
 	Float64: func(a, b float64) float64 {
 		if a < b {
 			return a
@@ -39,10 +62,28 @@ var Min = &Function{
 		}
 		return b
 	},
+	Decimal: func(_ *decimal.Format, a, b *decimal.Decimal) *decimal.Decimal {
+		if decimal.Cmp(a, b) < 0 {
+			return a
+		}
+		return b
+	},
+	BigInt: func(a, b *big.Int) *big.Int {
+		if a != nil && a.Cmp(b) < 0 {
+			return a
+		}
+		return b
+	},
+	BigFloat: func(_ *bigfloat.Format, a, b *big.Float) *big.Float {
+		if bigfloat.Cmp(a, b) < 0 {
+			return a
+		}
+		return b
+	},
 }
 
 var Max = &Function{
-	Init: Init{-math.MaxFloat64, math.MinInt64, 0},
+	Init: Init{-math.MaxFloat64, math.MinInt64, 0, decimal.NewInf(true), nil, new(big.Float).SetInf(true)},
 	Float64: func(a, b float64) float64 {
 		if a > b {
 			return a
@@ -61,10 +102,32 @@ var Max = &Function{
 		}
 		return b
 	},
+	Decimal: func(_ *decimal.Format, a, b *decimal.Decimal) *decimal.Decimal {
+		if decimal.Cmp(a, b) > 0 {
+			return a
+		}
+		return b
+	},
+	BigInt: func(a, b *big.Int) *big.Int {
+		if a != nil && a.Cmp(b) > 0 {
+			return a
+		}
+		return b
+	},
+	BigFloat: func(_ *bigfloat.Format, a, b *big.Float) *big.Float {
+		if bigfloat.Cmp(a, b) > 0 {
+			return a
+		}
+		return b
+	},
 }
 
 var Add = &Function{
-	Float64: func(a, b float64) float64 { return a + b },
-	Int64:   func(a, b int64) int64 { return a + b },
-	Uint64:  func(a, b uint64) uint64 { return a + b },
+	Init:     Init{Decimal: decimal.New(0, 0), BigInt: new(big.Int), BigFloat: new(big.Float)},
+	Float64:  func(a, b float64) float64 { return a + b },
+	Int64:    func(a, b int64) int64 { return a + b },
+	Uint64:   func(a, b uint64) uint64 { return a + b },
+	Decimal:  (*decimal.Format).Add,
+	BigInt:   func(a, b *big.Int) *big.Int { return new(big.Int).Add(a, b) },
+	BigFloat: (*bigfloat.Format).Add,
 }
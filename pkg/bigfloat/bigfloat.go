@@ -0,0 +1,322 @@
+// Package bigfloat implements the IEEE 754 binary128 and binary256
+// floating-point formats used by the Zed float128 and float256 types.  Values
+// are represented by big.Floats, with a nil *big.Float standing for NaN since
+// big.Float has no NaN.  A Format rounds values to its precision and exponent
+// range, performs arithmetic on them, and encodes them in its interchange
+// encoding.
+package bigfloat
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// A Format is an IEEE 754 binary interchange format.
+type Format struct {
+	name      string
+	bits      int
+	expBits   int
+	precision uint
+	emax      int
+}
+
+var (
+	Binary128 = newFormat("float128", 128, 15)
+	Binary256 = newFormat("float256", 256, 19)
+)
+
+func newFormat(name string, k, expBits int) *Format {
+	return &Format{
+		name:      name,
+		bits:      k,
+		expBits:   expBits,
+		precision: uint(k - expBits),
+		emax:      1<<(expBits-1) - 1,
+	}
+}
+
+// FormatOfSize returns the format whose encoding is n bytes long or nil if
+// there is none.
+func FormatOfSize(n int) *Format {
+	switch n {
+	case 16:
+		return Binary128
+	case 32:
+		return Binary256
+	}
+	return nil
+}
+
+func (f *Format) String() string {
+	return f.name
+}
+
+// Precision returns the number of bits in the significand of a normal value.
+func (f *Format) Precision() uint {
+	return f.precision
+}
+
+// Size returns the length of an encoded value in bytes.
+func (f *Format) Size() int {
+	return f.bits / 8
+}
+
+// emin is the exponent of the smallest normal value.
+func (f *Format) emin() int {
+	return 1 - f.emax
+}
+
+func (f *Format) newFloat() *big.Float {
+	return new(big.Float).SetPrec(f.precision).SetMode(big.ToNearestEven)
+}
+
+// Round returns x rounded to f's precision using round-half-even.  A value
+// too large for f becomes an infinity and a value too small for f's
+// subnormal range becomes zero.
+func (f *Format) Round(x *big.Float) *big.Float {
+	if x == nil {
+		return nil
+	}
+	if x.IsInf() || x.Sign() == 0 {
+		return f.newFloat().Set(x)
+	}
+	out := f.newFloat()
+	// big.Float exponents are one more than IEEE exponents since the
+	// mantissa of a big.Float is in [0.5, 1).
+	if e := x.MantExp(nil) - 1; e < f.emin() {
+		// Round to a multiple of the smallest subnormal.
+		prec := int(f.precision) - (f.emin() - e)
+		if prec <= 0 {
+			// x is less than the smallest subnormal, so it
+			// rounds to that or zero.
+			tiny := f.smallest(x.Signbit())
+			half := new(big.Float).SetMantExp(tiny, -1)
+			if new(big.Float).Abs(x).Cmp(half.Abs(half)) > 0 {
+				return tiny
+			}
+			if x.Signbit() {
+				out.Neg(out)
+			}
+			return out
+		}
+		out.SetPrec(uint(prec)).Set(x)
+		out.SetPrec(f.precision)
+	} else {
+		out.Set(x)
+	}
+	if out.MantExp(nil)-1 > f.emax {
+		return f.newFloat().SetInf(out.Signbit())
+	}
+	return out
+}
+
+// smallest returns the smallest subnormal value of f with the given sign.
+func (f *Format) smallest(neg bool) *big.Float {
+	out := f.newFloat().SetMantExp(big.NewFloat(1), f.emin()-int(f.precision)+1)
+	if neg {
+		out.Neg(out)
+	}
+	return out
+}
+
+// FromFloat64 returns v as a value of f, which holds every float64 exactly.
+func (f *Format) FromFloat64(v float64) *big.Float {
+	if math.IsNaN(v) {
+		return nil
+	}
+	return f.newFloat().SetFloat64(v)
+}
+
+// FromInt returns x rounded to f.
+func (f *Format) FromInt(x *big.Int) *big.Float {
+	return f.Round(new(big.Float).SetInt(x))
+}
+
+// Float64 returns the float64 nearest to x.
+func Float64(x *big.Float) float64 {
+	if x == nil {
+		return math.NaN()
+	}
+	v, _ := x.Float64()
+	return v
+}
+
+// Parse returns the value of f nearest to s, which is a decimal
+// floating-point number or one of "NaN", "Inf", "+Inf", and "-Inf".
+func (f *Format) Parse(s string) (*big.Float, error) {
+	if s == "NaN" {
+		return nil, nil
+	}
+	// Parse with extra precision so rounding subnormals is rarely
+	// affected by double rounding.
+	x, _, err := big.ParseFloat(s, 10, f.precision+64, big.ToNearestEven)
+	if err != nil {
+		return nil, err
+	}
+	return f.Round(x), nil
+}
+
+// String formats x as the float64 formatting of ZSON does, using the fewest
+// digits that identify x among values of its precision.
+func String(x *big.Float) string {
+	switch {
+	case x == nil:
+		return "NaN"
+	case x.IsInf() && x.Signbit():
+		return "-Inf"
+	case x.IsInf():
+		return "+Inf"
+	}
+	if i, acc := x.Int64(); acc == big.Exact {
+		return fmt.Sprintf("%d.", i)
+	}
+	return x.Text('g', -1)
+}
+
+// Cmp returns -1, 0, or 1 as a is less than, equal to, or greater than b.
+// NaNs are equal to each other and less than any other value.
+func Cmp(a, b *big.Float) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	return a.Cmp(b)
+}
+
+// Neg returns -x.
+func (f *Format) Neg(x *big.Float) *big.Float {
+	if x == nil {
+		return nil
+	}
+	return f.newFloat().Neg(x)
+}
+
+// Add returns a+b rounded to f.
+func (f *Format) Add(a, b *big.Float) *big.Float {
+	if a == nil || b == nil || a.IsInf() && b.IsInf() && a.Signbit() != b.Signbit() {
+		return nil
+	}
+	return f.Round(f.newFloat().Add(a, b))
+}
+
+// Sub returns a-b rounded to f.
+func (f *Format) Sub(a, b *big.Float) *big.Float {
+	return f.Add(a, f.Neg(b))
+}
+
+// Mul returns a×b rounded to f.
+func (f *Format) Mul(a, b *big.Float) *big.Float {
+	if a == nil || b == nil || a.IsInf() && b.Sign() == 0 || a.Sign() == 0 && b.IsInf() {
+		return nil
+	}
+	return f.Round(f.newFloat().Mul(a, b))
+}
+
+// Quo returns a/b rounded to f.  Division of a nonzero value by zero gives
+// an infinity, and division of zero by zero gives NaN.
+func (f *Format) Quo(a, b *big.Float) *big.Float {
+	if a == nil || b == nil || a.IsInf() && b.IsInf() || a.Sign() == 0 && b.Sign() == 0 {
+		return nil
+	}
+	return f.Round(f.newFloat().Quo(a, b))
+}
+
+// Append appends the encoding of x rounded to f to b in little-endian byte
+// order.
+func (f *Format) Append(b []byte, x *big.Float) []byte {
+	k := f.bits
+	t := k - 1 - f.expBits
+	var bits big.Int
+	switch x = f.Round(x); {
+	case x == nil:
+		// Quiet NaN.
+		bits.SetBit(&bits, t-1, 1)
+		bits.Or(&bits, f.expMask())
+	case x.IsInf():
+		bits.Set(f.expMask())
+	case x.Sign() != 0:
+		e := x.MantExp(nil) - 1
+		biased := e + f.emax
+		if e < f.emin() {
+			biased = 0
+			e = f.emin()
+		}
+		// The significand is |x| scaled to an integer of t bits
+		// plus, for normal values, an implicit leading bit.
+		abs := new(big.Float).Abs(x)
+		sig, _ := abs.SetMantExp(abs, t-e).Int(nil)
+		if biased > 0 {
+			sig.SetBit(sig, t, 0)
+		}
+		bits.Lsh(big.NewInt(int64(biased)), uint(t))
+		bits.Or(&bits, sig)
+	}
+	if x != nil && x.Signbit() {
+		bits.SetBit(&bits, k-1, 1)
+	}
+	n := len(b)
+	b = append(b, make([]byte, f.Size())...)
+	bits.FillBytes(b[n:])
+	reverse(b[n:])
+	return b
+}
+
+// Decode returns the value encoded in b, which must be f.Size() bytes.
+func (f *Format) Decode(b []byte) (*big.Float, error) {
+	if len(b) != f.Size() {
+		return nil, fmt.Errorf("%s encoding must be %d bytes: got %d", f, f.Size(), len(b))
+	}
+	be := make([]byte, len(b))
+	copy(be, b)
+	reverse(be)
+	var bits big.Int
+	bits.SetBytes(be)
+	k := f.bits
+	t := k - 1 - f.expBits
+	neg := bits.Bit(k-1) == 1
+	sig := lowBits(&bits, t)
+	biased := int(new(big.Int).Rsh(&bits, uint(t)).Uint64() & (1<<f.expBits - 1))
+	out := f.newFloat()
+	switch {
+	case biased == 1<<f.expBits-1:
+		if sig.Sign() != 0 {
+			return nil, nil
+		}
+		out.SetInf(neg)
+		return out, nil
+	case biased == 0:
+		out.SetMantExp(out.SetInt(sig), f.emin()-t)
+	default:
+		sig.SetBit(sig, t, 1)
+		out.SetMantExp(out.SetInt(sig), biased-f.emax-t)
+	}
+	if neg {
+		out.Neg(out)
+	}
+	return out, nil
+}
+
+// expMask returns the encoding with every bit of the exponent field set.
+func (f *Format) expMask() *big.Int {
+	mask := new(big.Int).Lsh(big.NewInt(1), uint(f.expBits))
+	mask.Sub(mask, big.NewInt(1))
+	return mask.Lsh(mask, uint(f.bits-1-f.expBits))
+}
+
+// lowBits returns the n least significant bits of x.
+func lowBits(x *big.Int, n int) *big.Int {
+	mask := new(big.Int).Lsh(big.NewInt(1), uint(n))
+	mask.Sub(mask, big.NewInt(1))
+	return mask.And(mask, x)
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
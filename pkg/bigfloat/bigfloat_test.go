@@ -0,0 +1,132 @@
+package bigfloat_test
+
+import (
+	"encoding/hex"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/zed/pkg/bigfloat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parse(t *testing.T, f *bigfloat.Format, s string) *big.Float {
+	x, err := f.Parse(s)
+	require.NoError(t, err, "input: %q", s)
+	return x
+}
+
+func TestEncoding(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		format *bigfloat.Format
+		in     string
+		hex    string
+	}{
+		// Expected encodings are big-endian.
+		{bigfloat.Binary128, "1", "3fff0000000000000000000000000000"},
+		{bigfloat.Binary128, "-2", "c0000000000000000000000000000000"},
+		{bigfloat.Binary128, "0.5", "3ffe0000000000000000000000000000"},
+		{bigfloat.Binary128, "0", "00000000000000000000000000000000"},
+		{bigfloat.Binary128, "+Inf", "7fff0000000000000000000000000000"},
+		{bigfloat.Binary128, "-Inf", "ffff0000000000000000000000000000"},
+		{bigfloat.Binary128, "NaN", "7fff8000000000000000000000000000"},
+		// The smallest subnormal and the largest finite value.
+		{bigfloat.Binary128, "6.4751751194380251109244389582276465525e-4966", "00000000000000000000000000000001"},
+		{bigfloat.Binary128, "1.18973149535723176508575932662800702e+4932", "7ffeffffffffffffffffffffffffffff"},
+		{bigfloat.Binary256, "1", "3ffff" + strings.Repeat("0", 59)},
+		{bigfloat.Binary256, "-1.5", "bffff8" + strings.Repeat("0", 58)},
+	}
+	for _, c := range cases {
+		b := c.format.Append(nil, parse(t, c.format, c.in))
+		reversed := make([]byte, len(b))
+		for i := range b {
+			reversed[len(b)-1-i] = b[i]
+		}
+		assert.Equal(t, c.hex, hex.EncodeToString(reversed), "%s %s", c.format, c.in)
+		x, err := c.format.Decode(b)
+		require.NoError(t, err)
+		assert.Equal(t, 0, bigfloat.Cmp(parse(t, c.format, c.in), x), "%s %s", c.format, c.in)
+	}
+	_, err := bigfloat.Binary128.Decode([]byte{1, 2, 3})
+	assert.Error(t, err)
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, f := range []*bigfloat.Format{bigfloat.Binary128, bigfloat.Binary256} {
+		for _, s := range []string{"0.1", "-123.456", "1e-4950", "1e+4000", "3.14159265358979323846264338327950288"} {
+			x := parse(t, f, s)
+			y, err := f.Decode(f.Append(nil, x))
+			require.NoError(t, err)
+			assert.Equal(t, 0, x.Cmp(y), "%s %s", f, s)
+			z := parse(t, f, bigfloat.String(x))
+			assert.Equal(t, 0, x.Cmp(z), "%s %s", f, s)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Parallel()
+	f := bigfloat.Binary128
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"1", "1."},
+		{"-1000000", "-1000000."},
+		{"0.1", "0.1"},
+		{"1e300", "1e+300"},
+		{"0.00001", "1e-05"},
+		{"+Inf", "+Inf"},
+		{"-Inf", "-Inf"},
+		{"NaN", "NaN"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, bigfloat.String(parse(t, f, c.in)), "input: %q", c.in)
+	}
+}
+
+func TestRound(t *testing.T) {
+	t.Parallel()
+	f := bigfloat.Binary128
+	assert.True(t, parse(t, f, "1e5000").IsInf())
+	assert.True(t, parse(t, f, "-1e5000").Signbit())
+	assert.Equal(t, 0, parse(t, f, "1e-5000").Sign())
+	assert.True(t, parse(t, f, "-1e-5000").Signbit())
+	// Half the smallest subnormal rounds to even, which is zero.
+	tiny := parse(t, f, "6.4751751194380251109244389582276465525e-4966")
+	half := new(big.Float).SetMantExp(tiny, -1)
+	assert.Equal(t, 0, f.Round(half).Sign())
+	threeHalves := new(big.Float).Mul(half, big.NewFloat(3))
+	assert.Equal(t, 0, f.Round(threeHalves).Cmp(new(big.Float).Mul(tiny, big.NewFloat(2))))
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+	f := bigfloat.Binary128
+	one, three := parse(t, f, "1"), parse(t, f, "3")
+	assert.Equal(t, "0.3333333333333333333333333333333333", bigfloat.String(f.Quo(one, three)))
+	assert.Equal(t, "4.", bigfloat.String(f.Add(one, three)))
+	assert.Equal(t, "-2.", bigfloat.String(f.Sub(one, three)))
+	assert.Equal(t, "3.", bigfloat.String(f.Mul(one, three)))
+	inf := parse(t, f, "+Inf")
+	assert.Nil(t, f.Add(inf, f.Neg(inf)))
+	assert.Nil(t, f.Mul(inf, parse(t, f, "0")))
+	assert.Nil(t, f.Quo(parse(t, f, "0"), parse(t, f, "0")))
+	assert.True(t, f.Quo(one, parse(t, f, "0")).IsInf())
+	assert.Nil(t, f.Add(nil, one))
+	assert.True(t, math.IsNaN(bigfloat.Float64(nil)))
+	assert.Equal(t, 0.1, bigfloat.Float64(parse(t, f, "0.1")))
+}
+
+func TestCmp(t *testing.T) {
+	t.Parallel()
+	f := bigfloat.Binary128
+	assert.Equal(t, -1, bigfloat.Cmp(nil, parse(t, f, "-Inf")))
+	assert.Equal(t, 0, bigfloat.Cmp(nil, nil))
+	assert.Equal(t, 1, bigfloat.Cmp(parse(t, f, "2"), parse(t, f, "1.5")))
+	assert.Equal(t, 0, bigfloat.Cmp(parse(t, f, "0"), parse(t, f, "-0")))
+}
@@ -0,0 +1,496 @@
+// Package decimal implements the IEEE 754 decimal floating-point numbers
+// used by the Zed decimal types.  A Decimal holds a value exactly.  A Format
+// describes one of the decimal interchange formats, rounds values to its
+// precision and exponent range, and encodes them in its binary integer
+// decimal (BID) encoding.
+package decimal
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Form distinguishes finite values from infinities and NaNs.
+type Form uint8
+
+const (
+	Finite Form = iota
+	Infinite
+	NaN
+)
+
+// A Decimal is a finite value of the form (-1)^Neg × Coef × 10^Exp, an
+// infinity, or a NaN.  Coef is never negative.  Since the coefficient is
+// kept as is, a Decimal has a scale as well as a value: 1.20 and 1.2 are
+// equal but different Decimals.  The zero value is 0.
+type Decimal struct {
+	Form Form
+	Neg  bool
+	Coef big.Int
+	Exp  int
+}
+
+var ErrSyntax = errors.New("invalid decimal syntax")
+
+// New returns the Decimal coef × 10^exp.
+func New(coef int64, exp int) *Decimal {
+	d := &Decimal{Exp: exp, Neg: coef < 0}
+	d.Coef.SetInt64(coef)
+	d.Coef.Abs(&d.Coef)
+	return d
+}
+
+// NewFromBigInt returns the Decimal coef × 10^exp.
+func NewFromBigInt(coef *big.Int, exp int) *Decimal {
+	d := &Decimal{Exp: exp, Neg: coef.Sign() < 0}
+	d.Coef.Abs(coef)
+	return d
+}
+
+// NewInf returns positive infinity if neg is false and negative infinity
+// otherwise.
+func NewInf(neg bool) *Decimal {
+	return &Decimal{Form: Infinite, Neg: neg}
+}
+
+func NewNaN() *Decimal {
+	return &Decimal{Form: NaN}
+}
+
+// NewFromFloat returns the Decimal with the fewest digits that converts back
+// to f, which has bitSize bits (32 for float32, 64 for float64).
+func NewFromFloat(f float64, bitSize int) *Decimal {
+	switch {
+	case math.IsNaN(f):
+		return NewNaN()
+	case math.IsInf(f, 0):
+		return NewInf(f < 0)
+	}
+	d, err := Parse(strconv.FormatFloat(f, 'e', -1, bitSize))
+	if err != nil {
+		panic(err)
+	}
+	// Write integers of up to 17 digits, the most needed to identify
+	// a float64, without an exponent.
+	if n := numDigits(&d.Coef); d.Exp > 0 && n+d.Exp <= 17 {
+		d.Coef.Mul(&d.Coef, pow10(d.Exp))
+		d.Exp = 0
+	}
+	return d
+}
+
+// Parse returns the Decimal represented by s, which is a decimal number
+// with an optional sign, decimal point, and exponent, or one of "NaN",
+// "Inf", "+Inf", and "-Inf".
+func Parse(s string) (*Decimal, error) {
+	d := &Decimal{}
+	t := s
+	if t != "" && (t[0] == '+' || t[0] == '-') {
+		d.Neg = t[0] == '-'
+		t = t[1:]
+	}
+	switch strings.ToLower(t) {
+	case "inf", "infinity":
+		d.Form = Infinite
+		return d, nil
+	case "nan":
+		if s != t {
+			return nil, ErrSyntax
+		}
+		d.Form = NaN
+		return d, nil
+	}
+	mantissa, exponent := t, ""
+	if i := strings.IndexAny(t, "eE"); i >= 0 {
+		mantissa, exponent = t[:i], t[i+1:]
+		if exponent == "" {
+			return nil, ErrSyntax
+		}
+	}
+	digits := mantissa
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		digits = mantissa[:i] + mantissa[i+1:]
+		d.Exp = -(len(mantissa) - i - 1)
+	}
+	if digits == "" || strings.IndexFunc(digits, notDigit) >= 0 {
+		return nil, ErrSyntax
+	}
+	if _, ok := d.Coef.SetString(digits, 10); !ok {
+		return nil, ErrSyntax
+	}
+	if exponent != "" {
+		e, err := strconv.ParseInt(exponent, 10, 32)
+		if err != nil {
+			if !errors.Is(err, strconv.ErrRange) {
+				return nil, ErrSyntax
+			}
+			// The value will round to zero or infinity in any
+			// format.
+			e = math.MaxInt32
+			if exponent[0] == '-' {
+				e = math.MinInt32
+			}
+		}
+		d.Exp += int(e)
+	}
+	return d, nil
+}
+
+func notDigit(r rune) bool {
+	return r < '0' || r > '9'
+}
+
+func (d *Decimal) IsNaN() bool {
+	return d.Form == NaN
+}
+
+func (d *Decimal) IsInf() bool {
+	return d.Form == Infinite
+}
+
+func (d *Decimal) IsZero() bool {
+	return d.Form == Finite && d.Coef.Sign() == 0
+}
+
+// Sign returns -1, 0, or 1 as d is less than, equal to, or greater than
+// zero.  The sign of a NaN is 0.
+func (d *Decimal) Sign() int {
+	switch {
+	case d.Form == NaN || d.IsZero():
+		return 0
+	case d.Neg:
+		return -1
+	}
+	return 1
+}
+
+// Negate returns -d.
+func (d *Decimal) Negate() *Decimal {
+	out := d.copy()
+	if out.Form != NaN {
+		out.Neg = !out.Neg
+	}
+	return out
+}
+
+// Reduce returns d with the trailing zeros of its coefficient removed, so
+// equal values have equal reductions.  The reduction of a zero is 0.
+func (d *Decimal) Reduce() *Decimal {
+	out := d.copy()
+	if out.Form != Finite {
+		return out
+	}
+	if out.Coef.Sign() == 0 {
+		out.Neg, out.Exp = false, 0
+		return out
+	}
+	var q, r big.Int
+	for {
+		q.QuoRem(&out.Coef, bigTen, &r)
+		if r.Sign() != 0 {
+			return out
+		}
+		out.Coef.Set(&q)
+		out.Exp++
+	}
+}
+
+// RoundInt returns d rounded to the nearest integer, rounding half away from
+// zero as math.Round does.
+func (d *Decimal) RoundInt() *Decimal {
+	out := d.copy()
+	if out.Form != Finite || out.Exp >= 0 {
+		return out
+	}
+	drop := -out.Exp
+	out.Exp = 0
+	if drop > numDigits(&out.Coef) {
+		out.Coef.SetInt64(0)
+		return out
+	}
+	var r big.Int
+	out.Coef.QuoRem(&out.Coef, pow10(drop), &r)
+	if r.Mul(&r, big.NewInt(2)).Cmp(pow10(drop)) >= 0 {
+		out.Coef.Add(&out.Coef, bigOne)
+	}
+	return out
+}
+
+// Quantize returns d with exponent exp, rounding half-even if digits must be
+// removed from its coefficient.  Infinities and NaNs are returned as is.
+func (d *Decimal) Quantize(exp int) *Decimal {
+	out := d.copy()
+	if out.Form != Finite {
+		return out
+	}
+	if exp < out.Exp {
+		out.Coef.Mul(&out.Coef, pow10(out.Exp-exp))
+	} else if exp > out.Exp {
+		roundDigits(&out.Coef, exp-out.Exp, numDigits(&out.Coef))
+	}
+	out.Exp = exp
+	return out
+}
+
+// Rescale is like Quantize but returns false instead of rounding if digits
+// must be removed from the coefficient of d or if d is not finite.
+func (d *Decimal) Rescale(exp int) (*Decimal, bool) {
+	if d.Form != Finite {
+		return nil, false
+	}
+	out := d.Quantize(exp)
+	if exp > d.Exp && Cmp(out, d) != 0 {
+		return nil, false
+	}
+	return out, true
+}
+
+// TrimFraction returns d with trailing zeros removed from the digits of its
+// coefficient that follow the decimal point, so 1.500 becomes 1.5 and 100
+// remains 100.
+func (d *Decimal) TrimFraction() *Decimal {
+	out := d.copy()
+	if out.Form != Finite {
+		return out
+	}
+	var q, r big.Int
+	for out.Exp < 0 {
+		q.QuoRem(&out.Coef, bigTen, &r)
+		if r.Sign() != 0 {
+			break
+		}
+		out.Coef.Set(&q)
+		out.Exp++
+	}
+	return out
+}
+
+// BigInt returns the signed coefficient of d, which must be finite.
+func (d *Decimal) BigInt() *big.Int {
+	x := new(big.Int).Set(&d.Coef)
+	if d.Neg {
+		x.Neg(x)
+	}
+	return x
+}
+
+func (d *Decimal) copy() *Decimal {
+	out := &Decimal{Form: d.Form, Neg: d.Neg, Exp: d.Exp}
+	out.Coef.Set(&d.Coef)
+	return out
+}
+
+// adjusted returns the exponent of the most significant digit of d.
+func (d *Decimal) adjusted() int {
+	return d.Exp + numDigits(&d.Coef) - 1
+}
+
+// Cmp returns -1, 0, or 1 as a is less than, equal to, or greater than b.
+// Values are compared numerically regardless of scale and the sign of zero.
+// NaNs are equal to each other and less than any other value.
+func Cmp(a, b *Decimal) int {
+	switch {
+	case a.Form == NaN && b.Form == NaN:
+		return 0
+	case a.Form == NaN:
+		return -1
+	case b.Form == NaN:
+		return 1
+	}
+	sa, sb := a.Sign(), b.Sign()
+	if sa != sb {
+		if sa < sb {
+			return -1
+		}
+		return 1
+	}
+	if sa == 0 {
+		return 0
+	}
+	// a and b are nonzero with the same sign.
+	c := cmpAbs(a, b)
+	if a.Neg {
+		return -c
+	}
+	return c
+}
+
+func cmpAbs(a, b *Decimal) int {
+	switch {
+	case a.Form == Infinite && b.Form == Infinite:
+		return 0
+	case a.Form == Infinite:
+		return 1
+	case b.Form == Infinite:
+		return -1
+	}
+	if adjA, adjB := a.adjusted(), b.adjusted(); adjA != adjB {
+		if adjA < adjB {
+			return -1
+		}
+		return 1
+	}
+	// The most significant digits are aligned so the exponents differ by
+	// no more than the number of digits.
+	ca, cb := align(a, b)
+	return ca.Cmp(cb)
+}
+
+// align returns the coefficients of a and b scaled to the smaller of their
+// exponents.
+func align(a, b *Decimal) (*big.Int, *big.Int) {
+	ca, cb := new(big.Int).Set(&a.Coef), new(big.Int).Set(&b.Coef)
+	if a.Exp > b.Exp {
+		ca.Mul(ca, pow10(a.Exp-b.Exp))
+	} else if b.Exp > a.Exp {
+		cb.Mul(cb, pow10(b.Exp-a.Exp))
+	}
+	return ca, cb
+}
+
+// Float64 returns the float64 nearest to d.
+func (d *Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Int64 returns d truncated to an integer and true if the result fits in an
+// int64.
+func (d *Decimal) Int64() (int64, bool) {
+	v, ok := d.truncate(20)
+	if !ok || !v.IsInt64() {
+		return 0, false
+	}
+	return v.Int64(), true
+}
+
+// Uint64 returns d truncated to an integer and true if the result fits in a
+// uint64.
+func (d *Decimal) Uint64() (uint64, bool) {
+	v, ok := d.truncate(20)
+	if !ok || !v.IsUint64() {
+		return 0, false
+	}
+	return v.Uint64(), true
+}
+
+// Int returns d truncated to an integer and true if d is finite and less than
+// 10^80 in magnitude, which is enough for any 256-bit integer.
+func (d *Decimal) Int() (*big.Int, bool) {
+	return d.truncate(80)
+}
+
+// truncate returns the integer part of d if d is finite and less than
+// 10^digits in magnitude.
+func (d *Decimal) truncate(digits int) (*big.Int, bool) {
+	if d.Form != Finite {
+		return nil, false
+	}
+	if d.IsZero() || d.adjusted() < 0 {
+		return new(big.Int), true
+	}
+	if d.adjusted() >= digits {
+		return nil, false
+	}
+	v := new(big.Int).Set(&d.Coef)
+	if d.Exp > 0 {
+		v.Mul(v, pow10(d.Exp))
+	} else if d.Exp < 0 {
+		v.Quo(v, pow10(-d.Exp))
+	}
+	if d.Neg {
+		v.Neg(v)
+	}
+	return v, true
+}
+
+// String formats d in scientific notation if its exponent is positive or
+// it is small in magnitude and in plain notation otherwise, following the
+// to-scientific-string conversion of the General Decimal Arithmetic
+// specification.  Every digit of the coefficient is written so the scale
+// of d is preserved.
+func (d *Decimal) String() string {
+	switch d.Form {
+	case NaN:
+		return "NaN"
+	case Infinite:
+		if d.Neg {
+			return "-Inf"
+		}
+		return "+Inf"
+	}
+	var b strings.Builder
+	if d.Neg {
+		b.WriteByte('-')
+	}
+	digits := d.Coef.String()
+	adjusted := d.Exp + len(digits) - 1
+	switch {
+	case d.Exp <= 0 && adjusted >= -6:
+		if d.Exp == 0 {
+			b.WriteString(digits)
+			break
+		}
+		if point := len(digits) + d.Exp; point > 0 {
+			b.WriteString(digits[:point])
+			b.WriteByte('.')
+			b.WriteString(digits[point:])
+		} else {
+			b.WriteString("0.")
+			b.WriteString(strings.Repeat("0", -point))
+			b.WriteString(digits)
+		}
+	default:
+		b.WriteString(digits[:1])
+		if len(digits) > 1 {
+			b.WriteByte('.')
+			b.WriteString(digits[1:])
+		}
+		b.WriteByte('e')
+		if adjusted >= 0 {
+			b.WriteByte('+')
+		}
+		b.WriteString(strconv.Itoa(adjusted))
+	}
+	return b.String()
+}
+
+var (
+	bigOne = big.NewInt(1)
+	bigTen = big.NewInt(10)
+	pow10s []*big.Int
+)
+
+func init() {
+	// Cache the powers of ten up to the precision of the widest format.
+	p := big.NewInt(1)
+	for i := 0; i <= 2*Decimal256.precision+2; i++ {
+		pow10s = append(pow10s, new(big.Int).Set(p))
+		p.Mul(p, bigTen)
+	}
+}
+
+// pow10 returns 10^n, which must not be modified.
+func pow10(n int) *big.Int {
+	if n < len(pow10s) {
+		return pow10s[n]
+	}
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// numDigits returns the number of decimal digits in x, which is at least 1.
+func numDigits(x *big.Int) int {
+	if x.Sign() == 0 {
+		return 1
+	}
+	// log10(2) is slightly more than 0.30103, so this estimate is the
+	// number of digits or one more.
+	n := int(float64(x.BitLen())*0.30103) + 1
+	if x.CmpAbs(pow10(n-1)) < 0 {
+		n--
+	}
+	return n
+}
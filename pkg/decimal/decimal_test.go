@@ -0,0 +1,288 @@
+package decimal_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/brimdata/zed/pkg/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parse(t *testing.T, s string) *decimal.Decimal {
+	d, err := decimal.Parse(s)
+	require.NoError(t, err, "input: %q", s)
+	return d
+}
+
+func TestParseString(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"0", "0"},
+		{"-0", "-0"},
+		{"1.20", "1.20"},
+		{"+12.5", "12.5"},
+		{".5", "0.5"},
+		{"5.", "5"},
+		{"0.000001", "0.000001"},
+		{"0.0000001", "1e-7"},
+		{"1e2", "1e+2"},
+		{"123e-2", "1.23"},
+		{"-1.5E+3", "-1.5e+3"},
+		{"inf", "+Inf"},
+		{"-Inf", "-Inf"},
+		{"NaN", "NaN"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, parse(t, c.in).String(), "input: %q", c.in)
+	}
+	for _, s := range []string{"", "-", ".", "1e", "1.2.3", "1x", "-NaN", "e5"} {
+		_, err := decimal.Parse(s)
+		assert.ErrorIs(t, err, decimal.ErrSyntax, "input: %q", s)
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		format *decimal.Format
+		in     string
+		hex    string
+	}{
+		// Expected encodings are big-endian.
+		{decimal.Decimal32, "1", "32800001"},
+		{decimal.Decimal32, "-7.50", "b18002ee"},
+		{decimal.Decimal32, "9999999", "6cb8967f"},
+		{decimal.Decimal64, "1", "31c0000000000001"},
+		{decimal.Decimal64, "9999999999999999", "6c7386f26fc0ffff"},
+		{decimal.Decimal64, "+Inf", "7800000000000000"},
+		{decimal.Decimal64, "NaN", "7c00000000000000"},
+		{decimal.Decimal128, "1", "30400000000000000000000000000001"},
+	}
+	for _, c := range cases {
+		b := c.format.Append(nil, parse(t, c.in))
+		reversed := make([]byte, len(b))
+		for i := range b {
+			reversed[len(b)-1-i] = b[i]
+		}
+		assert.Equal(t, c.hex, hex.EncodeToString(reversed), "%s %s", c.format, c.in)
+		d, err := c.format.Decode(b)
+		require.NoError(t, err)
+		assert.Equal(t, c.in, d.String(), "%s %s", c.format, c.in)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	formats := []*decimal.Format{decimal.Decimal32, decimal.Decimal64, decimal.Decimal128, decimal.Decimal256}
+	for _, s := range []string{"0", "-0.00", "1.5", "-123.456", "1e-101", "9.999999e+96"} {
+		for _, f := range formats {
+			d, err := f.Decode(f.Append(nil, parse(t, s)))
+			require.NoError(t, err)
+			assert.Equal(t, s, d.String(), "%s %s", f, s)
+		}
+	}
+	_, err := decimal.Decimal64.Decode([]byte{1, 2, 3})
+	assert.Error(t, err)
+}
+
+func TestReduce(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"1.500", "1.5"},
+		{"100", "1e+2"},
+		{"-0.00", "0"},
+		{"12", "12"},
+		{"NaN", "NaN"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, parse(t, c.in).Reduce().String(), "input: %q", c.in)
+	}
+}
+
+func TestRoundInt(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"1.5", "2"},
+		{"-2.5", "-3"},
+		{"2.49", "2"},
+		{"0.001", "0"},
+		{"1e+2", "1e+2"},
+		{"-Inf", "-Inf"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, parse(t, c.in).RoundInt().String(), "input: %q", c.in)
+	}
+}
+
+func TestQuantize(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in       string
+		exp      int
+		expected string
+	}{
+		{"1.2", -3, "1.200"},
+		{"1.2345", -2, "1.23"},
+		{"1.235", -2, "1.24"},
+		{"1.225", -2, "1.22"},
+		{"-0.001", -1, "-0.0"},
+		{"12", 1, "1e+1"},
+		{"NaN", 0, "NaN"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, parse(t, c.in).Quantize(c.exp).String(), "input: %q", c.in)
+	}
+	assert.Equal(t, "-1200", parse(t, "-1.2").Quantize(-3).BigInt().String())
+}
+
+func TestRescale(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in       string
+		exp      int
+		expected string
+	}{
+		{"1.2", -3, "1.200"},
+		{"1.2300", -2, "1.23"},
+		{"1.2345", -2, ""},
+		{"120", 1, "1.2e+2"},
+		{"123", 1, ""},
+		{"NaN", 0, ""},
+		{"Inf", 0, ""},
+	}
+	for _, c := range cases {
+		d, ok := parse(t, c.in).Rescale(c.exp)
+		if c.expected == "" {
+			assert.False(t, ok, "input: %q", c.in)
+			continue
+		}
+		assert.True(t, ok, "input: %q", c.in)
+		assert.Equal(t, c.expected, d.String(), "input: %q", c.in)
+	}
+}
+
+func TestTrimFraction(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"1.500", "1.5"},
+		{"100", "100"},
+		{"1e+2", "1e+2"},
+		{"-2.000", "-2"},
+		{"0.000", "0"},
+		{"NaN", "NaN"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, parse(t, c.in).TrimFraction().String(), "input: %q", c.in)
+	}
+}
+
+func TestRound(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"1.234567", "1.234567"},
+		{"1.2345675", "1.234568"},
+		{"1.2345665", "1.234566"},
+		{"1.23456651", "1.234567"},
+		{"9999999.5", "1.000000e+7"},
+		{"1e96", "1.000000e+96"},
+		{"1e97", "+Inf"},
+		{"-1e200", "-Inf"},
+		{"1e-101", "1e-101"},
+		{"1e-102", "0e-101"},
+		{"6e-102", "1e-101"},
+		{"0e200", "0e+90"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, decimal.Decimal32.Round(parse(t, c.in)).String(), "input: %q", c.in)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+	f := decimal.Decimal64
+	cases := []struct {
+		op       func(a, b *decimal.Decimal) *decimal.Decimal
+		a, b     string
+		expected string
+	}{
+		{f.Add, "1.20", "3", "4.20"},
+		{f.Add, "0.1", "0.2", "0.3"},
+		{f.Add, "1.5", "-1.5", "0.0"},
+		{f.Add, "1", "1e-100", "1.000000000000000"},
+		{f.Add, "0.00", "12", "12.00"},
+		{f.Add, "+Inf", "-Inf", "NaN"},
+		{f.Sub, "1.30", "1.07", "0.23"},
+		{f.Mul, "1.20", "3", "3.60"},
+		{f.Mul, "-0.5", "0.25", "-0.125"},
+		{f.Mul, "+Inf", "0", "NaN"},
+		{f.Quo, "1", "4", "0.25"},
+		{f.Quo, "1.00", "4", "0.25"},
+		{f.Quo, "2.40", "2", "1.20"},
+		{f.Quo, "1000", "10", "100"},
+		{f.Quo, "1", "3", "0.3333333333333333"},
+		{f.Quo, "2", "3", "0.6666666666666667"},
+		{f.Quo, "1", "0", "+Inf"},
+		{f.Quo, "-1", "0", "-Inf"},
+		{f.Quo, "0", "0", "NaN"},
+	}
+	for _, c := range cases {
+		actual := c.op(parse(t, c.a), parse(t, c.b))
+		assert.Equal(t, c.expected, actual.String(), "%s, %s", c.a, c.b)
+	}
+}
+
+func TestCmp(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0", "1", 0},
+		{"0", "-0.00", 0},
+		{"1.01", "1.1", -1},
+		{"-2", "-10", 1},
+		{"99", "1e2", -1},
+		{"+Inf", "1e300", 1},
+		{"NaN", "-Inf", -1},
+		{"NaN", "NaN", 0},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, decimal.Cmp(parse(t, c.a), parse(t, c.b)), "%s, %s", c.a, c.b)
+		assert.Equal(t, -c.expected, decimal.Cmp(parse(t, c.b), parse(t, c.a)), "%s, %s", c.b, c.a)
+	}
+}
+
+func TestConversions(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "0.1", decimal.NewFromFloat(0.1, 64).String())
+	assert.Equal(t, "100", decimal.NewFromFloat(100, 64).String())
+	assert.Equal(t, "1e+300", decimal.NewFromFloat(1e300, 64).String())
+	assert.Equal(t, "0.1", decimal.NewFromFloat(float64(float32(0.1)), 32).String())
+	assert.Equal(t, 1.25, parse(t, "1.25").Float64())
+	i, ok := parse(t, "-12.9").Int64()
+	assert.True(t, ok)
+	assert.EqualValues(t, -12, i)
+	_, ok = parse(t, "1e19").Int64()
+	assert.False(t, ok)
+	u, ok := parse(t, "1e19").Uint64()
+	assert.True(t, ok)
+	assert.EqualValues(t, uint64(1e19), u)
+	_, ok = parse(t, "-1").Uint64()
+	assert.False(t, ok)
+}
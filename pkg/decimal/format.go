@@ -0,0 +1,372 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A Format is an IEEE 754 decimal interchange format of k bits, which has
+// a precision of 9k/32-2 digits and a maximum exponent of 3×2^(k/16+3).
+// decimal256 is not one of the basic formats but is defined by the same
+// parameters.
+type Format struct {
+	name      string
+	bits      int
+	precision int
+	emax      int
+}
+
+var (
+	Decimal32  = newFormat("decimal32", 32)
+	Decimal64  = newFormat("decimal64", 64)
+	Decimal128 = newFormat("decimal128", 128)
+	Decimal256 = newFormat("decimal256", 256)
+)
+
+func newFormat(name string, k int) *Format {
+	return &Format{
+		name:      name,
+		bits:      k,
+		precision: 9*k/32 - 2,
+		emax:      3 << (k/16 + 3),
+	}
+}
+
+// FormatOfSize returns the format whose encoding is n bytes long or nil if
+// there is none.
+func FormatOfSize(n int) *Format {
+	switch n {
+	case 4:
+		return Decimal32
+	case 8:
+		return Decimal64
+	case 16:
+		return Decimal128
+	case 32:
+		return Decimal256
+	}
+	return nil
+}
+
+func (f *Format) String() string {
+	return f.name
+}
+
+// Precision returns the number of digits in the coefficient of a value.
+func (f *Format) Precision() int {
+	return f.precision
+}
+
+// Size returns the length of an encoded value in bytes.
+func (f *Format) Size() int {
+	return f.bits / 8
+}
+
+// Emax returns the largest exponent of a value in scientific notation, so
+// the largest finite value is 9.99...9 × 10^Emax.
+func (f *Format) Emax() int {
+	return f.emax
+}
+
+// etiny is the smallest exponent of the coefficient of a value.
+func (f *Format) etiny() int {
+	return 2 - f.emax - f.precision
+}
+
+// elimit is the largest exponent of the coefficient of a value.
+func (f *Format) elimit() int {
+	return f.emax - f.precision + 1
+}
+
+// Round returns d rounded to f's precision using round-half-even, with its
+// exponent brought into f's range.  A value too large for f becomes an
+// infinity and a value too small becomes zero.
+func (f *Format) Round(d *Decimal) *Decimal {
+	if d.Form != Finite {
+		return d.copy()
+	}
+	out := d.copy()
+	n := numDigits(&out.Coef)
+	drop := n - f.precision
+	if tiny := f.etiny() - out.Exp; tiny > drop {
+		drop = tiny
+	}
+	if drop > 0 {
+		roundDigits(&out.Coef, drop, n)
+		out.Exp += drop
+		if numDigits(&out.Coef) > f.precision {
+			// Rounding carried into a new digit.
+			out.Coef.Quo(&out.Coef, bigTen)
+			out.Exp++
+		}
+	}
+	if elimit := f.elimit(); out.Exp > elimit {
+		if out.Coef.Sign() == 0 {
+			out.Exp = elimit
+			return out
+		}
+		pad := out.Exp - elimit
+		if numDigits(&out.Coef)+pad > f.precision {
+			return NewInf(out.Neg)
+		}
+		out.Coef.Mul(&out.Coef, pow10(pad))
+		out.Exp = elimit
+	}
+	return out
+}
+
+// roundDigits rounds the n-digit x to remove its drop least significant
+// digits using round-half-even.
+func roundDigits(x *big.Int, drop, n int) {
+	if drop > n {
+		// x is less than half of 10^drop.
+		x.SetInt64(0)
+		return
+	}
+	var r big.Int
+	x.QuoRem(x, pow10(drop), &r)
+	r.Mul(&r, big.NewInt(2))
+	if c := r.Cmp(pow10(drop)); c > 0 || c == 0 && x.Bit(0) == 1 {
+		x.Add(x, bigOne)
+	}
+}
+
+// Add returns a+b rounded to f.
+func (f *Format) Add(a, b *Decimal) *Decimal {
+	switch {
+	case a.Form == NaN || b.Form == NaN:
+		return NewNaN()
+	case a.Form == Infinite && b.Form == Infinite:
+		if a.Neg != b.Neg {
+			return NewNaN()
+		}
+		return a.copy()
+	case a.Form == Infinite:
+		return a.copy()
+	case b.Form == Infinite:
+		return b.copy()
+	}
+	if a.IsZero() || b.IsZero() {
+		return f.addZero(a, b)
+	}
+	if a.adjusted() < b.adjusted() {
+		a, b = b, a
+	}
+	// If every digit of b is below those that can affect the rounding
+	// of the sum, b is replaced by a single digit just below them so its
+	// coefficient needn't be scaled by a large power of ten.
+	limit := a.Exp
+	if e := a.adjusted() - f.precision - 1; e < limit {
+		limit = e
+	}
+	if b.adjusted() < limit-1 {
+		b = &Decimal{Neg: b.Neg, Exp: limit - 1}
+		b.Coef.SetInt64(1)
+	}
+	ca, cb := align(a, b)
+	if a.Neg {
+		ca.Neg(ca)
+	}
+	if b.Neg {
+		cb.Neg(cb)
+	}
+	sum := NewFromBigInt(ca.Add(ca, cb), min(a.Exp, b.Exp))
+	return f.Round(sum)
+}
+
+// addZero returns a+b where either is zero.  The result has the smaller
+// exponent of a and b if the other addend can be scaled to it.
+func (f *Format) addZero(a, b *Decimal) *Decimal {
+	exp := min(a.Exp, b.Exp)
+	if a.IsZero() && b.IsZero() {
+		// The sum of zeros is negative only if both are.
+		out := &Decimal{Neg: a.Neg && b.Neg, Exp: exp}
+		return f.Round(out)
+	}
+	x := a
+	if a.IsZero() {
+		x = b
+	}
+	out := x.copy()
+	if pad := min(out.Exp-exp, f.precision-numDigits(&out.Coef)); pad > 0 {
+		out.Coef.Mul(&out.Coef, pow10(pad))
+		out.Exp -= pad
+	}
+	return f.Round(out)
+}
+
+// Sub returns a-b rounded to f.
+func (f *Format) Sub(a, b *Decimal) *Decimal {
+	return f.Add(a, b.Negate())
+}
+
+// Mul returns a×b rounded to f.
+func (f *Format) Mul(a, b *Decimal) *Decimal {
+	neg := a.Neg != b.Neg
+	switch {
+	case a.Form == NaN || b.Form == NaN:
+		return NewNaN()
+	case a.Form == Infinite || b.Form == Infinite:
+		if a.IsZero() || b.IsZero() {
+			return NewNaN()
+		}
+		return NewInf(neg)
+	}
+	out := &Decimal{Neg: neg, Exp: a.Exp + b.Exp}
+	out.Coef.Mul(&a.Coef, &b.Coef)
+	return f.Round(out)
+}
+
+// Quo returns a/b rounded to f.  An exact quotient has the exponent
+// closest to the exponent of a less that of b.  Division of a nonzero
+// value by zero gives an infinity, and division of zero by zero gives NaN.
+func (f *Format) Quo(a, b *Decimal) *Decimal {
+	neg := a.Neg != b.Neg
+	switch {
+	case a.Form == NaN || b.Form == NaN:
+		return NewNaN()
+	case a.Form == Infinite && b.Form == Infinite:
+		return NewNaN()
+	case a.Form == Infinite:
+		return NewInf(neg)
+	case b.Form == Infinite:
+		return f.Round(&Decimal{Neg: neg, Exp: f.etiny()})
+	case b.IsZero():
+		if a.IsZero() {
+			return NewNaN()
+		}
+		return NewInf(neg)
+	}
+	ideal := a.Exp - b.Exp
+	if a.IsZero() {
+		return f.Round(&Decimal{Neg: neg, Exp: ideal})
+	}
+	// Scale the dividend so the quotient has at least one more digit
+	// than the precision.
+	shift := f.precision + 1 + numDigits(&b.Coef) - numDigits(&a.Coef)
+	if shift < 0 {
+		shift = 0
+	}
+	out := &Decimal{Neg: neg, Exp: ideal - shift}
+	num := new(big.Int).Mul(&a.Coef, pow10(shift))
+	var r big.Int
+	out.Coef.QuoRem(num, &b.Coef, &r)
+	if r.Sign() != 0 {
+		// The quotient is inexact, so append a nonzero digit to
+		// break any tie when it is rounded.
+		out.Coef.Mul(&out.Coef, bigTen)
+		out.Coef.Add(&out.Coef, bigOne)
+		out.Exp--
+	} else {
+		var digit big.Int
+		for out.Exp < ideal {
+			q, _ := new(big.Int).QuoRem(&out.Coef, bigTen, &digit)
+			if digit.Sign() != 0 {
+				break
+			}
+			out.Coef.Set(q)
+			out.Exp++
+		}
+	}
+	return f.Round(out)
+}
+
+// Append appends the encoding of d rounded to f to b.  Values are encoded
+// in the binary integer decimal (BID) encoding in little-endian byte order.
+func (f *Format) Append(b []byte, d *Decimal) []byte {
+	d = f.Round(d)
+	k := f.bits
+	t := 15*k/16 - 10
+	var x big.Int
+	switch d.Form {
+	case NaN:
+		x.Lsh(big.NewInt(0x1f), uint(k-6))
+	case Infinite:
+		x.Lsh(big.NewInt(0x1e), uint(k-6))
+	default:
+		e := big.NewInt(int64(d.Exp - f.etiny()))
+		if d.Coef.BitLen() <= t+3 {
+			x.Lsh(e, uint(t+3))
+			x.Or(&x, &d.Coef)
+		} else {
+			// The coefficient's implicit leading bits are 100.
+			var low big.Int
+			low.Sub(&d.Coef, new(big.Int).Lsh(bigOne, uint(t+3)))
+			x.Lsh(big.NewInt(3), uint(k-3))
+			x.Or(&x, e.Lsh(e, uint(t+1)))
+			x.Or(&x, &low)
+		}
+	}
+	if d.Neg {
+		x.SetBit(&x, k-1, 1)
+	}
+	n := len(b)
+	b = append(b, make([]byte, f.bits/8)...)
+	x.FillBytes(b[n:])
+	reverse(b[n:])
+	return b
+}
+
+// Decode returns the Decimal encoded in b, which must be f.Size() bytes.
+// A noncanonical coefficient too large for f's precision is decoded as zero.
+func (f *Format) Decode(b []byte) (*Decimal, error) {
+	if len(b) != f.bits/8 {
+		return nil, fmt.Errorf("%s encoding must be %d bytes: got %d", f, f.bits/8, len(b))
+	}
+	k := f.bits
+	t := 15*k/16 - 10
+	expBits := k/16 + 6
+	be := make([]byte, len(b))
+	copy(be, b)
+	reverse(be)
+	var x big.Int
+	x.SetBytes(be)
+	d := &Decimal{Neg: x.Bit(k-1) == 1}
+	var e big.Int
+	switch top := bits(&x, k-6, 5); {
+	case top == 0x1f:
+		d.Form, d.Neg = NaN, false
+		return d, nil
+	case top == 0x1e:
+		d.Form = Infinite
+		return d, nil
+	case top>>3 == 3:
+		e.SetUint64(bits(&x, t+1, expBits))
+		d.Coef.SetBit(lowBits(&x, t+1), t+3, 1)
+	default:
+		e.SetUint64(bits(&x, t+3, expBits))
+		d.Coef.Set(lowBits(&x, t+3))
+	}
+	if d.Coef.Cmp(pow10(f.precision)) >= 0 {
+		d.Coef.SetInt64(0)
+	}
+	d.Exp = int(e.Int64()) + f.etiny()
+	return d, nil
+}
+
+// bits returns the n bits of x beginning at bit i.
+func bits(x *big.Int, i, n int) uint64 {
+	var v big.Int
+	v.Rsh(x, uint(i))
+	return lowBits(&v, n).Uint64()
+}
+
+// lowBits returns the n least significant bits of x.
+func lowBits(x *big.Int, n int) *big.Int {
+	mask := new(big.Int).Lsh(bigOne, uint(n))
+	mask.Sub(mask, bigOne)
+	return mask.And(mask, x)
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
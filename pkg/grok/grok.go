@@ -0,0 +1,185 @@
+// Package grok implements grok patterns, which name regular expressions
+// so they can be combined into larger expressions that extract fields
+// from unstructured text.
+//
+// A pattern is a regular expression that may refer to a named pattern
+// with %{NAME}, capture the text matched by a named pattern as a field
+// with %{NAME:field}, and give the type of the field with
+// %{NAME:field:type}, where type is int or float.
+package grok
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ErrNoMatch = errors.New("value does not match pattern")
+
+// Library maps pattern names to their definitions.
+type Library map[string]string
+
+// NewLibrary returns a library containing the built-in patterns.
+func NewLibrary() Library {
+	lib := make(Library)
+	if err := lib.Add(patterns); err != nil {
+		panic(err)
+	}
+	return lib
+}
+
+// Add adds the pattern definitions in defs to the library, replacing any
+// with the same name.  Each line of defs has a pattern name followed by
+// whitespace and the pattern.  Blank lines and lines beginning with # are
+// ignored.
+func (l Library) Add(defs string) error {
+	scanner := bufio.NewScanner(strings.NewReader(defs))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		name, def, ok := strings.Cut(line, " ")
+		if !ok {
+			name, def, ok = strings.Cut(line, "\t")
+		}
+		if !ok || !validName.MatchString(name) {
+			return fmt.Errorf("invalid pattern definition: %q", line)
+		}
+		l[name] = strings.TrimSpace(def)
+	}
+	return scanner.Err()
+}
+
+var (
+	validName = regexp.MustCompile(`^\w+$`)
+	reference = regexp.MustCompile(`%{(\w+)(?::([^:}]+))?(?::(\w+))?}`)
+)
+
+// Type is the type of a field.
+type Type int
+
+const (
+	String Type = iota
+	Int
+	Float
+)
+
+type Field struct {
+	Name string
+	Type Type
+}
+
+// Pattern is a compiled grok pattern.
+type Pattern struct {
+	re     *regexp.Regexp
+	fields []Field
+	// groups holds the submatch index of each field.
+	groups []int
+}
+
+// Compile expands the references to named patterns in pattern and compiles
+// the result.
+func (l Library) Compile(pattern string) (*Pattern, error) {
+	var fields []Field
+	seen := make(map[string]bool)
+	expanded, err := l.expand(pattern, &fields, seen, nil)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(expanded)
+	if err != nil {
+		return nil, err
+	}
+	p := &Pattern{re: re, fields: fields}
+	for k := range fields {
+		p.groups = append(p.groups, re.SubexpIndex(groupName(k)))
+	}
+	return p, nil
+}
+
+// expand replaces the references in pattern with their definitions,
+// recursively, while appending captured fields to fields.  The names of
+// the patterns being expanded are in stack so cycles can be detected.
+func (l Library) expand(pattern string, fields *[]Field, seen map[string]bool, stack []string) (string, error) {
+	var b strings.Builder
+	for {
+		loc := reference.FindStringSubmatchIndex(pattern)
+		if loc == nil {
+			b.WriteString(pattern)
+			return b.String(), nil
+		}
+		b.WriteString(pattern[:loc[0]])
+		name := pattern[loc[2]:loc[3]]
+		def, ok := l[name]
+		if !ok {
+			return "", fmt.Errorf("unknown pattern %q", name)
+		}
+		for _, s := range stack {
+			if s == name {
+				return "", fmt.Errorf("pattern %q refers to itself", name)
+			}
+		}
+		if loc[4] >= 0 {
+			field := Field{Name: pattern[loc[4]:loc[5]]}
+			if seen[field.Name] {
+				return "", fmt.Errorf("duplicate field %q", field.Name)
+			}
+			seen[field.Name] = true
+			if loc[6] >= 0 {
+				switch typ := pattern[loc[6]:loc[7]]; typ {
+				case "int":
+					field.Type = Int
+				case "float":
+					field.Type = Float
+				case "string":
+				default:
+					return "", fmt.Errorf("unknown type %q for field %q", typ, field.Name)
+				}
+			}
+			// Add the field before expanding the definition so fields
+			// are in the order their references appear.
+			b.WriteString("(?P<" + groupName(len(*fields)) + ">")
+			*fields = append(*fields, field)
+		} else {
+			b.WriteString("(?:")
+		}
+		expanded, err := l.expand(def, fields, seen, append(stack, name))
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(expanded)
+		b.WriteString(")")
+		pattern = pattern[loc[1]:]
+	}
+}
+
+func groupName(k int) string {
+	return "grok_field_" + strconv.Itoa(k)
+}
+
+// Fields returns the fields captured by the pattern in the order they
+// appear in the pattern.
+func (p *Pattern) Fields() []Field {
+	return p.fields
+}
+
+// Match matches b against the pattern and returns the text captured for
+// each field, which is nil for fields that did not participate in the
+// match.  If b does not match, Match returns ErrNoMatch.
+func (p *Pattern) Match(b []byte) ([][]byte, error) {
+	loc := p.re.FindSubmatchIndex(b)
+	if loc == nil {
+		return nil, ErrNoMatch
+	}
+	captures := make([][]byte, len(p.groups))
+	for i, k := range p.groups {
+		if start := loc[2*k]; start >= 0 {
+			captures[i] = b[start:loc[2*k+1]]
+		}
+	}
+	return captures, nil
+}
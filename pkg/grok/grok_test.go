@@ -0,0 +1,71 @@
+package grok
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibrary(t *testing.T) {
+	// Every built-in pattern must compile.
+	lib := NewLibrary()
+	for name := range lib {
+		_, err := lib.Compile("%{" + name + "}")
+		assert.NoError(t, err, "pattern %s", name)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		input    string
+		expected map[string]string
+	}{
+		{"%{IP:ip}", "addr=fe80::1%eth0", map[string]string{"ip": "fe80::1%eth0"}},
+		{"%{IP:ip} ", "10.0.0.1 ", map[string]string{"ip": "10.0.0.1"}},
+		{"%{TIMESTAMP_ISO8601:ts}", "at 2023-03-05T14:07:09.123Z", map[string]string{"ts": "2023-03-05T14:07:09.123Z"}},
+		{"%{EMAILADDRESS:email}", "mail bob.smith@example.com", map[string]string{"email": "bob.smith@example.com"}},
+		{"%{QS:q}", `say "a \"b\" c"`, map[string]string{"q": `"a \"b\" c"`}},
+		{"%{URI:uri}", "https://user@host.com:8080/a/b?x=1", map[string]string{"uri": "https://user@host.com:8080/a/b?x=1"}},
+		{"%{SYSLOGBASE}", "Mar  5 14:07:09 web01 sshd[4242]:", map[string]string{
+			"timestamp": "Mar  5 14:07:09",
+			"logsource": "web01",
+			"program":   "sshd",
+			"pid":       "4242",
+		}},
+	}
+	lib := NewLibrary()
+	for _, c := range cases {
+		p, err := lib.Compile(c.pattern)
+		require.NoError(t, err)
+		captures, err := p.Match([]byte(c.input))
+		require.NoError(t, err, "pattern %s", c.pattern)
+		actual := make(map[string]string)
+		for i, f := range p.Fields() {
+			if captures[i] != nil {
+				actual[f.Name] = string(captures[i])
+			}
+		}
+		assert.Equal(t, c.expected, actual, "pattern %s", c.pattern)
+	}
+}
+
+func TestCompileError(t *testing.T) {
+	lib := NewLibrary()
+	require.NoError(t, lib.Add("LOOP1 a%{LOOP2}\nLOOP2 %{LOOP1}"))
+	cases := []struct {
+		pattern string
+		err     string
+	}{
+		{"%{NOPE}", `unknown pattern "NOPE"`},
+		{"%{LOOP1}", `pattern "LOOP1" refers to itself`},
+		{"%{INT:x} %{INT:x}", `duplicate field "x"`},
+		{"%{INT:x:bool}", `unknown type "bool" for field "x"`},
+	}
+	for _, c := range cases {
+		_, err := lib.Compile(c.pattern)
+		assert.EqualError(t, err, c.err)
+	}
+	assert.EqualError(t, lib.Add("BAD-NAME x"), `invalid pattern definition: "BAD-NAME x"`)
+}
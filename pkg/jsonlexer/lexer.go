@@ -39,6 +39,13 @@ func New(r io.Reader) *Lexer {
 	}
 }
 
+// Reset discards the lexer's state and switches it to reading from r.
+func (l *Lexer) Reset(r io.Reader) {
+	l.br.Reset(r)
+	l.buf = l.buf[:0]
+	l.err = nil
+}
+
 func (l *Lexer) Buf() []byte {
 	return l.buf
 }
@@ -0,0 +1,487 @@
+// Package strftime formats and parses times using the conversion
+// specifications of the C library functions strftime and strptime.
+//
+// The supported conversions are:
+//
+//	%a  abbreviated weekday name (Mon)
+//	%A  full weekday name (Monday)
+//	%b  abbreviated month name (Jan); %h is a synonym
+//	%B  full month name (January)
+//	%C  century as a decimal number (20)
+//	%d  day of the month, zero padded (01-31)
+//	%D  equivalent to %m/%d/%y
+//	%e  day of the month, space padded ( 1-31)
+//	%f  microseconds, zero padded (000000-999999); parses 1 to 9 digits
+//	%F  equivalent to %Y-%m-%d
+//	%H  hour of the 24-hour clock (00-23)
+//	%I  hour of the 12-hour clock (01-12)
+//	%j  day of the year (001-366)
+//	%L  milliseconds, zero padded (000-999)
+//	%m  month (01-12)
+//	%M  minute (00-59)
+//	%n  newline; matches any whitespace when parsing
+//	%N  nanoseconds, zero padded (000000000-999999999)
+//	%p  AM or PM
+//	%R  equivalent to %H:%M
+//	%s  seconds since the Unix epoch
+//	%S  second (00-60)
+//	%t  tab; matches any whitespace when parsing
+//	%T  equivalent to %H:%M:%S
+//	%u  weekday with Monday as 1 (1-7)
+//	%w  weekday with Sunday as 0 (0-6)
+//	%y  year without century (00-99)
+//	%Y  year with century (2006)
+//	%z  time zone offset (-0700); parses -07, -0700, -07:00, and Z
+//	%Z  time zone name (MST); parses UTC, GMT, Z, and IANA names
+//	%%  a literal %
+package strftime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Format returns t formatted according to format.  An unknown conversion
+// is copied to the output unchanged.
+func Format(format string, t time.Time) string {
+	return string(AppendFormat(nil, format, t))
+}
+
+// AppendFormat is like Format but appends the result to b.
+func AppendFormat(b []byte, format string, t time.Time) []byte {
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' || i+1 == len(format) {
+			b = append(b, c)
+			continue
+		}
+		i++
+		b = appendConversion(b, format[i], t)
+	}
+	return b
+}
+
+func appendConversion(b []byte, c byte, t time.Time) []byte {
+	switch c {
+	case 'a':
+		return append(b, t.Weekday().String()[:3]...)
+	case 'A':
+		return append(b, t.Weekday().String()...)
+	case 'b', 'h':
+		return append(b, t.Month().String()[:3]...)
+	case 'B':
+		return append(b, t.Month().String()...)
+	case 'C':
+		return appendInt(b, t.Year()/100, 2, '0')
+	case 'd':
+		return appendInt(b, t.Day(), 2, '0')
+	case 'D':
+		return AppendFormat(b, "%m/%d/%y", t)
+	case 'e':
+		return appendInt(b, t.Day(), 2, ' ')
+	case 'f':
+		return appendInt(b, t.Nanosecond()/1000, 6, '0')
+	case 'F':
+		return AppendFormat(b, "%Y-%m-%d", t)
+	case 'H':
+		return appendInt(b, t.Hour(), 2, '0')
+	case 'I':
+		hour := t.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+		return appendInt(b, hour, 2, '0')
+	case 'j':
+		return appendInt(b, t.YearDay(), 3, '0')
+	case 'L':
+		return appendInt(b, t.Nanosecond()/1000000, 3, '0')
+	case 'm':
+		return appendInt(b, int(t.Month()), 2, '0')
+	case 'M':
+		return appendInt(b, t.Minute(), 2, '0')
+	case 'n':
+		return append(b, '\n')
+	case 'N':
+		return appendInt(b, t.Nanosecond(), 9, '0')
+	case 'p':
+		if t.Hour() < 12 {
+			return append(b, "AM"...)
+		}
+		return append(b, "PM"...)
+	case 'R':
+		return AppendFormat(b, "%H:%M", t)
+	case 's':
+		return strconv.AppendInt(b, t.Unix(), 10)
+	case 'S':
+		return appendInt(b, t.Second(), 2, '0')
+	case 't':
+		return append(b, '\t')
+	case 'T':
+		return AppendFormat(b, "%H:%M:%S", t)
+	case 'u':
+		weekday := int(t.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		return appendInt(b, weekday, 1, '0')
+	case 'w':
+		return appendInt(b, int(t.Weekday()), 1, '0')
+	case 'y':
+		return appendInt(b, t.Year()%100, 2, '0')
+	case 'Y':
+		return appendInt(b, t.Year(), 4, '0')
+	case 'z':
+		return t.AppendFormat(b, "-0700")
+	case 'Z':
+		return t.AppendFormat(b, "MST")
+	case '%':
+		return append(b, '%')
+	}
+	return append(b, '%', c)
+}
+
+func appendInt(b []byte, n, width int, pad byte) []byte {
+	if n < 0 {
+		b = append(b, '-')
+		n = -n
+	}
+	s := strconv.Itoa(n)
+	for k := len(s); k < width; k++ {
+		b = append(b, pad)
+	}
+	return append(b, s...)
+}
+
+var ErrUnknownConversion = errors.New("unknown conversion")
+
+// Parse parses s according to format and returns the time it represents.
+// Whitespace in format matches zero or more whitespace characters in s,
+// and other characters must match exactly.  Fields missing from format
+// take their values from 1970-01-01T00:00:00Z.  If format has no time
+// zone conversion, the time is interpreted in UTC.
+func Parse(format, s string) (time.Time, error) {
+	p := parser{s: s, year: 1970, month: 1, day: 1, loc: time.UTC}
+	if err := p.parse(format); err != nil {
+		return time.Time{}, err
+	}
+	if p.pos < len(p.s) {
+		return time.Time{}, fmt.Errorf("unparsed text %q", p.s[p.pos:])
+	}
+	return p.time()
+}
+
+type parser struct {
+	s   string
+	pos int
+
+	year      int
+	month     int
+	day       int
+	yday      int
+	hour      int
+	minute    int
+	second    int
+	nsec      int
+	pm        bool
+	hasPM     bool
+	unix      int64
+	hasUnix   bool
+	loc       *time.Location
+	hasOffset bool
+}
+
+func (p *parser) parse(format string) error {
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if isSpace(c) {
+			p.skipSpace()
+			continue
+		}
+		if c != '%' || i+1 == len(format) {
+			if p.pos >= len(p.s) || p.s[p.pos] != c {
+				return p.errorf("expected %q", c)
+			}
+			p.pos++
+			continue
+		}
+		i++
+		if err := p.conversion(format[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parser) conversion(c byte) error {
+	var err error
+	switch c {
+	case 'a', 'A':
+		_, err = p.name(weekdayNames, "weekday")
+	case 'b', 'B', 'h':
+		p.month, err = p.name(monthNames, "month")
+		p.month++
+	case 'C':
+		var century int
+		century, err = p.number(2, 0, 99, "century")
+		p.year = century*100 + p.year%100
+	case 'd', 'e':
+		p.skipSpace()
+		p.day, err = p.number(2, 1, 31, "day")
+	case 'D':
+		err = p.parse("%m/%d/%y")
+	case 'f', 'N':
+		err = p.fraction()
+	case 'F':
+		err = p.parse("%Y-%m-%d")
+	case 'H':
+		p.hour, err = p.number(2, 0, 23, "hour")
+	case 'I':
+		p.hour, err = p.number(2, 1, 12, "hour")
+	case 'j':
+		p.yday, err = p.number(3, 1, 366, "day of year")
+	case 'L':
+		var msec int
+		msec, err = p.number(3, 0, 999, "milliseconds")
+		p.nsec = msec * 1000000
+	case 'm':
+		p.month, err = p.number(2, 1, 12, "month")
+	case 'M':
+		p.minute, err = p.number(2, 0, 59, "minute")
+	case 'n', 't':
+		p.skipSpace()
+	case 'p':
+		var i int
+		i, err = p.name([]string{"AM", "PM"}, "AM/PM")
+		p.pm = i == 1
+		p.hasPM = true
+	case 'R':
+		err = p.parse("%H:%M")
+	case 's':
+		err = p.epoch()
+	case 'S':
+		p.second, err = p.number(2, 0, 60, "second")
+	case 'T':
+		err = p.parse("%H:%M:%S")
+	case 'u':
+		_, err = p.number(1, 1, 7, "weekday")
+	case 'w':
+		_, err = p.number(1, 0, 6, "weekday")
+	case 'y':
+		var year int
+		year, err = p.number(2, 0, 99, "year")
+		// Follow POSIX: values 69-99 refer to the twentieth century
+		// and values 00-68 refer to the twenty-first.
+		if year < 69 {
+			year += 2000
+		} else {
+			year += 1900
+		}
+		p.year = year
+	case 'Y':
+		p.year, err = p.number(4, 0, 9999, "year")
+	case 'z':
+		err = p.offset()
+	case 'Z':
+		err = p.zone()
+	case '%':
+		if p.pos >= len(p.s) || p.s[p.pos] != '%' {
+			return p.errorf("expected %q", '%')
+		}
+		p.pos++
+	default:
+		return fmt.Errorf("%w: %%%c", ErrUnknownConversion, c)
+	}
+	return err
+}
+
+func (p *parser) time() (time.Time, error) {
+	if p.hasUnix {
+		return time.Unix(p.unix, int64(p.nsec)).UTC(), nil
+	}
+	hour := p.hour
+	if p.hasPM {
+		hour %= 12
+		if p.pm {
+			hour += 12
+		}
+	}
+	month, day := p.month, p.day
+	if p.yday != 0 {
+		month, day = 1, p.yday
+	}
+	t := time.Date(p.year, time.Month(month), day, hour, p.minute, p.second, p.nsec, p.loc)
+	if t.Day() != day && p.yday == 0 {
+		return time.Time{}, fmt.Errorf("day %d out of range for month %d", day, month)
+	}
+	return t, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	if p.pos >= len(p.s) {
+		return fmt.Errorf(format+" at end of input", args...)
+	}
+	return fmt.Errorf(format+" at %q", append(args, p.s[p.pos:])...)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.s) && isSpace(p.s[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *parser) digits(max int) string {
+	start := p.pos
+	for p.pos < len(p.s) && p.pos-start < max && isDigit(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// number parses an unsigned decimal number of at most width digits and
+// checks that it lies between min and max.
+func (p *parser) number(width, min, max int, what string) (int, error) {
+	digits := p.digits(width)
+	if digits == "" {
+		return 0, p.errorf("expected %s", what)
+	}
+	n, _ := strconv.Atoi(digits)
+	if n < min || n > max {
+		return 0, fmt.Errorf("%s %s out of range", what, digits)
+	}
+	return n, nil
+}
+
+func (p *parser) fraction() error {
+	digits := p.digits(9)
+	if digits == "" {
+		return p.errorf("expected fractional seconds")
+	}
+	n, _ := strconv.Atoi(digits)
+	for k := len(digits); k < 9; k++ {
+		n *= 10
+	}
+	p.nsec = n
+	return nil
+}
+
+func (p *parser) epoch() error {
+	start := p.pos
+	if p.pos < len(p.s) && (p.s[p.pos] == '-' || p.s[p.pos] == '+') {
+		p.pos++
+	}
+	p.digits(len(p.s))
+	n, err := strconv.ParseInt(p.s[start:p.pos], 10, 64)
+	if err != nil {
+		p.pos = start
+		return p.errorf("expected seconds since epoch")
+	}
+	p.unix = n
+	p.hasUnix = true
+	return nil
+}
+
+var (
+	weekdayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	monthNames   = []string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+)
+
+// name matches the full name or three-letter abbreviation of one of names,
+// ignoring case, and returns its index.
+func (p *parser) name(names []string, what string) (int, error) {
+	rest := p.s[p.pos:]
+	for i, name := range names {
+		if len(rest) >= len(name) && strings.EqualFold(rest[:len(name)], name) {
+			p.pos += len(name)
+			return i, nil
+		}
+	}
+	for i, name := range names {
+		if len(rest) >= 3 && strings.EqualFold(rest[:3], name[:3]) {
+			p.pos += 3
+			return i, nil
+		}
+	}
+	return 0, p.errorf("expected %s", what)
+}
+
+func (p *parser) offset() error {
+	if p.pos < len(p.s) && p.s[p.pos] == 'Z' {
+		p.pos++
+		p.setOffset(0)
+		return nil
+	}
+	if p.pos >= len(p.s) || (p.s[p.pos] != '+' && p.s[p.pos] != '-') {
+		return p.errorf("expected time zone offset")
+	}
+	sign := 1
+	if p.s[p.pos] == '-' {
+		sign = -1
+	}
+	p.pos++
+	hours, err := p.number(2, 0, 23, "time zone offset")
+	if err != nil {
+		return err
+	}
+	var minutes int
+	if p.pos < len(p.s) && p.s[p.pos] == ':' {
+		p.pos++
+		if minutes, err = p.number(2, 0, 59, "time zone offset"); err != nil {
+			return err
+		}
+	} else if p.pos < len(p.s) && isDigit(p.s[p.pos]) {
+		if minutes, err = p.number(2, 0, 59, "time zone offset"); err != nil {
+			return err
+		}
+	}
+	p.setOffset(sign * (hours*3600 + minutes*60))
+	return nil
+}
+
+func (p *parser) setOffset(seconds int) {
+	if seconds == 0 {
+		p.loc = time.UTC
+	} else {
+		p.loc = time.FixedZone("", seconds)
+	}
+	p.hasOffset = true
+}
+
+func (p *parser) zone() error {
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := rune(p.s[p.pos])
+		if !unicode.IsLetter(c) && c != '/' && c != '_' && c != '-' && c != '+' && !unicode.IsDigit(c) {
+			break
+		}
+		p.pos++
+	}
+	name := p.s[start:p.pos]
+	switch name {
+	case "":
+		return p.errorf("expected time zone name")
+	case "UTC", "GMT", "Z":
+		p.setOffset(0)
+		return nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return fmt.Errorf("unknown time zone %q", name)
+	}
+	// An explicit offset takes precedence over a zone name.
+	if !p.hasOffset {
+		p.loc = loc
+	}
+	return nil
+}
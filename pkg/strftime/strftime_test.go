@@ -0,0 +1,77 @@
+package strftime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	ts := time.Date(2023, time.March, 5, 14, 7, 9, 123456789, time.UTC)
+	cases := []struct {
+		format   string
+		expected string
+	}{
+		{"%Y-%m-%dT%H:%M:%S", "2023-03-05T14:07:09"},
+		{"%F %T.%f", "2023-03-05 14:07:09.123456"},
+		{"%a %A %b %B %e", "Sun Sunday Mar March  5"},
+		{"%I:%M %p", "02:07 PM"},
+		{"%D %R", "03/05/23 14:07"},
+		{"%j %u %w %C", "064 7 0 20"},
+		{"%L %N", "123 123456789"},
+		{"%s", "1678025229"},
+		{"%z %Z", "+0000 UTC"},
+		{"100%% %q %", "100% %q %"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, Format(c.format, ts), "format %q", c.format)
+	}
+	midnight := time.Date(2023, time.March, 5, 0, 0, 0, 0, time.FixedZone("", -7*3600))
+	assert.Equal(t, "12 AM -0700", Format("%I %p %z", midnight))
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		format   string
+		input    string
+		expected time.Time
+	}{
+		{"%Y-%m-%d %H:%M:%S", "2023-03-05 14:07:09", time.Date(2023, 3, 5, 14, 7, 9, 0, time.UTC)},
+		{"%d/%b/%Y:%T %z", "05/Mar/2023:14:07:09 -0700", time.Date(2023, 3, 5, 21, 7, 9, 0, time.UTC)},
+		{"%F %T.%f", "2023-03-05 14:07:09.5", time.Date(2023, 3, 5, 14, 7, 9, 500000000, time.UTC)},
+		{"%B %e %Y %I:%M %p", "march 5 2023 12:30 am", time.Date(2023, 3, 5, 0, 30, 0, 0, time.UTC)},
+		{"%m/%d/%y", "3/5/69", time.Date(1969, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"%Y %j", "2024 60", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"%s", "1678025229", time.Date(2023, 3, 5, 14, 7, 9, 0, time.UTC)},
+		{"%Y-%m-%dT%H:%M:%S%z", "2023-03-05T14:07:09Z", time.Date(2023, 3, 5, 14, 7, 9, 0, time.UTC)},
+		{"%Y-%m-%d %H:%M %Z", "2023-07-01 12:00 America/New_York", time.Date(2023, 7, 1, 16, 0, 0, 0, time.UTC)},
+		{"%H:%M  %a", "08:15Mon", time.Date(1970, 1, 1, 8, 15, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		ts, err := Parse(c.format, c.input)
+		require.NoError(t, err, "format %q", c.format)
+		assert.Equal(t, c.expected, ts.UTC(), "format %q", c.format)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	cases := []struct {
+		format string
+		input  string
+		err    string
+	}{
+		{"%Y-%m-%d", "2023-13-01", "month 13 out of range"},
+		{"%Y-%m-%d", "2023-02-30", "day 30 out of range for month 2"},
+		{"%Y-%m-%d", "2023-02-01x", `unparsed text "x"`},
+		{"%Y-%m-%d", "2023/02/01", `expected '-' at "/02/01"`},
+		{"%H:%M", "10", `expected ':' at end of input`},
+		{"%Q", "10", "unknown conversion: %Q"},
+		{"%Z", "Nowhere/Special", `unknown time zone "Nowhere/Special"`},
+	}
+	for _, c := range cases {
+		_, err := Parse(c.format, c.input)
+		assert.EqualError(t, err, c.err, "format %q", c.format)
+	}
+}
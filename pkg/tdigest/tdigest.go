@@ -0,0 +1,166 @@
+// Package tdigest implements the merging t-digest of Dunning and Ertl, a
+// compact, mergeable sketch for estimating quantiles of a stream of numbers
+// with accuracy that is highest near the extreme quantiles.
+// See https://arxiv.org/abs/1902.04023.
+package tdigest
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultCompression bounds the number of centroids in a digest to roughly
+// twice its value while providing error of well under one percent for most
+// quantiles.
+const DefaultCompression = 100
+
+type Centroid struct {
+	Mean   float64
+	Weight float64
+}
+
+type Digest struct {
+	compression float64
+	centroids   []Centroid
+	// unmerged holds values added since the last compression.
+	unmerged    []Centroid
+	totalWeight float64
+	min         float64
+	max         float64
+}
+
+func New(compression float64) *Digest {
+	return &Digest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Add adds the value x with weight w to the digest.
+func (d *Digest) Add(x, w float64) {
+	if math.IsNaN(x) || w <= 0 {
+		return
+	}
+	d.unmerged = append(d.unmerged, Centroid{x, w})
+	d.totalWeight += w
+	d.min = math.Min(d.min, x)
+	d.max = math.Max(d.max, x)
+	if len(d.unmerged) >= 5*int(d.compression) {
+		d.compress()
+	}
+}
+
+// Merge adds the centroids of a digest with extreme values min and max
+// to d.
+func (d *Digest) Merge(centroids []Centroid, min, max float64) {
+	for _, c := range centroids {
+		if c.Weight > 0 {
+			d.unmerged = append(d.unmerged, c)
+			d.totalWeight += c.Weight
+		}
+	}
+	if len(centroids) > 0 {
+		d.min = math.Min(d.min, min)
+		d.max = math.Max(d.max, max)
+	}
+	d.compress()
+}
+
+// Centroids returns the compressed centroids of the digest in order of
+// increasing mean.
+func (d *Digest) Centroids() []Centroid {
+	d.compress()
+	return d.centroids
+}
+
+func (d *Digest) Min() float64 {
+	return d.min
+}
+
+func (d *Digest) Max() float64 {
+	return d.max
+}
+
+// Count returns the total weight of the values added to the digest.
+func (d *Digest) Count() float64 {
+	return d.totalWeight
+}
+
+func (d *Digest) compress() {
+	if len(d.unmerged) == 0 {
+		return
+	}
+	all := append(d.unmerged, d.centroids...)
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Mean < all[j].Mean
+	})
+	merged := make([]Centroid, 0, len(d.centroids)+1)
+	cur := all[0]
+	var weightSoFar float64
+	limit := d.totalWeight * d.quantileOfScale(d.scale(0)+1)
+	for _, c := range all[1:] {
+		if weightSoFar+cur.Weight+c.Weight <= limit {
+			cur.Weight += c.Weight
+			cur.Mean += (c.Mean - cur.Mean) * c.Weight / cur.Weight
+			continue
+		}
+		weightSoFar += cur.Weight
+		merged = append(merged, cur)
+		limit = d.totalWeight * d.quantileOfScale(d.scale(weightSoFar/d.totalWeight)+1)
+		cur = c
+	}
+	d.centroids = append(merged, cur)
+	d.unmerged = d.unmerged[:0]
+}
+
+// scale is the k1 scale function of the t-digest paper, which maps
+// quantile q to an index such that each centroid spans at most one unit.
+func (d *Digest) scale(q float64) float64 {
+	return d.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// quantileOfScale is the inverse of scale.
+func (d *Digest) quantileOfScale(k float64) float64 {
+	if k >= d.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/d.compression) + 1) / 2
+}
+
+// Quantile returns an estimate of the value at quantile q of the values
+// added to the digest, where q is between 0 and 1.  If the digest is
+// empty, Quantile returns NaN.  When no values have been merged into
+// centroids, as with small inputs, the result is exact and is interpolated
+// linearly between the values at the closest ranks.
+func (d *Digest) Quantile(q float64) float64 {
+	d.compress()
+	n := len(d.centroids)
+	if n == 0 {
+		return math.NaN()
+	}
+	// Treat the values of each centroid as spread evenly around its mean
+	// so that its mean falls at the middle of its ranks, then interpolate
+	// between adjacent centroids and between the extreme centroids and
+	// the extreme values, which have the lowest and highest ranks.
+	rank := q * (d.totalWeight - 1)
+	var cumulative float64
+	prevRank, prevMean := 0.0, d.min
+	for _, c := range d.centroids {
+		r := cumulative + (c.Weight-1)/2
+		if rank < r {
+			return interpolate(prevMean, c.Mean, (rank-prevRank)/(r-prevRank))
+		}
+		prevRank, prevMean = r, c.Mean
+		cumulative += c.Weight
+	}
+	lastRank := d.totalWeight - 1
+	if rank >= lastRank || lastRank == prevRank {
+		return d.max
+	}
+	return interpolate(prevMean, d.max, (rank-prevRank)/(lastRank-prevRank))
+}
+
+func interpolate(a, b, frac float64) float64 {
+	return a + (b-a)*frac
+}
@@ -0,0 +1,69 @@
+package tdigest
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmall(t *testing.T) {
+	d := New(DefaultCompression)
+	assert.True(t, math.IsNaN(d.Quantile(0.5)))
+	for _, x := range []float64{3, 1, 4, 2, 5} {
+		d.Add(x, 1)
+	}
+	assert.Equal(t, 1.0, d.Quantile(0))
+	assert.Equal(t, 3.0, d.Quantile(0.5))
+	assert.Equal(t, 5.0, d.Quantile(1))
+	d.Add(6, 1)
+	assert.Equal(t, 3.5, d.Quantile(0.5))
+	assert.Equal(t, 2.25, d.Quantile(0.25))
+}
+
+func TestAccuracy(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var vals []float64
+	d := New(DefaultCompression)
+	for i := 0; i < 100000; i++ {
+		x := r.ExpFloat64()
+		vals = append(vals, x)
+		d.Add(x, 1)
+	}
+	require.Less(t, len(d.Centroids()), 2*DefaultCompression)
+	sort.Float64s(vals)
+	for _, q := range []float64{0.001, 0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.99, 0.999} {
+		rank := rankOf(vals, d.Quantile(q))
+		assert.InDelta(t, q, rank, 0.005, "quantile %g", q)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var vals []float64
+	merged := New(DefaultCompression)
+	for k := 0; k < 10; k++ {
+		d := New(DefaultCompression)
+		for i := 0; i < 10000; i++ {
+			x := r.NormFloat64()
+			vals = append(vals, x)
+			d.Add(x, 1)
+		}
+		merged.Merge(d.Centroids(), d.Min(), d.Max())
+	}
+	assert.Equal(t, float64(len(vals)), merged.Count())
+	sort.Float64s(vals)
+	assert.Equal(t, vals[0], merged.Quantile(0))
+	assert.Equal(t, vals[len(vals)-1], merged.Quantile(1))
+	for _, q := range []float64{0.01, 0.1, 0.5, 0.9, 0.99} {
+		rank := rankOf(vals, merged.Quantile(q))
+		assert.InDelta(t, q, rank, 0.005, "quantile %g", q)
+	}
+}
+
+func rankOf(sorted []float64, x float64) float64 {
+	return float64(sort.SearchFloat64s(sorted, x)) / float64(len(sorted))
+}
@@ -5,9 +5,12 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"math/big"
 	"math/bits"
 	"net/netip"
 
+	"github.com/brimdata/zed/pkg/bigfloat"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/zcode"
 	"github.com/x448/float16"
@@ -111,8 +114,10 @@ func DecodeFloat(zb zcode.Bytes) float64 {
 	case 8:
 		bits := binary.LittleEndian.Uint64(zb)
 		return math.Float64frombits(bits)
+	case 16, 32:
+		return bigfloat.Float64(DecodeBigFloat(zb))
 	}
-	panic("float encoding is neither 4 nor 8 bytes")
+	panic("float encoding is not 2, 4, 8, 16, or 32 bytes")
 }
 
 type TypeOfFloat16 struct{}
@@ -195,6 +200,155 @@ func (t *TypeOfFloat64) Kind() Kind {
 	return PrimitiveKind
 }
 
+// BigFloatFormat returns the format of the float128 or float256 type with
+// the given ID or nil if there is none.
+func BigFloatFormat(id int) *bigfloat.Format {
+	switch id {
+	case IDFloat128:
+		return bigfloat.Binary128
+	case IDFloat256:
+		return bigfloat.Binary256
+	}
+	return nil
+}
+
+// AppendBigFloat appends x rounded to the format of the float128 or float256
+// type with the given ID.  A nil x is NaN.
+func AppendBigFloat(zb zcode.Bytes, id int, x *big.Float) zcode.Bytes {
+	return BigFloatFormat(id).Append(zb, x)
+}
+
+func EncodeBigFloat(id int, x *big.Float) zcode.Bytes {
+	return AppendBigFloat(nil, id, x)
+}
+
+// DecodeBigFloat decodes a value of any float type, returning nil for NaN.
+func DecodeBigFloat(zb zcode.Bytes) *big.Float {
+	f := bigfloat.FormatOfSize(len(zb))
+	if f == nil {
+		return bigfloat.Binary256.FromFloat64(DecodeFloat(zb))
+	}
+	x, err := f.Decode(zb)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+type TypeOfFloat128 struct{}
+
+func (t *TypeOfFloat128) ID() int {
+	return IDFloat128
+}
+
+func (t *TypeOfFloat128) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfFloat256 struct{}
+
+func (t *TypeOfFloat256) ID() int {
+	return IDFloat256
+}
+
+func (t *TypeOfFloat256) Kind() Kind {
+	return PrimitiveKind
+}
+
+// DecimalFormat returns the format of the decimal type with the given ID or
+// nil if the ID is not that of a decimal type.
+func DecimalFormat(id int) *decimal.Format {
+	switch id {
+	case IDDecimal32:
+		return decimal.Decimal32
+	case IDDecimal64:
+		return decimal.Decimal64
+	case IDDecimal128:
+		return decimal.Decimal128
+	case IDDecimal256:
+		return decimal.Decimal256
+	}
+	return nil
+}
+
+// DecimalTypeOfPrecision returns the narrowest decimal type whose precision
+// is at least p digits or nil if there is none.
+func DecimalTypeOfPrecision(p int) Type {
+	for _, typ := range []Type{TypeDecimal32, TypeDecimal64, TypeDecimal128, TypeDecimal256} {
+		if DecimalFormat(typ.ID()).Precision() >= p {
+			return typ
+		}
+	}
+	return nil
+}
+
+// AppendDecimal appends d rounded to the format of the decimal type with
+// the given ID.
+func AppendDecimal(zb zcode.Bytes, id int, d *decimal.Decimal) zcode.Bytes {
+	return DecimalFormat(id).Append(zb, d)
+}
+
+func EncodeDecimal(id int, d *decimal.Decimal) zcode.Bytes {
+	return AppendDecimal(nil, id, d)
+}
+
+// DecodeDecimal decodes a value of any decimal type, which is determined
+// by the length of zb.
+func DecodeDecimal(zb zcode.Bytes) *decimal.Decimal {
+	if zb == nil {
+		return &decimal.Decimal{}
+	}
+	f := decimal.FormatOfSize(len(zb))
+	if f == nil {
+		panic("decimal encoding is not 4, 8, 16, or 32 bytes")
+	}
+	d, err := f.Decode(zb)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+type TypeOfDecimal32 struct{}
+
+func (t *TypeOfDecimal32) ID() int {
+	return IDDecimal32
+}
+
+func (t *TypeOfDecimal32) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfDecimal64 struct{}
+
+func (t *TypeOfDecimal64) ID() int {
+	return IDDecimal64
+}
+
+func (t *TypeOfDecimal64) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfDecimal128 struct{}
+
+func (t *TypeOfDecimal128) ID() int {
+	return IDDecimal128
+}
+
+func (t *TypeOfDecimal128) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfDecimal256 struct{}
+
+func (t *TypeOfDecimal256) ID() int {
+	return IDDecimal256
+}
+
+func (t *TypeOfDecimal256) Kind() Kind {
+	return PrimitiveKind
+}
+
 func EncodeInt(i int64) zcode.Bytes {
 	var b [8]byte
 	n := zcode.EncodeCountedVarint(b[:], i)
@@ -223,6 +377,120 @@ func DecodeUint(zv zcode.Bytes) uint64 {
 	return zcode.DecodeCountedUvarint(zv)
 }
 
+// AppendBigInt appends the encoding of x as a signed integer.  A value that
+// fits in an int64 has the same encoding as with AppendInt.
+func AppendBigInt(zb zcode.Bytes, x *big.Int) zcode.Bytes {
+	if x.IsInt64() {
+		return AppendInt(zb, x.Int64())
+	}
+	// Like zcode.AppendCountedVarint, encode the magnitude shifted left
+	// one bit with the sign in the low bit.
+	var u big.Int
+	u.Lsh(u.Abs(x), 1)
+	if x.Sign() < 0 {
+		u.SetBit(&u, 0, 1)
+	}
+	return AppendBigUint(zb, &u)
+}
+
+func EncodeBigInt(x *big.Int) zcode.Bytes {
+	return AppendBigInt(nil, x)
+}
+
+// AppendBigUint appends the encoding of x, which must not be negative, as an
+// unsigned integer.  A value that fits in a uint64 has the same encoding as
+// with AppendUint.
+func AppendBigUint(zb zcode.Bytes, x *big.Int) zcode.Bytes {
+	if x.IsUint64() {
+		return AppendUint(zb, x.Uint64())
+	}
+	// The encoding is little-endian with no high-order zero bytes.
+	b := x.Bytes()
+	for i := len(b) - 1; i >= 0; i-- {
+		zb = append(zb, b[i])
+	}
+	return zb
+}
+
+func EncodeBigUint(x *big.Int) zcode.Bytes {
+	return AppendBigUint(nil, x)
+}
+
+// DecodeBigInt decodes a value of any signed integer type.
+func DecodeBigInt(zb zcode.Bytes) *big.Int {
+	if len(zb) <= 8 {
+		return big.NewInt(DecodeInt(zb))
+	}
+	u := DecodeBigUint(zb)
+	neg := u.Bit(0) == 1
+	u.Rsh(u, 1)
+	if neg {
+		u.Neg(u)
+	}
+	return u
+}
+
+// DecodeBigUint decodes a value of any unsigned integer type.
+func DecodeBigUint(zb zcode.Bytes) *big.Int {
+	if len(zb) <= 8 {
+		return new(big.Int).SetUint64(DecodeUint(zb))
+	}
+	b := make([]byte, len(zb))
+	for i := range zb {
+		b[len(zb)-1-i] = zb[i]
+	}
+	return new(big.Int).SetBytes(b)
+}
+
+// intBits returns the width of the integer type with the given ID or zero if
+// there is none.
+func intBits(id int) int {
+	switch id {
+	case IDUint8, IDInt8:
+		return 8
+	case IDUint16, IDInt16:
+		return 16
+	case IDUint32, IDInt32:
+		return 32
+	case IDUint64, IDInt64:
+		return 64
+	case IDUint128, IDInt128:
+		return 128
+	case IDUint256, IDInt256:
+		return 256
+	}
+	return 0
+}
+
+// IntInRange returns true iff x is in the range of the integer type with the
+// given ID.
+func IntInRange(id int, x *big.Int) bool {
+	bits := intBits(id)
+	if bits == 0 {
+		return false
+	}
+	if !IsSigned(id) {
+		return x.Sign() >= 0 && x.BitLen() <= bits
+	}
+	if x.Sign() < 0 {
+		// The most negative value, -2^(bits-1), has bits-1 bits in
+		// its magnitude along with its trailing zeros.
+		return x.BitLen() < bits || x.BitLen() == bits && x.TrailingZeroBits() == uint(bits-1)
+	}
+	return x.BitLen() < bits
+}
+
+// WrapInt returns x reduced to the range of the integer type with the given
+// ID with two's-complement wraparound, as when int64 arithmetic overflows.
+func WrapInt(id int, x *big.Int) *big.Int {
+	mod := new(big.Int).Lsh(big.NewInt(1), uint(intBits(id)))
+	v := new(big.Int).Mod(x, mod)
+	if IsSigned(id) && v.Bit(intBits(id)-1) == 1 {
+		v.Sub(v, mod)
+	}
+	return v
+}
+
 type TypeOfInt8 struct{}
 
 func (t *TypeOfInt8) ID() int {
@@ -303,6 +571,46 @@ func (t *TypeOfUint64) Kind() Kind {
 	return PrimitiveKind
 }
 
+type TypeOfInt128 struct{}
+
+func (t *TypeOfInt128) ID() int {
+	return IDInt128
+}
+
+func (t *TypeOfInt128) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfUint128 struct{}
+
+func (t *TypeOfUint128) ID() int {
+	return IDUint128
+}
+
+func (t *TypeOfUint128) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfInt256 struct{}
+
+func (t *TypeOfInt256) ID() int {
+	return IDInt256
+}
+
+func (t *TypeOfInt256) Kind() Kind {
+	return PrimitiveKind
+}
+
+type TypeOfUint256 struct{}
+
+func (t *TypeOfUint256) ID() int {
+	return IDUint256
+}
+
+func (t *TypeOfUint256) Kind() Kind {
+	return PrimitiveKind
+}
+
 type TypeOfIP struct{}
 
 func AppendIP(zb zcode.Bytes, a netip.Addr) zcode.Bytes {
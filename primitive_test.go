@@ -0,0 +1,47 @@
+package zed_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/brimdata/zed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBigIntEncoding(t *testing.T) {
+	for _, i := range []int64{0, 1, -1, 127, -128, math.MaxInt64, math.MinInt64} {
+		assert.Equal(t, zed.EncodeInt(i), zed.EncodeBigInt(big.NewInt(i)), "%d", i)
+		assert.Equal(t, i, zed.DecodeBigInt(zed.EncodeInt(i)).Int64(), "%d", i)
+	}
+	for _, u := range []uint64{0, 1, 255, math.MaxUint64} {
+		x := new(big.Int).SetUint64(u)
+		assert.Equal(t, zed.EncodeUint(u), zed.EncodeBigUint(x), "%d", u)
+		assert.Equal(t, u, zed.DecodeBigUint(zed.EncodeUint(u)).Uint64(), "%d", u)
+	}
+	max256 := new(big.Int).Lsh(big.NewInt(1), 256)
+	max256.Sub(max256, big.NewInt(1))
+	min256 := new(big.Int).Lsh(big.NewInt(-1), 255)
+	for _, s := range []string{"9223372036854775808", "-9223372036854775809", min256.String(), "170141183460469231731687303715884105727"} {
+		x, _ := new(big.Int).SetString(s, 10)
+		assert.Equal(t, s, zed.DecodeBigInt(zed.EncodeBigInt(x)).String())
+	}
+	assert.Equal(t, max256, zed.DecodeBigUint(zed.EncodeBigUint(max256)))
+	assert.Len(t, zed.EncodeBigUint(max256), 32)
+}
+
+func TestIntInRange(t *testing.T) {
+	pow2 := func(n uint) *big.Int { return new(big.Int).Lsh(big.NewInt(1), n) }
+	minus1 := func(x *big.Int) *big.Int { return x.Sub(x, big.NewInt(1)) }
+	assert.True(t, zed.IntInRange(zed.IDInt128, minus1(pow2(127))))
+	assert.False(t, zed.IntInRange(zed.IDInt128, pow2(127)))
+	assert.True(t, zed.IntInRange(zed.IDInt128, new(big.Int).Neg(pow2(127))))
+	assert.False(t, zed.IntInRange(zed.IDInt128, minus1(new(big.Int).Neg(pow2(127)))))
+	assert.True(t, zed.IntInRange(zed.IDUint256, minus1(pow2(256))))
+	assert.False(t, zed.IntInRange(zed.IDUint256, pow2(256)))
+	assert.False(t, zed.IntInRange(zed.IDUint128, big.NewInt(-1)))
+	assert.True(t, zed.IntInRange(zed.IDInt8, big.NewInt(-128)))
+	assert.False(t, zed.IntInRange(zed.IDInt8, big.NewInt(128)))
+	assert.Equal(t, "-1", zed.WrapInt(zed.IDInt128, minus1(pow2(128))).String())
+	assert.Equal(t, "0", zed.WrapInt(zed.IDUint128, pow2(128)).String())
+}
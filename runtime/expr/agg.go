@@ -11,8 +11,8 @@ type Aggregator struct {
 	where   Evaluator
 }
 
-func NewAggregator(op string, expr Evaluator, where Evaluator) (*Aggregator, error) {
-	pattern, err := agg.NewPattern(op, expr != nil)
+func NewAggregator(op string, expr Evaluator, where Evaluator, params ...*zed.Value) (*Aggregator, error) {
+	pattern, err := agg.NewPattern(op, expr != nil, params...)
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/pkg/anymath"
+	"github.com/brimdata/zed/runtime/expr/coerce"
 )
 
 // MaxValueSize limits the size of a value produced by an aggregate function
@@ -23,8 +24,12 @@ type Function interface {
 	ResultAsPartial(*zed.Context) *zed.Value
 }
 
-func NewPattern(op string, hasarg bool) (Pattern, error) {
+// NewPattern returns the pattern for aggregator op.  Some aggregators take
+// optional constant parameters following their argument, which are passed
+// in params, e.g., the quantile 0.9 in quantile(x, 0.9).
+func NewPattern(op string, hasarg bool, params ...*zed.Value) (Pattern, error) {
 	needarg := true
+	var nparams int
 	var pattern Pattern
 	switch op {
 	case "count":
@@ -53,6 +58,54 @@ func NewPattern(op string, hasarg bool) (Pattern, error) {
 		pattern = func() Function {
 			return newMathReducer(anymath.Add)
 		}
+	case "var":
+		pattern = func() Function {
+			return &Variance{}
+		}
+	case "stddev":
+		pattern = func() Function {
+			return &Variance{stddev: true}
+		}
+	case "covar":
+		pattern = func() Function {
+			return &Covariance{}
+		}
+	case "corr":
+		pattern = func() Function {
+			return &Covariance{corr: true}
+		}
+	case "median":
+		pattern = func() Function {
+			return newQuantile(0.5)
+		}
+	case "quantile":
+		// The semantic pass requires the quantile parameter, which is
+		// absent only when checking whether op is an aggregator.
+		nparams = 1
+		var q float64
+		if len(params) > 0 {
+			var ok bool
+			q, ok = coerce.ToFloat(params[0])
+			if !ok || q < 0 || q > 1 {
+				return nil, fmt.Errorf("%s: quantile must be a number between 0 and 1", op)
+			}
+		}
+		pattern = func() Function {
+			return newQuantile(q)
+		}
+	case "topk":
+		nparams = 1
+		k := 10
+		if len(params) > 0 {
+			n, ok := coerce.ToInt(params[0])
+			if !ok || n < 1 || !zed.IsInteger(params[0].Type.ID()) {
+				return nil, fmt.Errorf("%s: k must be a positive integer", op)
+			}
+			k = int(n)
+		}
+		pattern = func() Function {
+			return newTopK(k)
+		}
 	case "map":
 		pattern = func() Function {
 			return newMap()
@@ -87,5 +140,8 @@ func NewPattern(op string, hasarg bool) (Pattern, error) {
 	if needarg && !hasarg {
 		return nil, fmt.Errorf("%s: argument required", op)
 	}
+	if len(params) > nparams {
+		return nil, fmt.Errorf("%s: too many arguments", op)
+	}
 	return pattern, nil
 }
@@ -2,9 +2,12 @@ package agg
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/pkg/anymath"
+	"github.com/brimdata/zed/pkg/bigfloat"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/runtime/expr/coerce"
 	"github.com/brimdata/zed/zson"
@@ -68,8 +71,17 @@ func (m *mathReducer) consumeVal(val *zed.Value) {
 			m.math = NewInt64(m.function, state)
 		case zed.IDUint8, zed.IDUint16, zed.IDUint32, zed.IDUint64:
 			m.math = NewUint64(m.function, state)
+		case zed.IDInt128, zed.IDInt256, zed.IDUint128, zed.IDUint256:
+			typ, _ := zed.LookupPrimitiveByID(id)
+			m.math = NewBigInt(m.function, typ, state)
 		case zed.IDFloat16, zed.IDFloat32, zed.IDFloat64:
 			m.math = NewFloat64(m.function, state)
+		case zed.IDFloat128, zed.IDFloat256:
+			typ, _ := zed.LookupPrimitiveByID(id)
+			m.math = NewBigFloat(m.function, typ, state)
+		case zed.IDDecimal32, zed.IDDecimal64, zed.IDDecimal128, zed.IDDecimal256:
+			typ, _ := zed.LookupPrimitiveByID(id)
+			m.math = NewDecimal(m.function, typ, state)
 		case zed.IDDuration:
 			m.math = NewDuration(m.function, state)
 		case zed.IDTime:
@@ -190,6 +202,119 @@ func (u *Uint64) consume(val *zed.Value) {
 
 func (f *Uint64) typ() zed.Type { return zed.TypeUint64 }
 
+type BigInt struct {
+	zedType  zed.Type
+	state    *big.Int
+	function anymath.BigInt
+}
+
+func NewBigInt(f *anymath.Function, typ zed.Type, val *zed.Value) *BigInt {
+	state := f.Init.BigInt
+	if !val.IsNull() {
+		var ok bool
+		state, ok = coerce.ToBigInt(val)
+		if !ok {
+			panicCoercionFail(typ, val.Type)
+		}
+	}
+	return &BigInt{
+		zedType:  typ,
+		state:    state,
+		function: f.BigInt,
+	}
+}
+
+func (b *BigInt) result() *zed.Value {
+	// Wrap around on overflow as Int64 and Uint64 do.
+	id := b.zedType.ID()
+	v := zed.WrapInt(id, b.state)
+	if zed.IsSigned(id) {
+		return zed.NewValue(b.zedType, zed.EncodeBigInt(v))
+	}
+	return zed.NewValue(b.zedType, zed.EncodeBigUint(v))
+}
+
+func (b *BigInt) consume(val *zed.Value) {
+	if v, ok := coerce.ToBigInt(val); ok {
+		b.state = b.function(b.state, v)
+	}
+}
+
+func (b *BigInt) typ() zed.Type { return b.zedType }
+
+type BigFloat struct {
+	zedType  zed.Type
+	format   *bigfloat.Format
+	state    *big.Float
+	function anymath.BigFloat
+}
+
+func NewBigFloat(f *anymath.Function, typ zed.Type, val *zed.Value) *BigFloat {
+	format := zed.BigFloatFormat(typ.ID())
+	state := f.Init.BigFloat
+	if !val.IsNull() {
+		var ok bool
+		state, ok = coerce.ToBigFloat(val, format)
+		if !ok {
+			panicCoercionFail(typ, val.Type)
+		}
+	}
+	return &BigFloat{
+		zedType:  typ,
+		format:   format,
+		state:    state,
+		function: f.BigFloat,
+	}
+}
+
+func (b *BigFloat) result() *zed.Value {
+	return zed.NewValue(b.zedType, zed.EncodeBigFloat(b.zedType.ID(), b.state))
+}
+
+func (b *BigFloat) consume(val *zed.Value) {
+	if v, ok := coerce.ToBigFloat(val, b.format); ok {
+		b.state = b.function(b.format, b.state, v)
+	}
+}
+
+func (b *BigFloat) typ() zed.Type { return b.zedType }
+
+type Decimal struct {
+	zedType  zed.Type
+	format   *decimal.Format
+	state    *decimal.Decimal
+	function anymath.Decimal
+}
+
+func NewDecimal(f *anymath.Function, typ zed.Type, val *zed.Value) *Decimal {
+	state := f.Init.Decimal
+	if !val.IsNull() {
+		var ok bool
+		state, ok = coerce.ToDecimal(val)
+		if !ok {
+			panicCoercionFail(typ, val.Type)
+		}
+	}
+	return &Decimal{
+		zedType:  typ,
+		format:   zed.DecimalFormat(typ.ID()),
+		state:    state,
+		function: f.Decimal,
+	}
+}
+
+func (d *Decimal) result() *zed.Value {
+	return zed.NewValue(d.zedType, zed.EncodeDecimal(d.zedType.ID(), d.state))
+}
+
+func (d *Decimal) consume(val *zed.Value) {
+	if v, ok := coerce.ToDecimal(val); ok {
+		d.state = d.function(d.format, d.state, v)
+	}
+}
+
+func (d *Decimal) typ() zed.Type { return d.zedType }
+
 type Duration struct {
 	state    int64
 	function anymath.Int64
@@ -0,0 +1,107 @@
+package agg
+
+import (
+	"fmt"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/tdigest"
+	"github.com/brimdata/zed/runtime/expr/coerce"
+	"github.com/brimdata/zed/zcode"
+	"github.com/brimdata/zed/zson"
+)
+
+// Quantile uses a t-digest to estimate the value at a quantile of its input.
+type Quantile struct {
+	quantile float64
+	digest   *tdigest.Digest
+}
+
+var _ Function = (*Quantile)(nil)
+
+func newQuantile(q float64) *Quantile {
+	return &Quantile{
+		quantile: q,
+		digest:   tdigest.New(tdigest.DefaultCompression),
+	}
+}
+
+func (q *Quantile) Consume(val *zed.Value) {
+	if val.IsNull() {
+		return
+	}
+	if d, ok := coerce.ToFloat(val); ok {
+		q.digest.Add(d, 1)
+	}
+}
+
+func (q *Quantile) Result(*zed.Context) *zed.Value {
+	if q.digest.Count() == 0 {
+		return zed.NullFloat64
+	}
+	return zed.NewFloat64(q.digest.Quantile(q.quantile))
+}
+
+const (
+	minName     = "min"
+	maxName     = "max"
+	meansName   = "means"
+	weightsName = "weights"
+)
+
+func (q *Quantile) ConsumeAsPartial(partial *zed.Value) {
+	if partial.IsNull() {
+		return
+	}
+	min := partialFloat64(partial, "quantile", minName)
+	max := partialFloat64(partial, "quantile", maxName)
+	means := partialFloat64s(partial, "quantile", meansName)
+	weights := partialFloat64s(partial, "quantile", weightsName)
+	if len(means) != len(weights) {
+		panic(fmt.Errorf("quantile: partial means and weights differ in length: %s", zson.MustFormatValue(partial)))
+	}
+	centroids := make([]tdigest.Centroid, 0, len(means))
+	for k := range means {
+		centroids = append(centroids, tdigest.Centroid{Mean: means[k], Weight: weights[k]})
+	}
+	q.digest.Merge(centroids, min, max)
+}
+
+func partialFloat64s(partial *zed.Value, op, name string) []float64 {
+	val := partial.Deref(name)
+	if val == nil {
+		panic(fmt.Errorf("%s: partial %s is missing", op, name))
+	}
+	if typ, ok := val.Type.(*zed.TypeArray); !ok || typ.Type != zed.TypeFloat64 {
+		panic(fmt.Errorf("%s: partial %s has bad type: %s", op, name, zson.MustFormatValue(val)))
+	}
+	var out []float64
+	for it := val.Iter(); !it.Done(); {
+		out = append(out, zed.DecodeFloat64(it.Next()))
+	}
+	return out
+}
+
+func (q *Quantile) ResultAsPartial(zctx *zed.Context) *zed.Value {
+	centroids := q.digest.Centroids()
+	var b zcode.Builder
+	b.Append(zed.EncodeFloat64(q.digest.Min()))
+	b.Append(zed.EncodeFloat64(q.digest.Max()))
+	b.BeginContainer()
+	for _, c := range centroids {
+		b.Append(zed.EncodeFloat64(c.Mean))
+	}
+	b.EndContainer()
+	b.BeginContainer()
+	for _, c := range centroids {
+		b.Append(zed.EncodeFloat64(c.Weight))
+	}
+	b.EndContainer()
+	arrayType := zctx.LookupTypeArray(zed.TypeFloat64)
+	typ := zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField(minName, zed.TypeFloat64),
+		zed.NewField(maxName, zed.TypeFloat64),
+		zed.NewField(meansName, arrayType),
+		zed.NewField(weightsName, arrayType),
+	})
+	return zed.NewValue(typ, b.Bytes())
+}
@@ -0,0 +1,198 @@
+package agg
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zcode"
+	"github.com/brimdata/zed/zson"
+)
+
+// TopK uses the Space-Saving algorithm of Metwally et al. to estimate the
+// k most frequent values of its input and their counts in bounded memory.
+// It tracks a fixed number of counters, which is larger than k to improve
+// accuracy.  When a value without a counter arrives and all counters are in
+// use, the counter with the smallest count is reassigned to the value and
+// incremented, so a count may overestimate the true count of its value by
+// at most the count of the counter it replaced, which is recorded as the
+// counter's error.
+type TopK struct {
+	k        int
+	capacity int
+	counters map[string]*topkCounter
+	heap     topkHeap
+	scratch  zcode.Bytes
+}
+
+type topkCounter struct {
+	key   string
+	val   zed.Value
+	count uint64
+	err   uint64
+	index int
+}
+
+var _ Function = (*TopK)(nil)
+
+// topkCapacityFactor is the ratio of counters to k.
+const topkCapacityFactor = 10
+
+func newTopK(k int) *TopK {
+	return &TopK{
+		k:        k,
+		capacity: k * topkCapacityFactor,
+		counters: make(map[string]*topkCounter),
+	}
+}
+
+func (t *TopK) Consume(val *zed.Value) {
+	if !val.IsNull() {
+		t.update(val.Type, val.Bytes, 1, 0)
+	}
+}
+
+func (t *TopK) update(typ zed.Type, b zcode.Bytes, count, err uint64) {
+	t.scratch = zed.AppendInt(t.scratch[:0], int64(typ.ID()))
+	t.scratch = append(t.scratch, b...)
+	if c, ok := t.counters[string(t.scratch)]; ok {
+		c.count += count
+		c.err += err
+		heap.Fix(&t.heap, c.index)
+		return
+	}
+	if len(t.heap) < t.capacity {
+		c := &topkCounter{
+			key:   string(t.scratch),
+			val:   *zed.NewValue(typ, b).Copy(),
+			count: count,
+			err:   err,
+		}
+		t.counters[c.key] = c
+		heap.Push(&t.heap, c)
+		return
+	}
+	// Replace the value of the counter with the smallest count.
+	c := t.heap[0]
+	delete(t.counters, c.key)
+	c.key = string(t.scratch)
+	c.val = *zed.NewValue(typ, b).Copy()
+	c.err = c.count + err
+	c.count += count
+	t.counters[c.key] = c
+	heap.Fix(&t.heap, 0)
+}
+
+// sorted returns the counters in order of decreasing count.  Ties are
+// broken by key so results are deterministic.
+func (t *TopK) sorted() []*topkCounter {
+	counters := make([]*topkCounter, len(t.heap))
+	copy(counters, t.heap)
+	sort.Slice(counters, func(i, j int) bool {
+		if counters[i].count != counters[j].count {
+			return counters[i].count > counters[j].count
+		}
+		return bytes.Compare([]byte(counters[i].key), []byte(counters[j].key)) < 0
+	})
+	return counters
+}
+
+func (t *TopK) Result(zctx *zed.Context) *zed.Value {
+	counters := t.sorted()
+	if len(counters) > t.k {
+		counters = counters[:t.k]
+	}
+	return t.build(zctx, counters, false)
+}
+
+const errName = "error"
+
+func (t *TopK) build(zctx *zed.Context, counters []*topkCounter, partial bool) *zed.Value {
+	if len(counters) == 0 {
+		return zed.Null
+	}
+	vals := make([]zed.Value, 0, len(counters))
+	for _, c := range counters {
+		vals = append(vals, c.val)
+	}
+	inner := innerType(zctx, vals)
+	union, _ := inner.(*zed.TypeUnion)
+	fields := []zed.Field{
+		zed.NewField("value", inner),
+		zed.NewField(countName, zed.TypeUint64),
+	}
+	if partial {
+		fields = append(fields, zed.NewField(errName, zed.TypeUint64))
+	}
+	var b zcode.Builder
+	for _, c := range counters {
+		b.BeginContainer()
+		if union != nil {
+			zed.BuildUnion(&b, union.TagOf(c.val.Type), c.val.Bytes)
+		} else {
+			b.Append(c.val.Bytes)
+		}
+		b.Append(zed.EncodeUint(c.count))
+		if partial {
+			b.Append(zed.EncodeUint(c.err))
+		}
+		b.EndContainer()
+	}
+	typ := zctx.LookupTypeArray(zctx.MustLookupTypeRecord(fields))
+	return zed.NewValue(typ, b.Bytes())
+}
+
+func (t *TopK) ConsumeAsPartial(partial *zed.Value) {
+	if partial.IsNull() {
+		return
+	}
+	arrayType, ok := partial.Type.(*zed.TypeArray)
+	if !ok {
+		panic(fmt.Errorf("topk: partial not an array type: %s", zson.MustFormatValue(partial)))
+	}
+	elem := zed.Value{Type: arrayType.Type}
+	for it := partial.Iter(); !it.Done(); {
+		elem.Bytes = it.Next()
+		val := elem.Deref("value")
+		if val == nil {
+			panic(fmt.Errorf("topk: partial value is missing: %s", zson.MustFormatValue(partial)))
+		}
+		typ, b := val.Type, val.Bytes
+		if union, ok := zed.TypeUnder(typ).(*zed.TypeUnion); ok {
+			typ, b = union.Untag(b)
+		}
+		t.update(typ, b, partialUint64(&elem, "topk", countName), partialUint64(&elem, "topk", errName))
+	}
+}
+
+func (t *TopK) ResultAsPartial(zctx *zed.Context) *zed.Value {
+	return t.build(zctx, t.sorted(), true)
+}
+
+// topkHeap is a min-heap of counters ordered by count.
+type topkHeap []*topkCounter
+
+func (h topkHeap) Len() int           { return len(h) }
+func (h topkHeap) Less(i, j int) bool { return h[i].count < h[j].count }
+
+func (h topkHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *topkHeap) Push(x interface{}) {
+	c := x.(*topkCounter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *topkHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	c := old[n-1]
+	*h = old[:n-1]
+	return c
+}
@@ -0,0 +1,208 @@
+package agg
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/runtime/expr/coerce"
+	"github.com/brimdata/zed/zcode"
+	"github.com/brimdata/zed/zson"
+)
+
+// Variance computes the sample variance or, if stddev is true, the sample
+// standard deviation of its input.  The count, mean, and sum of squared
+// differences from the mean are updated with the pairwise algorithm of
+// Chan et al., which also merges partial results.
+type Variance struct {
+	stddev bool
+	count  uint64
+	mean   float64
+	m2     float64
+}
+
+var _ Function = (*Variance)(nil)
+
+func (v *Variance) Consume(val *zed.Value) {
+	if val.IsNull() {
+		return
+	}
+	if x, ok := coerce.ToFloat(val); ok {
+		v.merge(1, x, 0)
+	}
+}
+
+func (v *Variance) merge(count uint64, mean, m2 float64) {
+	if count == 0 {
+		return
+	}
+	total := v.count + count
+	delta := mean - v.mean
+	v.mean += delta * float64(count) / float64(total)
+	v.m2 += m2 + delta*delta*float64(v.count)*float64(count)/float64(total)
+	v.count = total
+}
+
+func (v *Variance) Result(*zed.Context) *zed.Value {
+	if v.count < 2 {
+		return zed.NullFloat64
+	}
+	variance := v.m2 / float64(v.count-1)
+	if v.stddev {
+		return zed.NewFloat64(math.Sqrt(variance))
+	}
+	return zed.NewFloat64(variance)
+}
+
+const (
+	meanName = "mean"
+	m2Name   = "m2"
+)
+
+func (v *Variance) ConsumeAsPartial(partial *zed.Value) {
+	if partial.IsNull() {
+		return
+	}
+	count := partialUint64(partial, "var", countName)
+	mean := partialFloat64(partial, "var", meanName)
+	m2 := partialFloat64(partial, "var", m2Name)
+	v.merge(count, mean, m2)
+}
+
+func (v *Variance) ResultAsPartial(zctx *zed.Context) *zed.Value {
+	var zv zcode.Bytes
+	zv = zed.NewUint64(v.count).Encode(zv)
+	zv = zed.NewFloat64(v.mean).Encode(zv)
+	zv = zed.NewFloat64(v.m2).Encode(zv)
+	typ := zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField(countName, zed.TypeUint64),
+		zed.NewField(meanName, zed.TypeFloat64),
+		zed.NewField(m2Name, zed.TypeFloat64),
+	})
+	return zed.NewValue(typ, zv)
+}
+
+// Covariance computes the sample covariance or, if corr is true, the
+// Pearson correlation coefficient of pairs of values, which are consumed as
+// records with fields x and y.  As with Variance, partial results are
+// merged with the pairwise algorithm of Chan et al.
+type Covariance struct {
+	corr  bool
+	count uint64
+	meanX float64
+	meanY float64
+	m2X   float64
+	m2Y   float64
+	cXY   float64
+}
+
+var _ Function = (*Covariance)(nil)
+
+func (c *Covariance) Consume(val *zed.Value) {
+	x, ok := toFloat(val.Deref("x"))
+	if !ok {
+		return
+	}
+	y, ok := toFloat(val.Deref("y"))
+	if !ok {
+		return
+	}
+	c.merge(1, x, y, 0, 0, 0)
+}
+
+func toFloat(val *zed.Value) (float64, bool) {
+	if val == nil || val.IsNull() {
+		return 0, false
+	}
+	return coerce.ToFloat(val)
+}
+
+func (c *Covariance) merge(count uint64, meanX, meanY, m2X, m2Y, cXY float64) {
+	if count == 0 {
+		return
+	}
+	total := c.count + count
+	dx := meanX - c.meanX
+	dy := meanY - c.meanY
+	weight := float64(c.count) * float64(count) / float64(total)
+	c.meanX += dx * float64(count) / float64(total)
+	c.meanY += dy * float64(count) / float64(total)
+	c.m2X += m2X + dx*dx*weight
+	c.m2Y += m2Y + dy*dy*weight
+	c.cXY += cXY + dx*dy*weight
+	c.count = total
+}
+
+func (c *Covariance) Result(*zed.Context) *zed.Value {
+	if c.count < 2 {
+		return zed.NullFloat64
+	}
+	if !c.corr {
+		return zed.NewFloat64(c.cXY / float64(c.count-1))
+	}
+	d := math.Sqrt(c.m2X * c.m2Y)
+	if d == 0 {
+		// The correlation is undefined if either input is constant.
+		return zed.NullFloat64
+	}
+	return zed.NewFloat64(c.cXY / d)
+}
+
+const (
+	meanXName = "mean_x"
+	meanYName = "mean_y"
+	m2XName   = "m2_x"
+	m2YName   = "m2_y"
+	cXYName   = "c_xy"
+)
+
+func (c *Covariance) ConsumeAsPartial(partial *zed.Value) {
+	if partial.IsNull() {
+		return
+	}
+	c.merge(partialUint64(partial, "covar", countName),
+		partialFloat64(partial, "covar", meanXName),
+		partialFloat64(partial, "covar", meanYName),
+		partialFloat64(partial, "covar", m2XName),
+		partialFloat64(partial, "covar", m2YName),
+		partialFloat64(partial, "covar", cXYName))
+}
+
+func (c *Covariance) ResultAsPartial(zctx *zed.Context) *zed.Value {
+	var zv zcode.Bytes
+	zv = zed.NewUint64(c.count).Encode(zv)
+	for _, f := range []float64{c.meanX, c.meanY, c.m2X, c.m2Y, c.cXY} {
+		zv = zed.NewFloat64(f).Encode(zv)
+	}
+	typ := zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField(countName, zed.TypeUint64),
+		zed.NewField(meanXName, zed.TypeFloat64),
+		zed.NewField(meanYName, zed.TypeFloat64),
+		zed.NewField(m2XName, zed.TypeFloat64),
+		zed.NewField(m2YName, zed.TypeFloat64),
+		zed.NewField(cXYName, zed.TypeFloat64),
+	})
+	return zed.NewValue(typ, zv)
+}
+
+func partialUint64(partial *zed.Value, op, name string) uint64 {
+	val := partial.Deref(name)
+	if val == nil {
+		panic(fmt.Errorf("%s: partial %s is missing", op, name))
+	}
+	if val.Type != zed.TypeUint64 {
+		panic(fmt.Errorf("%s: partial %s has bad type: %s", op, name, zson.MustFormatValue(val)))
+	}
+	return zed.DecodeUint(val.Bytes)
+}
+
+func partialFloat64(partial *zed.Value, op, name string) float64 {
+	val := partial.Deref(name)
+	if val == nil {
+		panic(fmt.Errorf("%s: partial %s is missing", op, name))
+	}
+	if val.Type != zed.TypeFloat64 {
+		panic(fmt.Errorf("%s: partial %s has bad type: %s", op, name, zson.MustFormatValue(val)))
+	}
+	return zed.DecodeFloat64(val.Bytes)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"math/big"
 	"net/netip"
 	"regexp"
 	"regexp/syntax"
@@ -11,7 +12,10 @@ import (
 	//XXX this shouldn't be reaching into the AST but we'll leave it for
 	// now until we factor-in the flow-based package
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/bigfloat"
 	"github.com/brimdata/zed/pkg/byteconv"
+	"github.com/brimdata/zed/pkg/decimal"
+	"github.com/brimdata/zed/runtime/expr/coerce"
 	"github.com/brimdata/zed/zcode"
 )
 
@@ -89,6 +93,14 @@ func CompareInt64(op string, pattern int64) (Boolean, error) {
 			}
 		case zed.IDFloat16, zed.IDFloat32, zed.IDFloat64:
 			return CompareFloat(zed.DecodeFloat(zv), float64(pattern))
+		case zed.IDDecimal32, zed.IDDecimal64, zed.IDDecimal128, zed.IDDecimal256:
+			return compareDecimals(CompareFloat, zed.DecodeDecimal(zv), decimal.New(pattern, 0))
+		case zed.IDInt128, zed.IDInt256:
+			return CompareFloat(float64(zed.DecodeBigInt(zv).Cmp(big.NewInt(pattern))), 0)
+		case zed.IDUint128, zed.IDUint256:
+			return CompareFloat(float64(zed.DecodeBigUint(zv).Cmp(big.NewInt(pattern))), 0)
+		case zed.IDFloat128, zed.IDFloat256:
+			return compareBigFloats(CompareFloat, zed.DecodeBigFloat(zv), new(big.Float).SetInt64(pattern))
 		case zed.IDTime:
 			return CompareInt(int64(zed.DecodeTime(zv)), pattern)
 		case zed.IDDuration:
@@ -181,11 +193,56 @@ func CompareFloat64(op string, pattern float64) (Boolean, error) {
 			return compare(float64(zed.DecodeTime(zv)), pattern)
 		case zed.IDDuration:
 			return compare(float64(zed.DecodeDuration(zv)), pattern)
+		case zed.IDDecimal32, zed.IDDecimal64, zed.IDDecimal128, zed.IDDecimal256:
+			return compareDecimals(compare, zed.DecodeDecimal(zv), decimal.NewFromFloat(pattern, 64))
+		case zed.IDInt128, zed.IDInt256:
+			x := new(big.Float).SetInt(zed.DecodeBigInt(zv))
+			return compareBigFloats(compare, x, bigfloat.Binary256.FromFloat64(pattern))
+		case zed.IDUint128, zed.IDUint256:
+			x := new(big.Float).SetInt(zed.DecodeBigUint(zv))
+			return compareBigFloats(compare, x, bigfloat.Binary256.FromFloat64(pattern))
+		case zed.IDFloat128, zed.IDFloat256:
+			return compareBigFloats(compare, zed.DecodeBigFloat(zv), bigfloat.Binary256.FromFloat64(pattern))
 		}
 		return false
 	}, nil
 }
 
+// CompareDecimal returns a Predicate that compares numbers with a decimal
+// using a comparison based on op.  A number of another type is converted to
+// a decimal to compare it.
+func CompareDecimal(op string, pattern *decimal.Decimal) (Boolean, error) {
+	compare, ok := compareFloat[op]
+	if !ok {
+		return nil, fmt.Errorf("unknown decimal comparator: %s", op)
+	}
+	return func(val *zed.Value) bool {
+		if !zed.IsNumber(val.Type.ID()) {
+			return false
+		}
+		d, ok := coerce.ToDecimal(val)
+		return ok && compareDecimals(compare, d, pattern)
+	}, nil
+}
+
+// compareDecimals compares a with b using compare.  As with floats, a NaN
+// is unordered, so only != is true when either is a NaN.
+func compareDecimals(compare func(float64, float64) bool, a, b *decimal.Decimal) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return compare(math.NaN(), 0)
+	}
+	return compare(float64(decimal.Cmp(a, b)), 0)
+}
+
+// compareBigFloats compares a with b using compare, where a nil value is a
+// NaN.
+func compareBigFloats(compare func(float64, float64) bool, a, b *big.Float) bool {
+	if a == nil || b == nil {
+		return compare(math.NaN(), 0)
+	}
+	return compare(float64(a.Cmp(b)), 0)
+}
+
 var compareString = map[string]func(string, string) bool{
 	"==": func(a, b string) bool { return a == b },
 	"!=": func(a, b string) bool { return a != b },
@@ -308,6 +365,8 @@ func Comparison(op string, val *zed.Value) (Boolean, error) {
 		return CompareInt64(op, zed.DecodeInt(val.Bytes))
 	case *zed.TypeOfTime, *zed.TypeOfDuration:
 		return CompareTime(op, zed.DecodeInt(val.Bytes))
+	case *zed.TypeOfDecimal32, *zed.TypeOfDecimal64, *zed.TypeOfDecimal128, *zed.TypeOfDecimal256:
+		return CompareDecimal(op, zed.DecodeDecimal(val.Bytes))
 	default:
 		return nil, fmt.Errorf("literal comparison of type %q unsupported", val.Type)
 	}
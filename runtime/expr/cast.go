@@ -1,7 +1,6 @@
 package expr
 
 import (
-	"fmt"
 	"math"
 	"net/netip"
 	"unicode/utf8"
@@ -9,6 +8,7 @@ import (
 	"github.com/araddon/dateparse"
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/pkg/byteconv"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/runtime/expr/coerce"
 	"github.com/brimdata/zed/zson"
@@ -34,12 +34,18 @@ func LookupPrimitiveCaster(zctx *zed.Context, typ zed.Type) Evaluator {
 		return &casterUintN{zctx, zed.TypeUint32, math.MaxUint32}
 	case zed.TypeUint64:
 		return &casterUintN{zctx, zed.TypeUint64, 0}
+	case zed.TypeInt128, zed.TypeInt256, zed.TypeUint128, zed.TypeUint256:
+		return &casterBigInt{zctx, typ}
 	case zed.TypeFloat16:
 		return &casterFloat16{zctx}
 	case zed.TypeFloat32:
 		return &casterFloat32{zctx}
 	case zed.TypeFloat64:
 		return &casterFloat64{zctx}
+	case zed.TypeFloat128, zed.TypeFloat256:
+		return &casterBigFloat{zctx, typ}
+	case zed.TypeDecimal32, zed.TypeDecimal64, zed.TypeDecimal128, zed.TypeDecimal256:
+		return &casterDecimal{zctx, typ}
 	case zed.TypeIP:
 		return &casterIP{zctx}
 	case zed.TypeNet:
@@ -88,6 +94,23 @@ func (c *casterUintN) Eval(ectx Context, val *zed.Value) *zed.Value {
 	return ectx.NewValue(c.typ, zed.EncodeUint(v))
 }
 
+type casterBigInt struct {
+	zctx *zed.Context
+	typ  zed.Type
+}
+
+func (c *casterBigInt) Eval(ectx Context, val *zed.Value) *zed.Value {
+	v, ok := coerce.ToBigInt(val)
+	if !ok || !zed.IntInRange(c.typ.ID(), v) {
+		return ectx.CopyValue(c.zctx.NewErrorf(
+			"cannot cast %s to type %s", zson.MustFormatValue(val), zson.FormatType(c.typ)))
+	}
+	if zed.IsSigned(c.typ.ID()) {
+		return ectx.NewValue(c.typ, zed.EncodeBigInt(v))
+	}
+	return ectx.NewValue(c.typ, zed.EncodeBigUint(v))
+}
+
 type casterBool struct {
 	zctx *zed.Context
 }
@@ -136,6 +159,41 @@ func (c *casterFloat64) Eval(ectx Context, val *zed.Value) *zed.Value {
 	return ectx.NewValue(zed.TypeFloat64, zed.EncodeFloat64(f))
 }
 
+type casterBigFloat struct {
+	zctx *zed.Context
+	typ  zed.Type
+}
+
+func (c *casterBigFloat) Eval(ectx Context, val *zed.Value) *zed.Value {
+	id := c.typ.ID()
+	f, ok := coerce.ToBigFloat(val, zed.BigFloatFormat(id))
+	if !ok {
+		return ectx.CopyValue(c.zctx.NewErrorf(
+			"cannot cast %s to type %s", zson.MustFormatValue(val), zson.FormatType(c.typ)))
+	}
+	return ectx.NewValue(c.typ, zed.EncodeBigFloat(id, f))
+}
+
+type casterDecimal struct {
+	zctx *zed.Context
+	typ  zed.Type
+}
+
+func (c *casterDecimal) Eval(ectx Context, val *zed.Value) *zed.Value {
+	d, ok := coerce.ToDecimal(val)
+	if ok && d.Form == decimal.Finite {
+		// Rounding to the precision of the type is allowed, but
+		// overflow to infinity is not.
+		d = zed.DecimalFormat(c.typ.ID()).Round(d)
+		ok = d.Form == decimal.Finite
+	}
+	if !ok {
+		return ectx.CopyValue(c.zctx.NewErrorf(
+			"cannot cast %s to type %s", zson.MustFormatValue(val), zson.FormatType(c.typ)))
+	}
+	return ectx.NewValue(c.typ, zed.EncodeDecimal(c.typ.ID(), d))
+}
+
 type casterIP struct {
 	zctx *zed.Context
 }
@@ -233,7 +291,7 @@ func (c *casterTime) Eval(ectx Context, val *zed.Value) *zed.Value {
 		//XXX we call coerce on integers here to avoid unsigned/signed decode
 		v, ok := coerce.ToInt(val)
 		if !ok {
-			panic(fmt.Sprintf("coerce %s to int failed", zson.MustFormatValue(val)))
+			return ectx.CopyValue(c.zctx.NewErrorf("cannot cast %s to type time", zson.MustFormatValue(val)))
 		}
 		ts = nano.Ts(v)
 	default:
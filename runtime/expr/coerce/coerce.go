@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"errors"
 	"math"
+	"math/big"
 	"strconv"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/bigfloat"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/runtime/expr/result"
 	"github.com/brimdata/zed/zcode"
@@ -33,6 +36,10 @@ type Pair struct {
 	// at buf and let go of the other input pointer.
 	result.Buffer
 	buf2 result.Buffer
+	// decimal is true if A and B were coerced to a decimal type, whose
+	// encodings must be decoded to compare them since equal values
+	// may differ in scale.
+	decimal bool
 }
 
 func (c *Pair) Equal() bool {
@@ -45,10 +52,19 @@ func (c *Pair) Equal() bool {
 	if c.B == nil {
 		return c.A == nil
 	}
+	if c.decimal {
+		return decimal.Cmp(zed.DecodeDecimal(c.A), zed.DecodeDecimal(c.B)) == 0
+	}
 	return bytes.Equal(c.A, c.B)
 }
 
 func (c *Pair) Coerce(a, b *zed.Value) (int, error) {
+	id, err := c.coerce(a, b)
+	c.decimal = err == nil && zed.IsDecimal(id)
+	return id, err
+}
+
+func (c *Pair) coerce(a, b *zed.Value) (int, error) {
 	c.A = a.Bytes
 	c.B = b.Bytes
 	if a.Type == nil {
@@ -89,6 +105,10 @@ func (c *Pair) compare(lhs, rhs *zed.Value) (bool, error) {
 }
 
 func intToFloat(id int, b zcode.Bytes) float64 {
+	if zed.IsBigInt(id) {
+		f, _ := new(big.Float).SetInt(toBigInt(id, b)).Float64()
+		return f
+	}
 	if zed.IsSigned(id) {
 		return float64(zed.DecodeInt(b))
 	}
@@ -112,6 +132,12 @@ func (c *Pair) promoteToUnsigned(in zcode.Bytes) (zcode.Bytes, bool) {
 }
 
 func (c *Pair) coerceNumbers(aid, bid int) (int, bool) {
+	if zed.IsDecimal(aid) || zed.IsDecimal(bid) {
+		return c.coerceDecimals(aid, bid)
+	}
+	if zed.IsBigFloat(aid) || zed.IsBigFloat(bid) {
+		return c.coerceBigFloats(aid, bid)
+	}
 	if zed.IsFloat(aid) {
 		if aid == zed.IDFloat16 {
 			c.A = c.buf2.Float64(float64(zed.DecodeFloat16(c.A)))
@@ -142,28 +168,96 @@ func (c *Pair) coerceNumbers(aid, bid int) (int, bool) {
 		}
 		return id, true
 	}
+	if zed.IsBigInt(aid) || zed.IsBigInt(bid) {
+		return c.coerceBigInts(aid, bid)
+	}
 	id := promoteInt(aid, bid)
 
 	// Otherwise, we'll promote mixed signed-ness to signed unless
 	// the unsigned value is greater than signed maxint, in which
 	// case, we report an overflow error.
+	if aIsSigned {
+		if b, ok := c.promoteToSigned(c.B); ok {
+			c.B = b
+			return id, true
+		}
+	} else if a, ok := c.promoteToSigned(c.A); ok {
+		c.A = a
+		return id, true
+	}
+	// We got overflow trying to turn the unsigned to signed,
+	// so try turning the signed into unsigned.  The unsigned
+	// value is left as is.
 	var ok bool
 	if aIsSigned {
-		c.B, ok = c.promoteToSigned(c.B)
+		c.A, ok = c.promoteToUnsigned(c.A)
 	} else {
-		c.A, ok = c.promoteToSigned(c.A)
+		c.B, ok = c.promoteToUnsigned(c.B)
 	}
-	if !ok {
-		// We got overflow trying to turn the unsigned to signed,
-		// so try turning the signed into unsigned.
-		if aIsSigned {
-			c.A, ok = c.promoteToUnsigned(c.A)
-		} else {
-			c.B, ok = c.promoteToUnsigned(c.B)
+	return zed.IDUint64, ok
+}
+
+// coerceDecimals converts A and B to the wider decimal type of aid and bid.
+// An integer or float is converted to a decimal with the same value, though
+// it may be rounded to the precision of the decimal type.
+func (c *Pair) coerceDecimals(aid, bid int) (int, bool) {
+	id := aid
+	if !zed.IsDecimal(aid) || zed.IsDecimal(bid) && bid > aid {
+		id = bid
+	}
+	if aid != id {
+		d, ok := toDecimal(aid, c.A)
+		if !ok {
+			return 0, false
+		}
+		c.A = c.buf2.Decimal(id, d)
+	}
+	if bid != id {
+		d, ok := toDecimal(bid, c.B)
+		if !ok {
+			return 0, false
 		}
-		id = zed.IDUint64
+		c.B = c.Decimal(id, d)
 	}
-	return id, ok
+	return id, true
+}
+
+// coerceBigFloats converts A and B to the wider float128 or float256 type of
+// aid and bid.
+func (c *Pair) coerceBigFloats(aid, bid int) (int, bool) {
+	id := aid
+	if !zed.IsBigFloat(aid) || zed.IsBigFloat(bid) && bid > aid {
+		id = bid
+	}
+	f := zed.BigFloatFormat(id)
+	if aid != id {
+		c.A = c.buf2.BigFloat(id, toBigFloat(f, aid, c.A))
+	}
+	if bid != id {
+		c.B = c.BigFloat(id, toBigFloat(f, bid, c.B))
+	}
+	return id, true
+}
+
+// coerceBigInts converts A and B, which have mixed signed-ness and at least
+// one of which is a 128- or 256-bit integer, to the wider signed type of
+// aid and bid or, if a value is out of range for it, to the unsigned type
+// of the same width.
+func (c *Pair) coerceBigInts(aid, bid int) (int, bool) {
+	a, b := toBigInt(aid, c.A), toBigInt(bid, c.B)
+	id := promoteInt(aid, bid)
+	if !zed.IntInRange(id, a) || !zed.IntInRange(id, b) {
+		id -= zed.IDInt8 - zed.IDUint8
+		if !zed.IntInRange(id, a) || !zed.IntInRange(id, b) {
+			return 0, false
+		}
+		c.A = c.buf2.BigUint(a)
+		c.B = c.BigUint(b)
+		return id, true
+	}
+	c.A = c.buf2.BigInt(a)
+	c.B = c.BigInt(b)
+	return id, true
 }
 
 func ToFloat(val *zed.Value) (float64, bool) {
@@ -171,12 +265,11 @@ func ToFloat(val *zed.Value) (float64, bool) {
 	if zed.IsFloat(id) {
 		return zed.DecodeFloat(val.Bytes), true
 	}
+	if zed.IsDecimal(id) {
+		return zed.DecodeDecimal(val.Bytes).Float64(), true
+	}
 	if zed.IsInteger(id) {
-		if zed.IsSigned(id) {
-			return float64(zed.DecodeInt(val.Bytes)), true
-		} else {
-			return float64(zed.DecodeUint(val.Bytes)), true
-		}
+		return intToFloat(id, val.Bytes), true
 	}
 	if id == zed.IDDuration {
 		return float64(zed.DecodeInt(val.Bytes)), true
@@ -196,6 +289,13 @@ func ToUint(val *zed.Value) (uint64, bool) {
 	if zed.IsFloat(id) {
 		return uint64(zed.DecodeFloat(val.Bytes)), true
 	}
+	if zed.IsDecimal(id) {
+		return zed.DecodeDecimal(val.Bytes).Uint64()
+	}
+	if zed.IsBigInt(id) {
+		v := toBigInt(id, val.Bytes)
+		return v.Uint64(), v.IsUint64()
+	}
 	if zed.IsInteger(id) {
 		if zed.IsSigned(id) {
 			v := zed.DecodeInt(val.Bytes)
@@ -225,6 +325,13 @@ func ToInt(val *zed.Value) (int64, bool) {
 	if zed.IsFloat(id) {
 		return int64(zed.DecodeFloat(val.Bytes)), true
 	}
+	if zed.IsDecimal(id) {
+		return zed.DecodeDecimal(val.Bytes).Int64()
+	}
+	if zed.IsBigInt(id) {
+		v := toBigInt(id, val.Bytes)
+		return v.Int64(), v.IsInt64()
+	}
 	if zed.IsInteger(id) {
 		if zed.IsSigned(id) {
 			// XXX check if negative? should -1:uint64 be maxint64 or an error?
@@ -246,6 +353,96 @@ func ToInt(val *zed.Value) (int64, bool) {
 	return 0, false
 }
 
+// ToDecimal converts a number, duration, time, or string to a decimal.  A
+// float is converted to the decimal with the fewest digits that converts
+// back to it, so float64(0.1) becomes 0.1 rather than its exact binary value.
+func ToDecimal(val *zed.Value) (*decimal.Decimal, bool) {
+	return toDecimal(val.Type.ID(), val.Bytes)
+}
+
+func toDecimal(id int, b zcode.Bytes) (*decimal.Decimal, bool) {
+	switch {
+	case zed.IsDecimal(id):
+		return zed.DecodeDecimal(b), true
+	case id == zed.IDFloat64:
+		return decimal.NewFromFloat(zed.DecodeFloat64(b), 64), true
+	case zed.IsBigFloat(id):
+		d, err := decimal.Parse(bigfloat.String(zed.DecodeBigFloat(b)))
+		return d, err == nil
+	case zed.IsFloat(id):
+		return decimal.NewFromFloat(zed.DecodeFloat(b), 32), true
+	case zed.IsInteger(id), zed.IsSigned(id):
+		return decimal.NewFromBigInt(toBigInt(id, b), 0), true
+	case id == zed.IDString:
+		d, err := decimal.Parse(string(b))
+		return d, err == nil
+	}
+	return nil, false
+}
+
+// ToBigInt converts a number, duration, time, or string to a big.Int.  A
+// float or decimal is truncated to an integer.
+func ToBigInt(val *zed.Value) (*big.Int, bool) {
+	id := val.Type.ID()
+	switch {
+	case zed.IsBigFloat(id):
+		x := zed.DecodeBigFloat(val.Bytes)
+		if x == nil || x.IsInf() {
+			return nil, false
+		}
+		v, _ := x.Int(nil)
+		return v, true
+	case zed.IsFloat(id):
+		f := zed.DecodeFloat(val.Bytes)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, false
+		}
+		v, _ := big.NewFloat(f).Int(nil)
+		return v, true
+	case zed.IsDecimal(id):
+		return zed.DecodeDecimal(val.Bytes).Int()
+	case zed.IsInteger(id), id == zed.IDDuration, id == zed.IDTime:
+		return toBigInt(id, val.Bytes), true
+	case id == zed.IDString:
+		return new(big.Int).SetString(string(val.Bytes), 10)
+	}
+	return nil, false
+}
+
+func toBigInt(id int, b zcode.Bytes) *big.Int {
+	if zed.IsSigned(id) {
+		return zed.DecodeBigInt(b)
+	}
+	return zed.DecodeBigUint(b)
+}
+
+// ToBigFloat converts a number, duration, time, or string to a value of the
+// float format f.  A nil result stands for NaN.
+func ToBigFloat(val *zed.Value, f *bigfloat.Format) (*big.Float, bool) {
+	id := val.Type.ID()
+	switch {
+	case zed.IsNumber(id), id == zed.IDDuration, id == zed.IDTime:
+		return toBigFloat(f, id, val.Bytes), true
+	case id == zed.IDString:
+		x, err := f.Parse(string(val.Bytes))
+		return x, err == nil
+	}
+	return nil, false
+}
+
+func toBigFloat(f *bigfloat.Format, id int, b zcode.Bytes) *big.Float {
+	switch {
+	case zed.IsFloat(id):
+		return f.Round(zed.DecodeBigFloat(b))
+	case zed.IsDecimal(id):
+		// The decimal string is exact, so parsing it rounds
+		// correctly.
+		x, _ := f.Parse(zed.DecodeDecimal(b).String())
+		return x
+	}
+	return f.FromInt(toBigInt(id, b))
+}
+
 func ToBool(val *zed.Value) (bool, bool) {
 	if val.IsString() {
 		v, err := strconv.ParseBool(string(val.Bytes))
@@ -260,6 +457,10 @@ func ToTime(val *zed.Value) (nano.Ts, bool) {
 	if id == zed.IDTime {
 		return zed.DecodeTime(val.Bytes), true
 	}
+	if zed.IsBigInt(id) {
+		v, ok := ToInt(val)
+		return nano.Ts(v), ok
+	}
 	if zed.IsSigned(id) {
 		return nano.Ts(zed.DecodeInt(val.Bytes)), true
 	}
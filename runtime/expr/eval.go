@@ -1,13 +1,14 @@
 package expr
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"regexp"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/runtime/expr/coerce"
 	"github.com/brimdata/zed/zcode"
@@ -263,6 +264,30 @@ func (n *numeric) floats() (float64, float64) {
 	return zed.DecodeFloat(n.vals.A), zed.DecodeFloat(n.vals.B)
 }
 
+func (n *numeric) decimals() (*decimal.Decimal, *decimal.Decimal) {
+	return zed.DecodeDecimal(n.vals.A), zed.DecodeDecimal(n.vals.B)
+}
+
+func (n *numeric) bigFloats() (*big.Float, *big.Float) {
+	return zed.DecodeBigFloat(n.vals.A), zed.DecodeBigFloat(n.vals.B)
+}
+
+func (n *numeric) bigInts(id int) (*big.Int, *big.Int) {
+	if zed.IsSigned(id) {
+		return zed.DecodeBigInt(n.vals.A), zed.DecodeBigInt(n.vals.B)
+	}
+	return zed.DecodeBigUint(n.vals.A), zed.DecodeBigUint(n.vals.B)
+}
+
+// encodeBigInt encodes x as a value of the 128- or 256-bit integer type id,
+// wrapping around on overflow as 64-bit integer arithmetic does.
+func encodeBigInt(id int, x *big.Int) zcode.Bytes {
+	if zed.IsSigned(id) {
+		return zed.EncodeBigInt(zed.WrapInt(id, x))
+	}
+	return zed.EncodeBigUint(zed.WrapInt(id, x))
+}
+
 func (n *numeric) ints() (int64, int64) {
 	return zed.DecodeInt(n.vals.A), zed.DecodeInt(n.vals.B)
 }
@@ -332,6 +357,21 @@ func (c *Compare) Eval(ectx Context, this *zed.Value) *zed.Value {
 		switch {
 		case c.vals.A == nil || c.vals.B == nil:
 			return zed.False
+		case zed.IsDecimal(id):
+			v1, v2 := c.decimals()
+			if v1.IsNaN() || v2.IsNaN() {
+				return zed.False
+			}
+			result = decimal.Cmp(v1, v2)
+		case zed.IsBigFloat(id):
+			v1, v2 := c.bigFloats()
+			if v1 == nil || v2 == nil {
+				return zed.False
+			}
+			result = v1.Cmp(v2)
+		case zed.IsBigInt(id):
+			v1, v2 := c.bigInts(id)
+			result = v1.Cmp(v2)
 		case zed.IsFloat(id):
 			v1, v2 := c.floats()
 			if v1 < v2 {
@@ -428,6 +468,15 @@ func (a *Add) Eval(ectx Context, this *zed.Value) *zed.Value {
 		return a.zctx.NewError(err)
 	}
 	switch {
+	case zed.IsDecimal(id):
+		v1, v2 := a.operands.decimals()
+		return ectx.NewValue(typ, zed.EncodeDecimal(id, zed.DecimalFormat(id).Add(v1, v2)))
+	case zed.IsBigFloat(id):
+		v1, v2 := a.operands.bigFloats()
+		return ectx.NewValue(typ, zed.EncodeBigFloat(id, zed.BigFloatFormat(id).Add(v1, v2)))
+	case zed.IsBigInt(id):
+		v1, v2 := a.operands.bigInts(id)
+		return ectx.NewValue(typ, encodeBigInt(id, v1.Add(v1, v2)))
 	case zed.IsFloat(id):
 		v1, v2 := a.operands.floats()
 		return ectx.NewValue(typ, zed.EncodeFloat64(v1+v2))
@@ -458,6 +507,15 @@ func (s *Subtract) Eval(ectx Context, this *zed.Value) *zed.Value {
 		return s.zctx.NewError(err)
 	}
 	switch {
+	case zed.IsDecimal(id):
+		v1, v2 := s.operands.decimals()
+		return ectx.NewValue(typ, zed.EncodeDecimal(id, zed.DecimalFormat(id).Sub(v1, v2)))
+	case zed.IsBigFloat(id):
+		v1, v2 := s.operands.bigFloats()
+		return ectx.NewValue(typ, zed.EncodeBigFloat(id, zed.BigFloatFormat(id).Sub(v1, v2)))
+	case zed.IsBigInt(id):
+		v1, v2 := s.operands.bigInts(id)
+		return ectx.NewValue(typ, encodeBigInt(id, v1.Sub(v1, v2)))
 	case zed.IsFloat(id):
 		v1, v2 := s.operands.floats()
 		return ectx.NewValue(typ, zed.EncodeFloat64(v1-v2))
@@ -488,6 +546,15 @@ func (m *Multiply) Eval(ectx Context, this *zed.Value) *zed.Value {
 		return m.zctx.NewError(err)
 	}
 	switch {
+	case zed.IsDecimal(id):
+		v1, v2 := m.operands.decimals()
+		return ectx.NewValue(typ, zed.EncodeDecimal(id, zed.DecimalFormat(id).Mul(v1, v2)))
+	case zed.IsBigFloat(id):
+		v1, v2 := m.operands.bigFloats()
+		return ectx.NewValue(typ, zed.EncodeBigFloat(id, zed.BigFloatFormat(id).Mul(v1, v2)))
+	case zed.IsBigInt(id):
+		v1, v2 := m.operands.bigInts(id)
+		return ectx.NewValue(typ, encodeBigInt(id, v1.Mul(v1, v2)))
 	case zed.IsFloat(id):
 		v1, v2 := m.operands.floats()
 		return ectx.NewValue(typ, zed.EncodeFloat64(v1*v2))
@@ -514,6 +581,24 @@ func (d *Divide) Eval(ectx Context, this *zed.Value) *zed.Value {
 		return d.zctx.NewError(err)
 	}
 	switch {
+	case zed.IsDecimal(id):
+		v1, v2 := d.operands.decimals()
+		if v2.IsZero() {
+			return d.zctx.NewError(DivideByZero)
+		}
+		return ectx.NewValue(typ, zed.EncodeDecimal(id, zed.DecimalFormat(id).Quo(v1, v2)))
+	case zed.IsBigFloat(id):
+		v1, v2 := d.operands.bigFloats()
+		if v2 != nil && v2.Sign() == 0 {
+			return d.zctx.NewError(DivideByZero)
+		}
+		return ectx.NewValue(typ, zed.EncodeBigFloat(id, zed.BigFloatFormat(id).Quo(v1, v2)))
+	case zed.IsBigInt(id):
+		v1, v2 := d.operands.bigInts(id)
+		if v2.Sign() == 0 {
+			return d.zctx.NewError(DivideByZero)
+		}
+		return ectx.NewValue(typ, encodeBigInt(id, v1.Quo(v1, v2)))
 	case zed.IsFloat(id):
 		v1, v2 := d.operands.floats()
 		if v2 == 0 {
@@ -548,9 +633,16 @@ func (m *Modulo) Eval(ectx Context, this *zed.Value) *zed.Value {
 	if err != nil {
 		return m.zctx.NewError(err)
 	}
-	if zed.IsFloat(id) || !zed.IsNumber(id) {
+	if zed.IsFloat(id) || zed.IsDecimal(id) || !zed.IsNumber(id) {
 		return ectx.CopyValue(m.zctx.NewErrorf("type %s incompatible with '%%' operator", zson.FormatType(typ)))
 	}
+	if zed.IsBigInt(id) {
+		x, y := m.operands.bigInts(id)
+		if y.Sign() == 0 {
+			return m.zctx.NewError(DivideByZero)
+		}
+		return ectx.NewValue(typ, encodeBigInt(id, x.Rem(x, y)))
+	}
 	if zed.IsSigned(id) {
 		x, y := m.operands.ints()
 		if y == 0 {
@@ -596,6 +688,17 @@ func (u *UnaryMinus) Eval(ectx Context, this *zed.Value) *zed.Value {
 			return val
 		}
 		return ectx.NewValue(typ, zed.EncodeFloat64(-zed.DecodeFloat64(val.Bytes)))
+	case zed.IDFloat128, zed.IDFloat256:
+		if val.Bytes == nil {
+			return val
+		}
+		f := zed.BigFloatFormat(typ.ID())
+		return ectx.NewValue(typ, zed.EncodeBigFloat(typ.ID(), f.Neg(zed.DecodeBigFloat(val.Bytes))))
+	case zed.IDDecimal32, zed.IDDecimal64, zed.IDDecimal128, zed.IDDecimal256:
+		if val.Bytes == nil {
+			return val
+		}
+		return ectx.NewValue(typ, zed.EncodeDecimal(typ.ID(), zed.DecodeDecimal(val.Bytes).Negate()))
 	case zed.IDInt8:
 		if val.Bytes == nil {
 			return val
@@ -632,6 +735,28 @@ func (u *UnaryMinus) Eval(ectx Context, this *zed.Value) *zed.Value {
 			return ectx.CopyValue(u.zctx.NewErrorf("unary '-' underflow: int64(%d)", v))
 		}
 		return ectx.NewValue(typ, zed.EncodeInt(-v))
+	case zed.IDInt128, zed.IDInt256:
+		if val.Bytes == nil {
+			return val
+		}
+		v := zed.DecodeBigInt(val.Bytes)
+		if !zed.IntInRange(typ.ID(), v.Neg(v)) {
+			return ectx.CopyValue(u.zctx.NewErrorf("unary '-' underflow: %s", zson.MustFormatValue(val)))
+		}
+		return ectx.NewValue(typ, zed.EncodeBigInt(v))
+	case zed.IDUint128, zed.IDUint256:
+		if val.Bytes == nil {
+			return val
+		}
+		var signed zed.Type = zed.TypeInt128
+		if typ.ID() == zed.IDUint256 {
+			signed = zed.TypeInt256
+		}
+		v := zed.DecodeBigUint(val.Bytes)
+		if !zed.IntInRange(signed.ID(), v.Neg(v)) {
+			return ectx.CopyValue(u.zctx.NewErrorf("unary '-' overflow: %s", zson.MustFormatValue(val)))
+		}
+		return ectx.NewValue(signed, zed.EncodeBigInt(v))
 	case zed.IDUint8:
 		if val.Bytes == nil {
 			return val
@@ -692,11 +817,19 @@ func getNthFromContainer(container zcode.Bytes, idx int) zcode.Bytes {
 	return nil
 }
 
-func lookupKey(mapBytes, target zcode.Bytes) (zcode.Bytes, bool) {
+// lookupKey returns the value of the entry in the map body mapBytes whose
+// key equals key.  Keys of a union type are compared by their underlying
+// values, and numbers are compared after coercion so, for example, an int64
+// index finds the entry with an equal int32 key.
+func lookupKey(keyType zed.Type, mapBytes zcode.Bytes, key *zed.Value) (zcode.Bytes, bool) {
+	var pair coerce.Pair
 	for it := mapBytes.Iter(); !it.Done(); {
-		key := it.Next()
+		typ, b := keyType, it.Next()
 		val := it.Next()
-		if bytes.Compare(key, target) == 0 {
+		if union, ok := typ.(*zed.TypeUnion); ok {
+			typ, b = union.Untag(b)
+		}
+		if _, err := pair.Coerce(&zed.Value{Type: typ, Bytes: b}, key); err == nil && pair.Equal() {
 			return val, true
 		}
 	}
@@ -765,11 +898,7 @@ func indexMap(zctx *zed.Context, ectx Context, typ *zed.TypeMap, mapBytes zcode.
 	if key.IsMissing() {
 		return zctx.Missing()
 	}
-	if key.Type != typ.KeyType {
-		// XXX issue #3360
-		return zctx.Missing()
-	}
-	if valBytes, ok := lookupKey(mapBytes, key.Bytes); ok {
+	if valBytes, ok := lookupKey(typ.KeyType, mapBytes, key); ok {
 		return deunion(ectx, typ.ValType, valBytes)
 	}
 	return zctx.Missing()
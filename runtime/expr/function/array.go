@@ -0,0 +1,111 @@
+package function
+
+import (
+	"sort"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/zcode"
+)
+
+// element is an element of an array or set.  val is the element with any
+// union tag removed and bytes is the element as it is encoded in the
+// container.
+type element struct {
+	val   zed.Value
+	bytes zcode.Bytes
+}
+
+// appendElements appends the elements of the array or set val to elems.
+func appendElements(elems []element, val *zed.Value) []element {
+	typ := zed.InnerType(val.Type)
+	union, _ := typ.(*zed.TypeUnion)
+	for it := val.Bytes.Iter(); !it.Done(); {
+		b := it.Next()
+		e := element{val: zed.Value{Type: typ, Bytes: b}, bytes: b}
+		if union != nil {
+			e.val.Type, e.val.Bytes = union.Untag(b)
+		}
+		elems = append(elems, e)
+	}
+	return elems
+}
+
+func buildElements(b *zcode.Builder, elems []element) zcode.Bytes {
+	b.Reset()
+	for _, e := range elems {
+		b.Append(e.bytes)
+	}
+	if bytes := b.Bytes(); bytes != nil {
+		return bytes
+	}
+	return zcode.Bytes{}
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#sort
+type Sort struct {
+	zctx    *zed.Context
+	compare expr.CompareFn
+	elems   []element
+	builder zcode.Builder
+}
+
+func newSort(zctx *zed.Context) *Sort {
+	return &Sort{zctx: zctx, compare: expr.NewValueCompareFn(order.Asc, true)}
+}
+
+func (s *Sort) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	val := &args[0]
+	if val.IsNull() {
+		return ctx.CopyValue(val)
+	}
+	if zed.InnerType(val.Type) == nil {
+		return s.zctx.WrapError("sort: array arg required", val)
+	}
+	s.elems = appendElements(s.elems[:0], val)
+	sort.SliceStable(s.elems, func(i, j int) bool {
+		return s.compare(&s.elems[i].val, &s.elems[j].val) < 0
+	})
+	return ctx.NewValue(val.Type, buildElements(&s.builder, s.elems))
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#distinct
+type Distinct struct {
+	zctx    *zed.Context
+	elems   []element
+	seen    map[distinctKey]struct{}
+	builder zcode.Builder
+}
+
+type distinctKey struct {
+	typ   zed.Type
+	bytes string
+}
+
+func newDistinct(zctx *zed.Context) *Distinct {
+	return &Distinct{zctx: zctx, seen: make(map[distinctKey]struct{})}
+}
+
+func (d *Distinct) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	val := &args[0]
+	if val.IsNull() {
+		return ctx.CopyValue(val)
+	}
+	if zed.InnerType(val.Type) == nil {
+		return d.zctx.WrapError("distinct: array arg required", val)
+	}
+	for k := range d.seen {
+		delete(d.seen, k)
+	}
+	d.elems = d.elems[:0]
+	for _, e := range appendElements(nil, val) {
+		key := distinctKey{e.val.Type, string(e.val.Bytes)}
+		if _, ok := d.seen[key]; ok {
+			continue
+		}
+		d.seen[key] = struct{}{}
+		d.elems = append(d.elems, e)
+	}
+	return ctx.NewValue(val.Type, buildElements(&d.builder, d.elems))
+}
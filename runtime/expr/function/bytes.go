@@ -1,11 +1,17 @@
 package function
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"hash"
+	"hash/fnv"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/zson"
+	"github.com/cespare/xxhash/v2"
 )
 
 // https://github.com/brimdata/zed/blob/main/docs/language/functions.md#base64
@@ -61,3 +67,90 @@ func (h *Hex) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 		return newErrorf(h.zctx, ctx, "base64: argument must a bytes or string type (bad argument: %s)", zson.String(val))
 	}
 }
+
+// Digest computes a cryptographic hash of a string or bytes value and
+// returns it as a hexadecimal string.
+//
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#md5
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#sha1
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#sha256
+type Digest struct {
+	zctx *zed.Context
+	name string
+	hash hash.Hash
+	sum  []byte
+}
+
+func newDigest(zctx *zed.Context, name string) *Digest {
+	var h hash.Hash
+	switch name {
+	case "md5":
+		h = md5.New()
+	case "sha1":
+		h = sha1.New()
+	case "sha256":
+		h = sha256.New()
+	default:
+		panic(name)
+	}
+	return &Digest{zctx: zctx, name: name, hash: h}
+}
+
+func (d *Digest) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	val := &args[0]
+	switch zed.TypeUnder(val.Type) {
+	case zed.TypeString, zed.TypeBytes:
+	default:
+		return d.zctx.WrapError(d.name+": string or bytes arg required", val)
+	}
+	if val.IsNull() {
+		return zed.NullString
+	}
+	d.hash.Reset()
+	d.hash.Write(val.Bytes)
+	d.sum = d.hash.Sum(d.sum[:0])
+	return newString(ctx, hex.EncodeToString(d.sum))
+}
+
+// Hash64 computes a fast, non-cryptographic 64-bit hash of a string or
+// bytes value.
+//
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#fnv
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#xxhash64
+type Hash64 struct {
+	zctx *zed.Context
+	name string
+	hash func([]byte) uint64
+}
+
+func newHash64(zctx *zed.Context, name string) *Hash64 {
+	h := &Hash64{zctx: zctx, name: name}
+	switch name {
+	case "fnv":
+		h.hash = fnv64a
+	case "xxhash64", "xxhash":
+		h.hash = xxhash.Sum64
+	default:
+		panic(name)
+	}
+	return h
+}
+
+func (h *Hash64) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	val := &args[0]
+	switch zed.TypeUnder(val.Type) {
+	case zed.TypeString, zed.TypeBytes:
+	default:
+		return h.zctx.WrapError(h.name+": string or bytes arg required", val)
+	}
+	if val.IsNull() {
+		return zed.NullUint64
+	}
+	return newUint64(ctx, h.hash(val.Bytes))
+}
+
+func fnv64a(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
@@ -46,6 +46,8 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		}
 	case "ceil":
 		f = &Ceil{zctx: zctx}
+	case "distinct":
+		f = newDistinct(zctx)
 	case "flatten":
 		f = NewFlatten(zctx)
 	case "floor":
@@ -53,6 +55,8 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 	case "join":
 		argmax = 2
 		f = &Join{zctx: zctx}
+	case "keys":
+		f = newMapElements(zctx, "keys", false)
 	case "ksuid":
 		argmin = 0
 		f = &KSUIDToString{zctx: zctx}
@@ -84,6 +88,17 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		argmin = 3
 		argmax = 3
 		f = &Replace{zctx: zctx}
+	case "regexp_extract", "parse_regex":
+		argmin, argmax = 2, 2
+		f = &RegexpExtract{zctx: zctx, name: name}
+	case "regexp_replace":
+		argmin, argmax = 3, 3
+		f = &RegexpReplace{zctx: zctx}
+	case "repeat":
+		argmin, argmax = 2, 2
+		f = &Repeat{zctx: zctx}
+	case "reverse":
+		f = &Reverse{zctx: zctx}
 	case "rune_len":
 		f = &RuneLen{zctx: zctx}
 	case "lower":
@@ -92,6 +107,29 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &ToUpper{zctx: zctx}
 	case "trim":
 		f = &Trim{zctx: zctx}
+	case "substring":
+		argmin, argmax = 2, 3
+		f = &Substring{zctx: zctx}
+	case "index_of":
+		argmin, argmax = 2, 2
+		f = &IndexOf{zctx: zctx}
+	case "starts_with":
+		argmin, argmax = 2, 2
+		f = &HasAffix{zctx: zctx, name: name}
+	case "ends_with":
+		argmin, argmax = 2, 2
+		f = &HasAffix{zctx: zctx, name: name, suffix: true}
+	case "pad_left":
+		argmin, argmax = 2, 3
+		f = &Pad{zctx: zctx, name: name}
+	case "pad_right":
+		argmin, argmax = 2, 3
+		f = &Pad{zctx: zctx, name: name, right: true}
+	case "sort":
+		f = newSort(zctx)
+	case "merge":
+		argmax = -1
+		f = newMerge(zctx)
 	case "split":
 		argmin = 2
 		argmax = 2
@@ -100,6 +138,18 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		argmin = 2
 		argmax = 2
 		f = &Bucket{zctx: zctx}
+	case "strftime":
+		argmin, argmax = 2, 3
+		f = &Strftime{zctx: zctx}
+	case "strptime":
+		argmin, argmax = 2, 2
+		f = &Strptime{zctx: zctx}
+	case "time_trunc":
+		argmin, argmax = 2, 3
+		f = &TimeTrunc{zctx: zctx}
+	case "year", "month", "day", "day_of_week", "hour":
+		argmax = 2
+		f = newTimePart(zctx, name)
 	case "typename":
 		argmax = 2
 		f = &typeName{zctx: zctx}
@@ -111,6 +161,9 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &NameOf{zctx: zctx}
 	case "fields":
 		f = NewFields(zctx)
+	case "grok":
+		argmin, argmax = 2, 3
+		f = newGrok(zctx)
 	case "has":
 		argmax = -1
 		f = &Has{}
@@ -131,6 +184,10 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &Base64{zctx: zctx}
 	case "hex":
 		f = &Hex{zctx: zctx}
+	case "md5", "sha1", "sha256":
+		f = newDigest(zctx, name)
+	case "fnv", "xxhash64", "xxhash":
+		f = newHash64(zctx, name)
 	case "compare":
 		argmin = 2
 		argmax = 3
@@ -145,6 +202,21 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 	case "network_of":
 		argmax = 2
 		f = &NetworkOf{zctx: zctx}
+	case "is_private", "is_loopback", "is_multicast":
+		f = newIPClass(zctx, name)
+	case "ip_version":
+		f = &IPVersion{zctx: zctx}
+	case "net_contains":
+		argmin, argmax = 2, 2
+		f = &NetContains{zctx: zctx}
+	case "net_broadcast":
+		f = &NetBroadcast{zctx: zctx}
+	case "ip_to_int":
+		f = &IPToInt{zctx: zctx}
+	case "int_to_ip":
+		f = &IntToIP{zctx: zctx}
+	case "ptr_name":
+		f = &PTRName{zctx: zctx}
 	case "nest_dotted":
 		path = field.Path{}
 		argmin = 0
@@ -153,6 +225,14 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &ParseURI{zctx: zctx, marshaler: zson.NewZNGMarshalerWithContext(zctx)}
 	case "parse_zson":
 		f = &ParseZSON{zctx: zctx}
+	case "parse_json":
+		f = newParseJSON(zctx)
+	case "parse_kv":
+		argmax = 3
+		f = newParseKV(zctx)
+	case "parse_csv_line":
+		argmax = 2
+		f = newParseCSVLine(zctx)
 	case "quiet":
 		f = &Quiet{zctx: zctx}
 	case "regexp":
@@ -162,6 +242,8 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &Under{zctx: zctx}
 	case "unflatten":
 		f = NewUnflatten(zctx)
+	case "values":
+		f = newMapElements(zctx, "values", true)
 	}
 	if argmin != -1 && narg < argmin {
 		return nil, nil, ErrTooFewArgs
@@ -178,7 +260,7 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 // signatures so the return type can be introspected.
 func HasBoolResult(name string) bool {
 	switch name {
-	case "has", "has_error", "is_error", "is", "missing", "cidr_match":
+	case "has", "has_error", "is_error", "is", "missing", "cidr_match", "is_private", "is_loopback", "is_multicast", "net_contains", "starts_with", "ends_with":
 		return true
 	}
 	return false
@@ -1,8 +1,13 @@
 package function
 
 import (
+	"encoding/binary"
 	"errors"
+	"math"
+	"math/big"
 	"net/netip"
+	"strconv"
+	"strings"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/zcode"
@@ -88,3 +93,258 @@ func (c *CIDRMatch) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 	}
 	return zed.False
 }
+
+// prefixOf returns the prefix of a net value or the single-address prefix
+// of an IP value.  IPv4-mapped IPv6 addresses are unmapped.  It returns the
+// zero prefix for a null value and false if val is not an IP or net.
+func prefixOf(val *zed.Value) (netip.Prefix, bool) {
+	typ := zed.TypeUnder(val.Type)
+	if typ != zed.TypeIP && typ != zed.TypeNet {
+		return netip.Prefix{}, false
+	}
+	if val.IsNull() {
+		return netip.Prefix{}, true
+	}
+	if typ == zed.TypeIP {
+		ip := zed.DecodeIP(val.Bytes).Unmap()
+		return netip.PrefixFrom(ip, ip.BitLen()), true
+	}
+	prefix := zed.DecodeNet(val.Bytes)
+	if ip := prefix.Addr(); ip.Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(ip.Unmap(), prefix.Bits()-96)
+	}
+	return prefix, true
+}
+
+// contains returns true if every address in prefix q is in prefix p.
+func contains(p, q netip.Prefix) bool {
+	return p.Bits() <= q.Bits() && p.Contains(q.Addr())
+}
+
+var (
+	privateNets = []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+		netip.MustParsePrefix("fc00::/7"),
+	}
+	loopbackNets = []netip.Prefix{
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+	}
+	multicastNets = []netip.Prefix{
+		netip.MustParsePrefix("224.0.0.0/4"),
+		netip.MustParsePrefix("ff00::/8"),
+	}
+)
+
+// IPClass tests whether an IP or every address of a net is in one of a
+// set of special-purpose address blocks.
+//
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#is_private
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#is_loopback
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#is_multicast
+type IPClass struct {
+	zctx *zed.Context
+	name string
+	nets []netip.Prefix
+}
+
+func newIPClass(zctx *zed.Context, name string) *IPClass {
+	var nets []netip.Prefix
+	switch name {
+	case "is_private":
+		nets = privateNets
+	case "is_loopback":
+		nets = loopbackNets
+	case "is_multicast":
+		nets = multicastNets
+	default:
+		panic(name)
+	}
+	return &IPClass{zctx: zctx, name: name, nets: nets}
+}
+
+func (i *IPClass) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	prefix, ok := prefixOf(&args[0])
+	if !ok {
+		return i.zctx.WrapError(i.name+": ip or net arg required", &args[0])
+	}
+	if args[0].IsNull() {
+		return zed.NullBool
+	}
+	for _, net := range i.nets {
+		if contains(net, prefix) {
+			return zed.True
+		}
+	}
+	return zed.False
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#ip_version
+type IPVersion struct {
+	zctx *zed.Context
+}
+
+func (i *IPVersion) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	prefix, ok := prefixOf(&args[0])
+	if !ok {
+		return i.zctx.WrapError("ip_version: ip or net arg required", &args[0])
+	}
+	if args[0].IsNull() {
+		return zed.NullInt64
+	}
+	if prefix.Addr().Is4() {
+		return newInt64(ctx, 4)
+	}
+	return newInt64(ctx, 6)
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#net_contains
+type NetContains struct {
+	zctx *zed.Context
+}
+
+func (n *NetContains) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	if zed.TypeUnder(args[0].Type) != zed.TypeNet {
+		return n.zctx.WrapError("net_contains: net arg required", &args[0])
+	}
+	inner, ok := prefixOf(&args[1])
+	if !ok {
+		return n.zctx.WrapError("net_contains: ip or net arg required", &args[1])
+	}
+	if args[0].IsNull() || args[1].IsNull() {
+		return zed.NullBool
+	}
+	outer, _ := prefixOf(&args[0])
+	if contains(outer, inner) {
+		return zed.True
+	}
+	return zed.False
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#net_broadcast
+type NetBroadcast struct {
+	zctx *zed.Context
+}
+
+func (n *NetBroadcast) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	if zed.TypeUnder(args[0].Type) != zed.TypeNet {
+		return n.zctx.WrapError("net_broadcast: net arg required", &args[0])
+	}
+	if args[0].IsNull() {
+		return zed.NullIP
+	}
+	prefix := zed.DecodeNet(args[0].Bytes).Masked()
+	b := prefix.Addr().AsSlice()
+	for k := prefix.Bits(); k < len(b)*8; k++ {
+		b[k/8] |= 0x80 >> (k % 8)
+	}
+	ip, _ := netip.AddrFromSlice(b)
+	return ctx.NewValue(zed.TypeIP, zed.EncodeIP(ip))
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#ip_to_int
+type IPToInt struct {
+	zctx *zed.Context
+}
+
+func (i *IPToInt) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	val := &args[0]
+	if zed.TypeUnder(val.Type) != zed.TypeIP {
+		return i.zctx.WrapError("ip_to_int: ip arg required", val)
+	}
+	if val.IsNull() {
+		return zed.NullUint32
+	}
+	ip := zed.DecodeIP(val.Bytes).Unmap()
+	if ip.Is4() {
+		b := ip.As4()
+		return newUint(ctx, zed.TypeUint32, uint64(binary.BigEndian.Uint32(b[:])))
+	}
+	b := ip.As16()
+	return ctx.NewValue(zed.TypeUint128, zed.EncodeBigUint(new(big.Int).SetBytes(b[:])))
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#int_to_ip
+type IntToIP struct {
+	zctx *zed.Context
+}
+
+func (i *IntToIP) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	val := &args[0]
+	id := val.Type.ID()
+	if !zed.IsInteger(id) {
+		return i.zctx.WrapError("int_to_ip: integer arg required", val)
+	}
+	if val.IsNull() {
+		return zed.NullIP
+	}
+	switch id {
+	case zed.IDInt128, zed.IDInt256, zed.IDUint128, zed.IDUint256:
+		// Integers wider than 64 bits convert to IPv6 addresses.
+		var v *big.Int
+		if zed.IsSigned(id) {
+			v = zed.DecodeBigInt(val.Bytes)
+		} else {
+			v = zed.DecodeBigUint(val.Bytes)
+		}
+		if !zed.IntInRange(zed.IDUint128, v) {
+			return i.zctx.WrapError("int_to_ip: integer out of range for IPv6 address", val)
+		}
+		var b [16]byte
+		v.FillBytes(b[:])
+		return ctx.NewValue(zed.TypeIP, zed.EncodeIP(netip.AddrFrom16(b)))
+	}
+	var v uint64
+	if zed.IsSigned(id) {
+		n := zed.DecodeInt(val.Bytes)
+		if n < 0 {
+			return i.zctx.WrapError("int_to_ip: integer out of range for IPv4 address", val)
+		}
+		v = uint64(n)
+	} else {
+		v = zed.DecodeUint(val.Bytes)
+	}
+	if v > math.MaxUint32 {
+		return i.zctx.WrapError("int_to_ip: integer out of range for IPv4 address", val)
+	}
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(v))
+	return ctx.NewValue(zed.TypeIP, zed.EncodeIP(netip.AddrFrom4(b)))
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#ptr_name
+type PTRName struct {
+	zctx *zed.Context
+}
+
+func (p *PTRName) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	val := &args[0]
+	if zed.TypeUnder(val.Type) != zed.TypeIP {
+		return p.zctx.WrapError("ptr_name: ip arg required", val)
+	}
+	if val.IsNull() {
+		return zed.NullString
+	}
+	ip := zed.DecodeIP(val.Bytes).Unmap()
+	b := ip.AsSlice()
+	var s strings.Builder
+	if ip.Is4() {
+		for k := len(b) - 1; k >= 0; k-- {
+			s.WriteString(strconv.Itoa(int(b[k])))
+			s.WriteByte('.')
+		}
+		s.WriteString("in-addr.arpa")
+	} else {
+		const digits = "0123456789abcdef"
+		for k := len(b) - 1; k >= 0; k-- {
+			s.WriteByte(digits[b[k]&0xf])
+			s.WriteByte('.')
+			s.WriteByte(digits[b[k]>>4])
+			s.WriteByte('.')
+		}
+		s.WriteString("ip6.arpa")
+	}
+	return newString(ctx, s.String())
+}
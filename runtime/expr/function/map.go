@@ -0,0 +1,96 @@
+package function
+
+import (
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/runtime/expr/agg"
+	"github.com/brimdata/zed/zcode"
+)
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#keys
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#values
+type MapElements struct {
+	zctx    *zed.Context
+	name    string
+	values  bool
+	builder zcode.Builder
+}
+
+func newMapElements(zctx *zed.Context, name string, values bool) *MapElements {
+	return &MapElements{zctx: zctx, name: name, values: values}
+}
+
+func (m *MapElements) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	val := &args[0]
+	typ, ok := zed.TypeUnder(val.Type).(*zed.TypeMap)
+	if !ok {
+		return m.zctx.WrapError(m.name+": map arg required", val)
+	}
+	inner := typ.KeyType
+	if m.values {
+		inner = typ.ValType
+	}
+	arrayType := m.zctx.LookupTypeArray(inner)
+	if val.IsNull() {
+		return ctx.NewValue(arrayType, nil)
+	}
+	m.builder.Reset()
+	for it := val.Bytes.Iter(); !it.Done(); {
+		key, value := it.Next(), it.Next()
+		if m.values {
+			m.builder.Append(value)
+		} else {
+			m.builder.Append(key)
+		}
+	}
+	b := m.builder.Bytes()
+	if b == nil {
+		b = zcode.Bytes{}
+	}
+	return ctx.NewValue(arrayType, b)
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#merge
+type Merge struct {
+	zctx    *zed.Context
+	pattern agg.Pattern
+}
+
+func newMerge(zctx *zed.Context) *Merge {
+	pattern, err := agg.NewPattern("map", true)
+	if err != nil {
+		panic(err)
+	}
+	return &Merge{zctx: zctx, pattern: pattern}
+}
+
+func (m *Merge) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	// The map aggregation combines the entries of the maps, with the value
+	// of an entry in a later map replacing that of an equal key in an
+	// earlier one.
+	fn := m.pattern()
+	var typ zed.Type
+	var empty zcode.Bytes
+	for i := range args {
+		val := &args[i]
+		if val.Type == zed.TypeNull {
+			continue
+		}
+		if _, ok := zed.TypeUnder(val.Type).(*zed.TypeMap); !ok {
+			return m.zctx.WrapError("merge: map arg required", val)
+		}
+		if typ == nil {
+			typ = val.Type
+		}
+		if !val.IsNull() {
+			empty = zcode.Bytes{}
+		}
+		fn.Consume(val)
+	}
+	result := fn.Result(m.zctx)
+	if result.IsNull() && typ != nil {
+		// The maps are all empty or null, so the result is an empty
+		// or null map of the type of the first.
+		return ctx.NewValue(typ, empty)
+	}
+	return ctx.CopyValue(result)
+}
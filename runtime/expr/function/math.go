@@ -2,6 +2,7 @@ package function
 
 import (
 	"math"
+	"math/big"
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/pkg/anymath"
@@ -29,12 +30,23 @@ func (a *Abs) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 		f := math.Abs(zed.DecodeFloat64(v.Bytes))
 		return newFloat64(ctx, f)
 	}
+	if zed.IsBigFloat(id) {
+		f := zed.DecodeBigFloat(v.Bytes)
+		if f != nil {
+			f = new(big.Float).Abs(f)
+		}
+		return ctx.NewValue(v.Type, zed.EncodeBigFloat(id, f))
+	}
 	if !zed.IsInteger(id) {
 		return newErrorf(a.zctx, ctx, "abs: not a number: %s", zson.MustFormatValue(&args[0]))
 	}
 	if !zed.IsSigned(id) {
 		return ctx.CopyValue(&args[0])
 	}
+	if zed.IsBigInt(id) {
+		x := zed.DecodeBigInt(v.Bytes)
+		return ctx.NewValue(v.Type, zed.EncodeBigInt(zed.WrapInt(id, x.Abs(x))))
+	}
 	x := zed.DecodeInt(v.Bytes)
 	if x < 0 {
 		x = -x
@@ -60,6 +72,9 @@ func (c *Ceil) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 	case id == zed.IDFloat64:
 		f := math.Ceil(zed.DecodeFloat64(v.Bytes))
 		return newFloat64(ctx, f)
+	case zed.IsBigFloat(id):
+		f := roundBigFloat(zed.DecodeBigFloat(v.Bytes), big.ToPositiveInf)
+		return ctx.NewValue(v.Type, zed.EncodeBigFloat(id, f))
 	case zed.IsInteger(id):
 		return ctx.CopyValue(&args[0])
 	default:
@@ -85,6 +100,9 @@ func (f *Floor) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 	case id == zed.IDFloat64:
 		v := math.Floor(zed.DecodeFloat64(v.Bytes))
 		return newFloat64(ctx, v)
+	case zed.IsBigFloat(id):
+		f := roundBigFloat(zed.DecodeBigFloat(v.Bytes), big.ToNegativeInf)
+		return ctx.NewValue(v.Type, zed.EncodeBigFloat(id, f))
 	case zed.IsInteger(id):
 		return ctx.CopyValue(&args[0])
 	default:
@@ -118,6 +136,18 @@ func (r *reducer) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 	val0 := &args[0]
 	typ := val0.Type
 	id := typ.ID()
+	if zed.IsBigFloat(id) {
+		format := zed.BigFloatFormat(id)
+		result := zed.DecodeBigFloat(val0.Bytes)
+		for _, val := range args[1:] {
+			v, ok := coerce.ToBigFloat(&val, format)
+			if !ok || !zed.IsNumber(val.Type.ID()) {
+				return newErrorf(r.zctx, ctx, "%s: not a number: %s", r.name, zson.MustFormatValue(&val))
+			}
+			result = r.fn.BigFloat(format, result, v)
+		}
+		return ctx.NewValue(typ, zed.EncodeBigFloat(id, result))
+	}
 	if zed.IsFloat(id) {
 		//XXX this is wrong like math aggregators...
 		// need to be more robust and adjust type as new types encountered
@@ -134,6 +164,32 @@ func (r *reducer) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 	if !zed.IsNumber(id) {
 		return newErrorf(r.zctx, ctx, "%s: not a number: %s", r.name, zson.MustFormatValue(val0))
 	}
+	if zed.IsDecimal(id) {
+		format := zed.DecimalFormat(id)
+		result := zed.DecodeDecimal(val0.Bytes)
+		for _, val := range args[1:] {
+			v, ok := coerce.ToDecimal(&val)
+			if !ok || !zed.IsNumber(val.Type.ID()) {
+				return newErrorf(r.zctx, ctx, "%s: not a number: %s", r.name, zson.MustFormatValue(&val))
+			}
+			result = r.fn.Decimal(format, result, v)
+		}
+		return ctx.NewValue(typ, zed.EncodeDecimal(id, result))
+	}
+	if zed.IsBigInt(id) {
+		result, _ := coerce.ToBigInt(val0)
+		for _, val := range args[1:] {
+			v, ok := coerce.ToBigInt(&val)
+			if !ok || !zed.IsInteger(val.Type.ID()) || !zed.IntInRange(id, v) {
+				return newErrorf(r.zctx, ctx, "%s: not a number: %s", r.name, zson.MustFormatValue(&val))
+			}
+			result = r.fn.BigInt(result, v)
+		}
+		if zed.IsSigned(id) {
+			return ctx.NewValue(typ, zed.EncodeBigInt(result))
+		}
+		return ctx.NewValue(typ, zed.EncodeBigUint(result))
+	}
 	if zed.IsSigned(id) {
 		result := zed.DecodeInt(val0.Bytes)
 		for _, val := range args[1:] {
@@ -178,12 +234,51 @@ func (r *Round) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 		f := zed.DecodeFloat64(val.Bytes)
 		return newFloat64(ctx, math.Round(f))
 	}
+	if zed.IsBigFloat(id) {
+		f := roundBigFloat(zed.DecodeBigFloat(val.Bytes), big.ToNearestAway)
+		return ctx.NewValue(val.Type, zed.EncodeBigFloat(id, f))
+	}
+	if zed.IsDecimal(id) {
+		d := zed.DecodeDecimal(val.Bytes).RoundInt()
+		return ctx.NewValue(val.Type, zed.EncodeDecimal(id, d))
+	}
 	if !zed.IsNumber(id) {
 		return newErrorf(r.zctx, ctx, "round: not a number: %s", zson.MustFormatValue(val))
 	}
 	return ctx.CopyValue(&args[0])
 }
 
+// roundBigFloat rounds x to an integer toward negative infinity, toward
+// positive infinity, or to nearest with halves away from zero as mode is
+// big.ToNegativeInf, big.ToPositiveInf, or big.ToNearestAway.
+func roundBigFloat(x *big.Float, mode big.RoundingMode) *big.Float {
+	if x == nil || x.IsInf() || x.IsInt() {
+		return x
+	}
+	i, _ := x.Int(nil)
+	// The fraction is exact at the precision of x.
+	frac := new(big.Float).SetPrec(x.Prec()).Sub(x, new(big.Float).SetInt(i))
+	switch mode {
+	case big.ToNegativeInf:
+		if frac.Sign() < 0 {
+			i.Sub(i, big.NewInt(1))
+		}
+	case big.ToPositiveInf:
+		if frac.Sign() > 0 {
+			i.Add(i, big.NewInt(1))
+		}
+	case big.ToNearestAway:
+		if frac.Abs(frac).Cmp(big.NewFloat(0.5)) >= 0 {
+			if x.Sign() < 0 {
+				i.Sub(i, big.NewInt(1))
+			} else {
+				i.Add(i, big.NewInt(1))
+			}
+		}
+	}
+	return new(big.Float).SetInt(i)
+}
+
 // https://github.com/brimdata/zed/blob/main/docs/language/functions.md#pow
 type Pow struct {
 	zctx *zed.Context
@@ -1,12 +1,16 @@
 package function
 
 import (
+	"errors"
 	"net/url"
 	"strconv"
 	"strings"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/grok"
 	"github.com/brimdata/zed/zcode"
+	"github.com/brimdata/zed/zio/csvio"
+	"github.com/brimdata/zed/zio/jsonio"
 	"github.com/brimdata/zed/zson"
 )
 
@@ -109,3 +113,332 @@ func (p *ParseZSON) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 	}
 	return ctx.CopyValue(result)
 }
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#grok
+type Grok struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	lib     grok.Library
+	pattern string
+	defs    string
+	grok    *grok.Pattern
+	err     error
+	typ     zed.Type
+}
+
+func newGrok(zctx *zed.Context) *Grok {
+	return &Grok{zctx: zctx, lib: grok.NewLibrary()}
+}
+
+func (g *Grok) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	patternVal, sVal := &args[0], &args[1]
+	if !patternVal.IsString() || patternVal.IsNull() {
+		return g.zctx.WrapError("grok: pattern must be a string", patternVal)
+	}
+	var defs string
+	if len(args) == 3 {
+		defsVal := &args[2]
+		if !defsVal.IsString() {
+			return g.zctx.WrapError("grok: definitions must be a string", defsVal)
+		}
+		defs = zed.DecodeString(defsVal.Bytes)
+	}
+	if pattern := zed.DecodeString(patternVal.Bytes); g.grok == nil && g.err == nil || pattern != g.pattern || defs != g.defs {
+		g.pattern, g.defs = pattern, defs
+		g.err = g.compile(pattern, defs)
+	}
+	if g.err != nil {
+		return newErrorf(g.zctx, ctx, "grok: %s", g.err)
+	}
+	if !sVal.IsString() {
+		return g.zctx.WrapError("grok: string arg required", sVal)
+	}
+	if sVal.IsNull() {
+		return ctx.NewValue(g.typ, nil)
+	}
+	captures, err := g.grok.Match(sVal.Bytes)
+	if err != nil {
+		return newErrorf(g.zctx, ctx, "grok: %s (%q)", err, sVal.Bytes)
+	}
+	g.builder.Reset()
+	for i, f := range g.grok.Fields() {
+		b := captures[i]
+		if b == nil {
+			g.builder.Append(nil)
+			continue
+		}
+		switch f.Type {
+		case grok.Int:
+			v, err := strconv.ParseInt(string(b), 10, 64)
+			if err != nil {
+				return newErrorf(g.zctx, ctx, "grok: field %s: cannot convert %q to int64", f.Name, b)
+			}
+			g.builder.Append(zed.EncodeInt(v))
+		case grok.Float:
+			v, err := strconv.ParseFloat(string(b), 64)
+			if err != nil {
+				return newErrorf(g.zctx, ctx, "grok: field %s: cannot convert %q to float64", f.Name, b)
+			}
+			g.builder.Append(zed.EncodeFloat64(v))
+		default:
+			g.builder.Append(b)
+		}
+	}
+	b := g.builder.Bytes()
+	if b == nil {
+		// The pattern has no fields so the result is an empty record.
+		b = zcode.Bytes{}
+	}
+	return ctx.NewValue(g.typ, b)
+}
+
+func (g *Grok) compile(pattern, defs string) error {
+	lib := g.lib
+	if defs != "" {
+		lib = grok.NewLibrary()
+		if err := lib.Add(defs); err != nil {
+			return err
+		}
+	}
+	p, err := lib.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	var fields []zed.Field
+	for _, f := range p.Fields() {
+		var typ zed.Type = zed.TypeString
+		switch f.Type {
+		case grok.Int:
+			typ = zed.TypeInt64
+		case grok.Float:
+			typ = zed.TypeFloat64
+		}
+		fields = append(fields, zed.NewField(f.Name, typ))
+	}
+	typ, err := g.zctx.LookupTypeRecord(fields)
+	if err != nil {
+		return err
+	}
+	g.grok, g.typ = p, typ
+	return nil
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#parse_kv
+type ParseKV struct {
+	zctx      *zed.Context
+	marshaler *zson.MarshalZNGContext
+	names     []string
+	vals      []interface{}
+}
+
+func newParseKV(zctx *zed.Context) *ParseKV {
+	return &ParseKV{zctx: zctx, marshaler: zson.NewZNGMarshalerWithContext(zctx)}
+}
+
+func (p *ParseKV) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	sVal := &args[0]
+	if !sVal.IsString() {
+		return p.zctx.WrapError("parse_kv: string arg required", sVal)
+	}
+	pairSep, kvSep := " ", "="
+	if len(args) > 1 {
+		if !args[1].IsString() || len(args[1].Bytes) == 0 {
+			return p.zctx.WrapError("parse_kv: pair separator must be a non-empty string", &args[1])
+		}
+		pairSep = zed.DecodeString(args[1].Bytes)
+	}
+	if len(args) > 2 {
+		if !args[2].IsString() || len(args[2].Bytes) == 0 {
+			return p.zctx.WrapError("parse_kv: key-value separator must be a non-empty string", &args[2])
+		}
+		kvSep = zed.DecodeString(args[2].Bytes)
+	}
+	if sVal.IsNull() {
+		return zed.Null
+	}
+	s := zed.DecodeString(sVal.Bytes)
+	if err := p.split(s, pairSep, kvSep); err != nil {
+		return newErrorf(p.zctx, ctx, "parse_kv: %s (%q)", err, s)
+	}
+	val, err := p.marshaler.MarshalCustom(p.names, p.vals)
+	if err != nil {
+		return newErrorf(p.zctx, ctx, "parse_kv: %s (%q)", err, s)
+	}
+	return emptyRecordIfNil(ctx, val)
+}
+
+// split splits s into keys and values in p.names and p.vals.  A value in
+// double quotes may contain either separator and is always a string.  The
+// types of other values are inferred as for CSV fields.
+func (p *ParseKV) split(s, pairSep, kvSep string) error {
+	p.names, p.vals = p.names[:0], p.vals[:0]
+	for {
+		for strings.HasPrefix(s, pairSep) {
+			s = s[len(pairSep):]
+		}
+		if s == "" {
+			return nil
+		}
+		end := strings.Index(s, pairSep)
+		if end < 0 {
+			end = len(s)
+		}
+		k := strings.Index(s[:end], kvSep)
+		if k < 0 {
+			// A key without a value.
+			p.names = append(p.names, s[:end])
+			p.vals = append(p.vals, nil)
+			s = s[end:]
+			continue
+		}
+		p.names = append(p.names, s[:k])
+		s = s[k+len(kvSep):]
+		if !strings.HasPrefix(s, `"`) {
+			end = strings.Index(s, pairSep)
+			if end < 0 {
+				end = len(s)
+			}
+			p.vals = append(p.vals, csvio.ConvertString(s[:end]))
+			s = s[end:]
+			continue
+		}
+		end = closingQuote(s)
+		if end < 0 {
+			return errors.New("unterminated quoted value")
+		}
+		v, err := strconv.Unquote(s[:end+1])
+		if err != nil {
+			// Keep the text between the quotes for escape sequences
+			// Go doesn't recognize.
+			v = s[1:end]
+		}
+		p.vals = append(p.vals, v)
+		s = s[end+1:]
+		if s != "" && !strings.HasPrefix(s, pairSep) {
+			return errors.New("quoted value not followed by separator")
+		}
+	}
+}
+
+// closingQuote returns the index of the double quote ending the quoted
+// string at the start of s or -1 if there is none.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#parse_csv_line
+type ParseCSVLine struct {
+	zctx      *zed.Context
+	marshaler *zson.MarshalZNGContext
+	names     []string
+	vals      []interface{}
+}
+
+func newParseCSVLine(zctx *zed.Context) *ParseCSVLine {
+	return &ParseCSVLine{zctx: zctx, marshaler: zson.NewZNGMarshalerWithContext(zctx)}
+}
+
+func (p *ParseCSVLine) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	sVal := &args[0]
+	if !sVal.IsString() {
+		return p.zctx.WrapError("parse_csv_line: string arg required", sVal)
+	}
+	var names []string
+	if len(args) > 1 {
+		var err *zed.Value
+		if names, err = p.header(&args[1]); err != nil {
+			return err
+		}
+	}
+	if sVal.IsNull() {
+		return zed.Null
+	}
+	s := zed.DecodeString(sVal.Bytes)
+	fields, err := csvio.SplitLine(s, 0)
+	if err != nil {
+		return newErrorf(p.zctx, ctx, "parse_csv_line: %s (%q)", err, s)
+	}
+	if names == nil {
+		p.names = p.names[:0]
+		for k := range fields {
+			p.names = append(p.names, "c"+strconv.Itoa(k))
+		}
+		names = p.names
+	} else if len(names) != len(fields) {
+		return newErrorf(p.zctx, ctx, "parse_csv_line: line has %d fields but header has %d (%q)", len(fields), len(names), s)
+	}
+	p.vals = p.vals[:0]
+	for _, f := range fields {
+		p.vals = append(p.vals, csvio.ConvertString(f))
+	}
+	val, err := p.marshaler.MarshalCustom(names, p.vals)
+	if err != nil {
+		return newErrorf(p.zctx, ctx, "parse_csv_line: %s (%q)", err, s)
+	}
+	return emptyRecordIfNil(ctx, val)
+}
+
+// header returns the field names given by a header line or an array of
+// strings.
+func (p *ParseCSVLine) header(val *zed.Value) ([]string, *zed.Value) {
+	switch typ := zed.TypeUnder(val.Type).(type) {
+	case *zed.TypeOfString:
+		names, err := csvio.SplitLine(zed.DecodeString(val.Bytes), 0)
+		if err != nil {
+			return nil, p.zctx.WrapError("parse_csv_line: "+err.Error(), val)
+		}
+		return names, nil
+	case *zed.TypeArray:
+		if zed.TypeUnder(typ.Type) == zed.TypeString {
+			var names []string
+			for it := val.Bytes.Iter(); !it.Done(); {
+				names = append(names, zed.DecodeString(it.Next()))
+			}
+			return names, nil
+		}
+	}
+	return nil, p.zctx.WrapError("parse_csv_line: header must be a string or array of strings", val)
+}
+
+// emptyRecordIfNil copies the record val to ctx.  The marshaler gives a
+// record with no fields a nil body, which would make it null, so an empty
+// body is substituted.
+func emptyRecordIfNil(ctx zed.Allocator, val *zed.Value) *zed.Value {
+	if val.Bytes == nil {
+		return ctx.NewValue(val.Type, zcode.Bytes{})
+	}
+	return ctx.CopyValue(val)
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#parse_json
+type ParseJSON struct {
+	zctx   *zed.Context
+	parser *jsonio.Parser
+}
+
+func newParseJSON(zctx *zed.Context) *ParseJSON {
+	return &ParseJSON{zctx: zctx, parser: jsonio.NewParser(zctx)}
+}
+
+func (p *ParseJSON) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	in := &args[0]
+	if !in.IsString() {
+		return p.zctx.WrapError("parse_json: string arg required", in)
+	}
+	if in.IsNull() {
+		return zed.Null
+	}
+	val, err := p.parser.Parse(in.Bytes)
+	if err != nil {
+		return p.zctx.WrapError("parse_json: "+err.Error(), in)
+	}
+	return ctx.CopyValue(val)
+}
@@ -1,6 +1,7 @@
 package function
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/brimdata/zed"
@@ -41,3 +42,106 @@ func (r *Regexp) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 	}
 	return ctx.NewValue(r.typ, r.builder.Bytes())
 }
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#regexp_replace
+type RegexpReplace struct {
+	zctx  *zed.Context
+	re    *regexp.Regexp
+	restr string
+	err   error
+}
+
+func (r *RegexpReplace) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	sVal, reVal, newVal := &args[0], &args[1], &args[2]
+	for i := range args {
+		if !args[i].IsString() {
+			return r.zctx.WrapError("regexp_replace: string args required", &args[i])
+		}
+	}
+	if sVal.IsNull() {
+		return zed.NullString
+	}
+	if reVal.IsNull() || newVal.IsNull() {
+		return newErrorf(r.zctx, ctx, "regexp_replace: an input arg is null")
+	}
+	if restr := zed.DecodeString(reVal.Bytes); r.restr != restr || r.re == nil && r.err == nil {
+		r.restr = restr
+		r.re, r.err = regexp.Compile(restr)
+	}
+	if r.err != nil {
+		return newErrorf(r.zctx, ctx, "regexp_replace: %s", r.err)
+	}
+	return newString(ctx, r.re.ReplaceAllString(zed.DecodeString(sVal.Bytes), zed.DecodeString(newVal.Bytes)))
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#regexp_extract
+type RegexpExtract struct {
+	zctx    *zed.Context
+	name    string
+	builder zcode.Builder
+	re      *regexp.Regexp
+	restr   string
+	err     error
+	typ     zed.Type
+	// groups holds the submatch indexes of the named capture groups in
+	// the order of the fields of typ.
+	groups []int
+}
+
+func (r *RegexpExtract) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	reVal, sVal := &args[0], &args[1]
+	if !reVal.IsString() || reVal.IsNull() {
+		return r.zctx.WrapError(r.name+": string required for first arg", reVal)
+	}
+	if restr := zed.DecodeString(reVal.Bytes); r.restr != restr || r.re == nil && r.err == nil {
+		r.restr = restr
+		r.err = r.compile(restr)
+	}
+	if r.err != nil {
+		return newErrorf(r.zctx, ctx, "%s: %s", r.name, r.err)
+	}
+	if !sVal.IsString() {
+		return r.zctx.WrapError(r.name+": string required for second arg", sVal)
+	}
+	if sVal.IsNull() {
+		return ctx.NewValue(r.typ, nil)
+	}
+	match := r.re.FindSubmatchIndex(sVal.Bytes)
+	if match == nil {
+		return newErrorf(r.zctx, ctx, "%s: no match (%q)", r.name, sVal.Bytes)
+	}
+	r.builder.Reset()
+	for _, k := range r.groups {
+		if start := match[2*k]; start >= 0 {
+			r.builder.Append(sVal.Bytes[start:match[2*k+1]])
+		} else {
+			r.builder.Append(nil)
+		}
+	}
+	return ctx.NewValue(r.typ, r.builder.Bytes())
+}
+
+func (r *RegexpExtract) compile(restr string) error {
+	re, err := regexp.Compile(restr)
+	if err != nil {
+		return err
+	}
+	var fields []zed.Field
+	var groups []int
+	for k, name := range re.SubexpNames() {
+		if name != "" {
+			fields = append(fields, zed.NewField(name, zed.TypeString))
+			groups = append(groups, k)
+		}
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("regular expression has no named capture groups: %q", restr)
+	}
+	typ, err := r.zctx.LookupTypeRecord(fields)
+	if err != nil {
+		// The only error is a duplicate field name.
+		return fmt.Errorf("regular expression has duplicate capture group names: %q", restr)
+	}
+	r.re, r.typ, r.groups = re, typ, groups
+	return nil
+}
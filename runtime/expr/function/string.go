@@ -1,11 +1,13 @@
 package function
 
 import (
+	"bytes"
 	"strings"
 	"unicode/utf8"
 
 	"github.com/agnivade/levenshtein"
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/runtime/expr/coerce"
 	"github.com/brimdata/zed/zcode"
 )
 
@@ -184,3 +186,202 @@ func (l *Levenshtein) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
 	as, bs := zed.DecodeString(a.Bytes), zed.DecodeString(b.Bytes)
 	return newInt64(ctx, int64(levenshtein.ComputeDistance(as, bs)))
 }
+
+// intArg returns the value of an integer argument.
+func intArg(val *zed.Value) (int, bool) {
+	if !zed.IsInteger(zed.TypeUnder(val.Type).ID()) || val.IsNull() {
+		return 0, false
+	}
+	n, ok := coerce.ToInt(val)
+	return int(n), ok
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#substring
+type Substring struct {
+	zctx *zed.Context
+}
+
+func (s *Substring) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	sVal := &args[0]
+	if !sVal.IsString() {
+		return s.zctx.WrapError("substring: string arg required", sVal)
+	}
+	start, ok := intArg(&args[1])
+	if !ok {
+		return s.zctx.WrapError("substring: start must be an integer", &args[1])
+	}
+	if sVal.IsNull() {
+		return zed.NullString
+	}
+	runes := []rune(zed.DecodeString(sVal.Bytes))
+	if start < 0 {
+		start += len(runes)
+	}
+	start = clamp(start, 0, len(runes))
+	end := len(runes)
+	if len(args) == 3 {
+		length, ok := intArg(&args[2])
+		if !ok || length < 0 {
+			return s.zctx.WrapError("substring: length must be a non-negative integer", &args[2])
+		}
+		if length < end-start {
+			end = start + length
+		}
+	}
+	return newString(ctx, string(runes[start:end]))
+}
+
+func clamp(n, lo, hi int) int {
+	if n < lo {
+		return lo
+	}
+	if n > hi {
+		return hi
+	}
+	return n
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#index_of
+type IndexOf struct {
+	zctx *zed.Context
+}
+
+func (i *IndexOf) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	sVal, subVal := &args[0], &args[1]
+	if !sVal.IsString() {
+		return i.zctx.WrapError("index_of: string args required", sVal)
+	}
+	if !subVal.IsString() {
+		return i.zctx.WrapError("index_of: string args required", subVal)
+	}
+	if sVal.IsNull() || subVal.IsNull() {
+		return zed.NullInt64
+	}
+	s := zed.DecodeString(sVal.Bytes)
+	off := strings.Index(s, zed.DecodeString(subVal.Bytes))
+	if off < 0 {
+		return newInt64(ctx, -1)
+	}
+	return newInt64(ctx, int64(utf8.RuneCountInString(s[:off])))
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#starts_with
+type HasAffix struct {
+	zctx   *zed.Context
+	name   string
+	suffix bool
+}
+
+func (h *HasAffix) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	sVal, affixVal := &args[0], &args[1]
+	if !sVal.IsString() {
+		return h.zctx.WrapError(h.name+": string args required", sVal)
+	}
+	if !affixVal.IsString() {
+		return h.zctx.WrapError(h.name+": string args required", affixVal)
+	}
+	if sVal.IsNull() || affixVal.IsNull() {
+		return zed.NullBool
+	}
+	var ok bool
+	if h.suffix {
+		ok = bytes.HasSuffix(sVal.Bytes, affixVal.Bytes)
+	} else {
+		ok = bytes.HasPrefix(sVal.Bytes, affixVal.Bytes)
+	}
+	if ok {
+		return zed.True
+	}
+	return zed.False
+}
+
+// maxStringLen bounds the length in bytes of the strings built by pad_left,
+// pad_right, and repeat so a huge width or count yields an error instead of
+// exhausting memory.
+const maxStringLen = 100 * 1024 * 1024
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#pad_left
+type Pad struct {
+	zctx  *zed.Context
+	name  string
+	right bool
+}
+
+func (p *Pad) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	sVal := &args[0]
+	if !sVal.IsString() {
+		return p.zctx.WrapError(p.name+": string arg required", sVal)
+	}
+	width, ok := intArg(&args[1])
+	if !ok {
+		return p.zctx.WrapError(p.name+": width must be an integer", &args[1])
+	}
+	pad := " "
+	if len(args) == 3 {
+		padVal := &args[2]
+		if !padVal.IsString() || len(padVal.Bytes) == 0 {
+			return p.zctx.WrapError(p.name+": pad must be a non-empty string", padVal)
+		}
+		pad = zed.DecodeString(padVal.Bytes)
+	}
+	if sVal.IsNull() {
+		return zed.NullString
+	}
+	s := zed.DecodeString(sVal.Bytes)
+	n := width - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return newString(ctx, s)
+	}
+	if n > (maxStringLen-len(s))/len(pad) {
+		return p.zctx.WrapError(p.name+": result too long", &args[1])
+	}
+	padRunes := []rune(pad)
+	fill := []rune(strings.Repeat(pad, (n+len(padRunes)-1)/len(padRunes)))[:n]
+	if p.right {
+		return newString(ctx, s+string(fill))
+	}
+	return newString(ctx, string(fill)+s)
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#repeat
+type Repeat struct {
+	zctx *zed.Context
+}
+
+func (r *Repeat) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	sVal := &args[0]
+	if !sVal.IsString() {
+		return r.zctx.WrapError("repeat: string arg required", sVal)
+	}
+	count, ok := intArg(&args[1])
+	if !ok || count < 0 {
+		return r.zctx.WrapError("repeat: count must be a non-negative integer", &args[1])
+	}
+	if sVal.IsNull() {
+		return zed.NullString
+	}
+	if count > 0 && len(sVal.Bytes) > maxStringLen/count {
+		return r.zctx.WrapError("repeat: result too long", &args[1])
+	}
+	return newString(ctx, strings.Repeat(zed.DecodeString(sVal.Bytes), count))
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#reverse
+type Reverse struct {
+	zctx *zed.Context
+}
+
+func (r *Reverse) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	sVal := &args[0]
+	if !sVal.IsString() {
+		return r.zctx.WrapError("reverse: string arg required", sVal)
+	}
+	if sVal.IsNull() {
+		return zed.NullString
+	}
+	runes := []rune(zed.DecodeString(sVal.Bytes))
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return newString(ctx, string(runes))
+}
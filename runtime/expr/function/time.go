@@ -1,9 +1,14 @@
 package function
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/pkg/nano"
+	"github.com/brimdata/zed/pkg/strftime"
 	"github.com/brimdata/zed/runtime/expr/coerce"
+	"github.com/brimdata/zed/zson"
 )
 
 // https://github.com/brimdata/zed/blob/main/docs/language/functions.md#now
@@ -52,3 +57,174 @@ func (b *Bucket) String() string {
 	}
 	return b.name
 }
+
+// timeArg converts val to a time in the location named by the optional
+// time zone argument in args, which are the arguments that follow val.
+func timeArg(zctx *zed.Context, ctx zed.Allocator, name string, zones *zones, val *zed.Value, args []zed.Value) (time.Time, *zed.Value) {
+	ts, ok := coerce.ToTime(val)
+	if !ok {
+		return time.Time{}, newErrorf(zctx, ctx, "%s: time arg required", name)
+	}
+	t := ts.Time()
+	if len(args) == 0 {
+		return t, nil
+	}
+	tz := &args[0]
+	if !tz.IsString() || tz.IsNull() {
+		return time.Time{}, newErrorf(zctx, ctx, "%s: time zone arg must be a string", name)
+	}
+	loc, err := zones.lookup(string(tz.Bytes))
+	if err != nil {
+		return time.Time{}, newErrorf(zctx, ctx, "%s: %s", name, err)
+	}
+	return t.In(loc), nil
+}
+
+// zones caches time zones by name since the time zone argument of a
+// function is usually a constant.
+type zones map[string]*time.Location
+
+func (z *zones) lookup(name string) (*time.Location, error) {
+	if loc, ok := (*z)[name]; ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	if *z == nil {
+		*z = make(zones)
+	}
+	(*z)[name] = loc
+	return loc, nil
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#strftime
+type Strftime struct {
+	zctx  *zed.Context
+	zones zones
+	bytes []byte
+}
+
+func (s *Strftime) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	formatArg := &args[0]
+	if !formatArg.IsString() {
+		return newErrorf(s.zctx, ctx, "strftime: format arg must be a string")
+	}
+	if formatArg.IsNull() || args[1].IsNull() {
+		return zed.NullString
+	}
+	t, errVal := timeArg(s.zctx, ctx, "strftime", &s.zones, &args[1], args[2:])
+	if errVal != nil {
+		return errVal
+	}
+	s.bytes = strftime.AppendFormat(s.bytes[:0], string(formatArg.Bytes), t)
+	return newString(ctx, string(s.bytes))
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#strptime
+type Strptime struct {
+	zctx *zed.Context
+}
+
+func (s *Strptime) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	formatArg, val := &args[0], &args[1]
+	if !formatArg.IsString() {
+		return newErrorf(s.zctx, ctx, "strptime: format arg must be a string")
+	}
+	if !val.IsString() {
+		return newErrorf(s.zctx, ctx, "strptime: string arg required")
+	}
+	if formatArg.IsNull() || val.IsNull() {
+		return zed.NullTime
+	}
+	t, err := strftime.Parse(string(formatArg.Bytes), string(val.Bytes))
+	if err != nil {
+		return newErrorf(s.zctx, ctx, "strptime: %s (bad argument: %s)", err, zson.String(val))
+	}
+	return newTime(ctx, nano.TimeToTs(t))
+}
+
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#time_trunc
+type TimeTrunc struct {
+	zctx  *zed.Context
+	zones zones
+}
+
+func (t *TimeTrunc) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	unitArg := &args[0]
+	if !unitArg.IsString() || unitArg.IsNull() {
+		return newErrorf(t.zctx, ctx, "time_trunc: unit arg must be a string")
+	}
+	if args[1].IsNull() {
+		return zed.NullTime
+	}
+	ts, errVal := timeArg(t.zctx, ctx, "time_trunc", &t.zones, &args[1], args[2:])
+	if errVal != nil {
+		return errVal
+	}
+	year, month, day := ts.Date()
+	hour, min, sec := ts.Clock()
+	switch unit := string(unitArg.Bytes); unit {
+	case "year":
+		month, day, hour, min, sec = time.January, 1, 0, 0, 0
+	case "quarter":
+		month, day, hour, min, sec = month-(month-1)%3, 1, 0, 0, 0
+	case "month":
+		day, hour, min, sec = 1, 0, 0, 0
+	case "week":
+		// Weeks begin on Monday.
+		day -= (int(ts.Weekday()) + 6) % 7
+		hour, min, sec = 0, 0, 0
+	case "day":
+		hour, min, sec = 0, 0, 0
+	case "hour":
+		min, sec = 0, 0
+	case "minute":
+		sec = 0
+	case "second":
+	default:
+		return newErrorf(t.zctx, ctx, "time_trunc: unknown unit %q", unit)
+	}
+	return newTime(ctx, nano.TimeToTs(time.Date(year, month, day, hour, min, sec, 0, ts.Location())))
+}
+
+// timePart extracts a calendar field from a time as an int64.
+//
+// https://github.com/brimdata/zed/blob/main/docs/language/functions.md#year
+type timePart struct {
+	zctx  *zed.Context
+	name  string
+	part  func(time.Time) int
+	zones zones
+}
+
+func newTimePart(zctx *zed.Context, name string) *timePart {
+	var part func(time.Time) int
+	switch name {
+	case "year":
+		part = time.Time.Year
+	case "month":
+		part = func(t time.Time) int { return int(t.Month()) }
+	case "day":
+		part = time.Time.Day
+	case "day_of_week":
+		part = func(t time.Time) int { return int(t.Weekday()) }
+	case "hour":
+		part = time.Time.Hour
+	default:
+		panic(name)
+	}
+	return &timePart{zctx: zctx, name: name, part: part}
+}
+
+func (t *timePart) Call(ctx zed.Allocator, args []zed.Value) *zed.Value {
+	if args[0].IsNull() {
+		return zed.NullInt64
+	}
+	ts, errVal := timeArg(t.zctx, ctx, t.name, &t.zones, &args[0], args[1:])
+	if errVal != nil {
+		return errVal
+	}
+	return newInt64(ctx, int64(t.part(ts)))
+}
@@ -0,0 +1,192 @@
+package expr
+
+import (
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zcode"
+	"github.com/brimdata/zed/zson"
+)
+
+// Lambda is an anonymous function whose parameters are bound to the
+// variables in consecutive slots beginning at slot.  Its body may refer to
+// the variables of the scope in which it appears and to this.
+type Lambda struct {
+	slot    int
+	nparams int
+	body    Evaluator
+}
+
+func NewLambda(slot, nparams int, body Evaluator) *Lambda {
+	return &Lambda{slot: slot, nparams: nparams, body: body}
+}
+
+// enter returns a frame for calling the lambda from the scope of ectx.
+// A new frame is needed for each call of the higher-order function since
+// the lambda may be invoked recursively through a user-defined function.
+func (l *Lambda) enter(ectx Context) *lambdaFrame {
+	vars := make([]zed.Value, l.slot+l.nparams)
+	copy(vars, ectx.Vars())
+	return &lambdaFrame{Context: ectx, lambda: l, vars: vars}
+}
+
+type lambdaFrame struct {
+	Context
+	lambda *Lambda
+	vars   []zed.Value
+}
+
+func (f *lambdaFrame) Vars() []zed.Value {
+	return f.vars
+}
+
+func (f *lambdaFrame) call(this *zed.Value, args ...zed.Value) *zed.Value {
+	copy(f.vars[f.lambda.slot:], args)
+	return f.lambda.body.Eval(f, this)
+}
+
+// elems calls fn with each element of the array or set val, removing the
+// union tag from elements of a union type.  It returns false if val is not
+// an array or set.
+func elems(val *zed.Value, fn func(elem zed.Value, b zcode.Bytes) bool) bool {
+	typ := zed.InnerType(val.Type)
+	if typ == nil {
+		return false
+	}
+	union, _ := typ.(*zed.TypeUnion)
+	for it := val.Bytes.Iter(); !it.Done(); {
+		b := it.Next()
+		elem := zed.Value{Type: typ, Bytes: b}
+		if union != nil {
+			elem.Type, elem.Bytes = union.Untag(b)
+		}
+		if !fn(elem, b) {
+			break
+		}
+	}
+	return true
+}
+
+// MapArray implements map(), which applies a lambda to each element of an
+// array or set and returns an array of the results.
+type MapArray struct {
+	zctx   *zed.Context
+	expr   Evaluator
+	lambda *Lambda
+}
+
+func NewMapArray(zctx *zed.Context, e Evaluator, lambda *Lambda) *MapArray {
+	return &MapArray{zctx: zctx, expr: e, lambda: lambda}
+}
+
+func (m *MapArray) Eval(ectx Context, this *zed.Value) *zed.Value {
+	val := m.expr.Eval(ectx, this)
+	if val.IsError() {
+		return val
+	}
+	if val.IsNull() {
+		return zed.Null
+	}
+	frame := m.lambda.enter(ectx)
+	// The collection is local to each call since the lambda may evaluate
+	// the receiver recursively, and it refers to copies of the results
+	// since the lambda's evaluator may reuse its buffers on each call.
+	var collection collectionBuilder
+	ok := elems(val, func(elem zed.Value, _ zcode.Bytes) bool {
+		collection.append(frame.call(this, elem).Copy())
+		return true
+	})
+	if !ok {
+		return m.zctx.WrapError("map: array arg required", val)
+	}
+	if len(collection.types) == 0 {
+		return ectx.NewValue(m.zctx.LookupTypeArray(zed.TypeNull), []byte{})
+	}
+	var b zcode.Builder
+	it := collection.iter(m.zctx)
+	for !it.done() {
+		it.appendNext(&b)
+	}
+	return ectx.NewValue(m.zctx.LookupTypeArray(it.typ), b.Bytes())
+}
+
+// FilterArray implements filter(), which returns the elements of an array
+// or set for which a lambda returns true.
+type FilterArray struct {
+	zctx   *zed.Context
+	expr   Evaluator
+	lambda *Lambda
+}
+
+func NewFilterArray(zctx *zed.Context, e Evaluator, lambda *Lambda) *FilterArray {
+	return &FilterArray{zctx: zctx, expr: e, lambda: lambda}
+}
+
+func (f *FilterArray) Eval(ectx Context, this *zed.Value) *zed.Value {
+	val := f.expr.Eval(ectx, this)
+	if val.IsError() || val.IsNull() {
+		return val
+	}
+	frame := f.lambda.enter(ectx)
+	var b zcode.Builder
+	var errVal *zed.Value
+	ok := elems(val, func(elem zed.Value, bytes zcode.Bytes) bool {
+		result := frame.call(this, elem)
+		if zed.TypeUnder(result.Type) != zed.TypeBool {
+			errVal = result
+			if !result.IsError() {
+				errVal = f.zctx.NewErrorf("filter: not type bool: %s", zson.MustFormatValue(result))
+			}
+			return false
+		}
+		if zed.DecodeBool(result.Bytes) {
+			b.Append(bytes)
+		}
+		return true
+	})
+	if !ok {
+		return f.zctx.WrapError("filter: array arg required", val)
+	}
+	if errVal != nil {
+		return ectx.CopyValue(errVal)
+	}
+	bytes := b.Bytes()
+	if bytes == nil {
+		bytes = zcode.Bytes{}
+	}
+	return ectx.NewValue(val.Type, bytes)
+}
+
+// ReduceArray implements reduce(), which combines the elements of an array
+// or set into a single value by calling a lambda with the value accumulated
+// so far and each element in turn.
+type ReduceArray struct {
+	zctx   *zed.Context
+	expr   Evaluator
+	init   Evaluator
+	lambda *Lambda
+}
+
+func NewReduceArray(zctx *zed.Context, e, init Evaluator, lambda *Lambda) *ReduceArray {
+	return &ReduceArray{zctx: zctx, expr: e, init: init, lambda: lambda}
+}
+
+func (r *ReduceArray) Eval(ectx Context, this *zed.Value) *zed.Value {
+	val := r.expr.Eval(ectx, this)
+	if val.IsError() {
+		return val
+	}
+	acc := r.init.Eval(ectx, this).Copy()
+	if val.IsNull() {
+		return ectx.CopyValue(acc)
+	}
+	frame := r.lambda.enter(ectx)
+	ok := elems(val, func(elem zed.Value, _ zcode.Bytes) bool {
+		// The accumulator is copied since the lambda's evaluator may
+		// reuse the buffer it refers to on the next call.
+		acc = frame.call(this, *acc, elem).Copy()
+		return true
+	})
+	if !ok {
+		return r.zctx.WrapError("reduce: array arg required", val)
+	}
+	return ectx.CopyValue(acc)
+}
@@ -1,7 +1,10 @@
 package result
 
 import (
+	"math/big"
+
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/zcode"
 )
@@ -18,6 +21,16 @@ func (b *Buffer) Uint(v uint64) zcode.Bytes {
 	return zcode.Bytes(*b)
 }
 
+func (b *Buffer) BigInt(v *big.Int) zcode.Bytes {
+	*b = Buffer(zed.AppendBigInt(zcode.Bytes((*b)[:0]), v))
+	return zcode.Bytes(*b)
+}
+
+func (b *Buffer) BigUint(v *big.Int) zcode.Bytes {
+	*b = Buffer(zed.AppendBigUint(zcode.Bytes((*b)[:0]), v))
+	return zcode.Bytes(*b)
+}
+
 func (b *Buffer) Float32(v float32) zcode.Bytes {
 	*b = Buffer(zed.AppendFloat32(zcode.Bytes((*b)[:0]), v))
 	return zcode.Bytes(*b)
@@ -28,6 +41,16 @@ func (b *Buffer) Float64(v float64) zcode.Bytes {
 	return zcode.Bytes(*b)
 }
 
+func (b *Buffer) BigFloat(id int, v *big.Float) zcode.Bytes {
+	*b = Buffer(zed.AppendBigFloat(zcode.Bytes((*b)[:0]), id, v))
+	return zcode.Bytes(*b)
+}
+
+func (b *Buffer) Decimal(id int, v *decimal.Decimal) zcode.Bytes {
+	*b = Buffer(zed.AppendDecimal(zcode.Bytes((*b)[:0]), id, v))
+	return zcode.Bytes(*b)
+}
+
 func (b *Buffer) Time(v nano.Ts) zcode.Bytes {
 	*b = Buffer(zed.AppendTime(zcode.Bytes((*b)[:0]), v))
 	return zcode.Bytes(*b)
@@ -8,6 +8,8 @@ import (
 
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/order"
+	"github.com/brimdata/zed/pkg/bigfloat"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/runtime/expr/coerce"
 	"github.com/brimdata/zed/zcode"
 	"github.com/brimdata/zed/zson"
@@ -50,6 +52,19 @@ func (s *Sorter) SortStable(vals []zed.Value, cmp *Comparator) {
 				} else {
 					s.i64s[i] = math.MinInt64
 				}
+			} else if zed.IsBigInt(id) {
+				// Clamp to the int64 range, as with a uint64
+				// below, so values outside it are compared
+				// in full.
+				v, _ := coerce.ToBigInt(val)
+				switch {
+				case v.IsInt64():
+					s.i64s[i] = v.Int64()
+				case v.Sign() < 0:
+					s.i64s[i] = math.MinInt64
+				default:
+					s.i64s[i] = math.MaxInt64
+				}
 			} else if zed.IsSigned(id) {
 				s.i64s[i] = zed.DecodeInt(val.Bytes)
 			} else {
@@ -343,6 +358,26 @@ func LookupCompare(typ zed.Type) comparefn {
 			return 0
 		}
 
+	case zed.IDInt128, zed.IDInt256:
+		return func(a, b zcode.Bytes) int {
+			return zed.DecodeBigInt(a).Cmp(zed.DecodeBigInt(b))
+		}
+
+	case zed.IDUint128, zed.IDUint256:
+		return func(a, b zcode.Bytes) int {
+			return zed.DecodeBigUint(a).Cmp(zed.DecodeBigUint(b))
+		}
+
+	case zed.IDFloat128, zed.IDFloat256:
+		return func(a, b zcode.Bytes) int {
+			return bigfloat.Cmp(zed.DecodeBigFloat(a), zed.DecodeBigFloat(b))
+		}
+
+	case zed.IDDecimal32, zed.IDDecimal64, zed.IDDecimal128, zed.IDDecimal256:
+		return func(a, b zcode.Bytes) int {
+			return decimal.Cmp(zed.DecodeDecimal(a), zed.DecodeDecimal(b))
+		}
+
 	case zed.IDTime:
 		return func(a, b zcode.Bytes) int {
 			va, vb := zed.DecodeTime(a), zed.DecodeTime(b)
@@ -0,0 +1,637 @@
+package join
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"sync"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/decimal"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/runtime/expr/coerce"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/runtime/op/spill"
+	"github.com/brimdata/zed/zbuf"
+)
+
+// MemMaxBytes specifies the maximum amount of memory that each hash join
+// will consume for its hash table (and for any lefthand records buffered
+// while the table is built) before spilling to disk.  Since all righthand
+// records with the same join key are joined in memory at once, a join key
+// whose righthand records exceed MemMaxBytes is not held to this limit.
+var MemMaxBytes = 128 * 1024 * 1024
+
+// numPartitions is the number of temporary files into which each input of
+// a spilled hash join is partitioned.
+const numPartitions = 16
+
+// maxPartitionLevel is the number of times a righthand partition that
+// exceeds MemMaxBytes may be partitioned again before it is joined in
+// memory regardless of its size.
+const maxPartitionLevel = 4
+
+// batchLen is the number of joined values sent downstream per batch.
+const batchLen = 1024
+
+// HashProc is a join that does not require sorted inputs.  It builds a hash
+// table from the righthand input then streams the lefthand input through
+// the table.  If the table grows beyond MemMaxBytes, both inputs are
+// partitioned by the hash of their join keys into temporary files and each
+// pair of partitions is joined in turn, with any righthand partition still
+// larger than MemMaxBytes partitioned again using a different hash.  The
+// output follows the order of the lefthand input unless the inputs were
+// spilled.
+type HashProc struct {
+	pctx        *op.Context
+	anti        bool
+	inner       bool
+	full        bool
+	ctx         context.Context
+	cancel      context.CancelFunc
+	once        sync.Once
+	left        *puller
+	right       *puller
+	getLeftKey  expr.Evaluator
+	getRightKey expr.Evaluator
+	cutter      *expr.Cutter
+	splicer     *splicer
+	unmatcher   *unmatcher
+	// keyless holds the righthand records of a full join that have no
+	// join key.
+	keyless  []zed.Value
+	resultCh chan op.Result
+	ectx     expr.Context
+	keyBuf   []byte
+	out      []zed.Value
+}
+
+// NewHash returns a hash join of left and right.  For a full join,
+// leftKeyPath is the field holding the left key, or nil if the left key is
+// not a field (see newUnmatcher).
+func NewHash(pctx *op.Context, anti, inner, full bool, left, right zbuf.Puller, leftKey, rightKey expr.Evaluator, leftKeyPath field.Path, lhs field.List, rhs []expr.Evaluator) (*HashProc, error) {
+	cutter, err := expr.NewCutter(pctx.Zctx, lhs, rhs)
+	if err != nil {
+		return nil, err
+	}
+	var u *unmatcher
+	if full {
+		if u, err = newUnmatcher(pctx.Zctx, leftKeyPath, rightKey, lhs, rhs); err != nil {
+			return nil, err
+		}
+	}
+	ctx, cancel := context.WithCancel(pctx.Context)
+	return &HashProc{
+		pctx:        pctx,
+		anti:        anti,
+		inner:       inner,
+		full:        full,
+		ctx:         ctx,
+		cancel:      cancel,
+		left:        newPuller(left, ctx),
+		right:       newPuller(right, ctx),
+		getLeftKey:  leftKey,
+		getRightKey: rightKey,
+		cutter:      cutter,
+		splicer:     newSplicer(pctx.Zctx),
+		unmatcher:   u,
+		resultCh:    make(chan op.Result),
+		ectx:        expr.NewContext(),
+	}, nil
+}
+
+func (h *HashProc) Pull(done bool) (zbuf.Batch, error) {
+	// XXX see issue #3437 regarding done protocol.
+	h.once.Do(func() {
+		go h.left.run()
+		go h.right.run()
+		// Block pctx's cancel function until h.run finishes its
+		// cleanup.
+		h.pctx.WaitGroup.Add(1)
+		go h.run()
+	})
+	r, ok := <-h.resultCh
+	if !ok {
+		return nil, h.pctx.Err()
+	}
+	return r.Batch, r.Err
+}
+
+func (h *HashProc) run() {
+	defer func() {
+		close(h.resultCh)
+		h.cancel()
+		// Tell pctx's cancel function that we've finished our cleanup.
+		h.pctx.WaitGroup.Done()
+	}()
+	if err := h.join(); err != nil {
+		h.sendResult(nil, err)
+		return
+	}
+	if h.flush() {
+		h.sendResult(nil, nil)
+	}
+}
+
+func (h *HashProc) join() error {
+	table := newHashTable()
+	var queue leftQueue
+	defer queue.close()
+	var parts *partitions
+	defer func() {
+		if parts != nil {
+			parts.close()
+		}
+	}()
+	// Read the righthand input into the hash table.  The lefthand
+	// input is buffered in the meantime since both inputs may be fed
+	// by a common upstream that would otherwise block.
+	leftCh, rightCh := h.left.ch, h.right.ch
+	for rightCh != nil {
+		select {
+		case res := <-rightCh:
+			if res.Err != nil {
+				return res.Err
+			}
+			if res.Batch == nil {
+				rightCh = nil
+				continue
+			}
+			for _, val := range res.Batch.Values() {
+				key := h.getRightKey.Eval(h.ectx, &val)
+				if key.IsMissing() {
+					if h.full {
+						h.keyless = append(h.keyless, *val.Copy())
+					}
+					continue
+				}
+				hkey := h.hashKey(key)
+				if parts != nil {
+					if err := parts.writeRight(hkey, &val); err != nil {
+						return err
+					}
+					continue
+				}
+				table.insert(hkey, val.Copy())
+				if table.nbytes >= MemMaxBytes {
+					var err error
+					if parts, err = table.spill(); err != nil {
+						return err
+					}
+					table = nil
+				}
+			}
+			res.Batch.Unref()
+		case res := <-leftCh:
+			if res.Err != nil {
+				return res.Err
+			}
+			if res.Batch == nil {
+				leftCh = nil
+				continue
+			}
+			if err := queue.push(res.Batch); err != nil {
+				return err
+			}
+			res.Batch.Unref()
+		case <-h.ctx.Done():
+			return h.ctx.Err()
+		}
+	}
+	if err := queue.rewind(h.pctx.Zctx); err != nil {
+		return err
+	}
+	if parts == nil {
+		if err := h.probeAll(table, &queue); err != nil {
+			return err
+		}
+		if leftCh != nil {
+			if err := h.probeAll(table, h.left); err != nil {
+				return err
+			}
+		}
+		if err := h.appendUnmatched(table); err != nil {
+			return err
+		}
+		return h.appendKeyless()
+	}
+	if err := parts.partition(h, &queue); err != nil {
+		return err
+	}
+	if leftCh != nil {
+		if err := parts.partition(h, h.left); err != nil {
+			return err
+		}
+	}
+	if err := parts.join(h); err != nil {
+		return err
+	}
+	return h.appendKeyless()
+}
+
+// probeAll looks up each record from r in table and appends the join
+// results to the pending output.
+func (h *HashProc) probeAll(table *hashTable, r interface {
+	Read() (*zed.Value, error)
+}) error {
+	for {
+		val, err := r.Read()
+		if val == nil || err != nil {
+			return err
+		}
+		key := h.getLeftKey.Eval(h.ectx, val)
+		if key.IsMissing() {
+			// As with the merge join, drop lefthand records that
+			// can't eval the key expression.
+			continue
+		}
+		entry := table.lookup(h.hashKey(key))
+		if entry == nil {
+			if !h.inner {
+				h.out = append(h.out, *val.Copy())
+			}
+		} else if !h.anti {
+			entry.matched = true
+			for _, rightVal := range entry.vals {
+				cutVal := h.cutter.Eval(h.ectx, rightVal)
+				rec, err := h.splicer.splice(val, cutVal)
+				if err != nil {
+					return err
+				}
+				h.out = append(h.out, *rec)
+			}
+		}
+		if len(h.out) >= batchLen && !h.flush() {
+			return h.ctx.Err()
+		}
+	}
+}
+
+// appendUnmatched appends the righthand records that did not match any
+// lefthand record to the pending output of a full join.
+func (h *HashProc) appendUnmatched(table *hashTable) error {
+	if !h.full {
+		return nil
+	}
+	for _, entry := range table.entries {
+		if entry.matched {
+			continue
+		}
+		if err := h.appendUnmatchedVals(entry.vals); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendKeyless appends the righthand records without a join key to the
+// pending output of a full join.
+func (h *HashProc) appendKeyless() error {
+	vals := make([]*zed.Value, 0, len(h.keyless))
+	for k := range h.keyless {
+		vals = append(vals, &h.keyless[k])
+	}
+	h.keyless = nil
+	return h.appendUnmatchedVals(vals)
+}
+
+func (h *HashProc) appendUnmatchedVals(vals []*zed.Value) error {
+	for _, val := range vals {
+		val, err := h.unmatcher.eval(h.ectx, val)
+		if err != nil {
+			return err
+		}
+		h.out = append(h.out, *val.Copy())
+		if len(h.out) >= batchLen && !h.flush() {
+			return h.ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (h *HashProc) flush() bool {
+	if len(h.out) == 0 {
+		return true
+	}
+	out := h.out
+	h.out = nil
+	return h.sendResult(zbuf.NewArray(out), nil)
+}
+
+func (h *HashProc) sendResult(b zbuf.Batch, err error) bool {
+	select {
+	case h.resultCh <- op.Result{Batch: b, Err: err}:
+		return true
+	case <-h.ctx.Done():
+		return false
+	}
+}
+
+// hashKey returns a string that is the same for any two join keys that the
+// merge join would consider equal, e.g., an int32 and a uint64 holding the
+// same number.
+func (h *HashProc) hashKey(key *zed.Value) string {
+	b := h.keyBuf[:0]
+	id := key.Type.ID()
+	switch {
+	case key.IsNull():
+		b = append(b, 'n')
+	case zed.IsDecimal(id), zed.IsBigInt(id), zed.IsBigFloat(id):
+		// A decimal or 128- or 256-bit number equal to an int64 or to
+		// the float64 it converts to is keyed like that number.
+		d, _ := coerce.ToDecimal(key)
+		f := d.Float64()
+		if i, ok := d.Int64(); ok && decimal.Cmp(d, decimal.New(i, 0)) == 0 {
+			b = binary.AppendVarint(append(b, 'i'), i)
+		} else if decimal.Cmp(d, decimal.NewFromFloat(f, 64)) == 0 && !d.IsNaN() {
+			b = binary.BigEndian.AppendUint64(append(b, 'f'), math.Float64bits(f))
+		} else {
+			b = append(append(b, 'd'), d.Reduce().String()...)
+		}
+	case id == zed.IDDuration || id == zed.IDTime:
+		// Durations and times are keyed by type so they don't match
+		// integers with the same number of nanoseconds.
+		b = binary.AppendUvarint(append(b, 'v'), uint64(id))
+		b = binary.AppendVarint(b, zed.DecodeInt(key.Bytes))
+	case zed.IsSigned(id):
+		b = binary.AppendVarint(append(b, 'i'), zed.DecodeInt(key.Bytes))
+	case zed.IsInteger(id):
+		u := zed.DecodeUint(key.Bytes)
+		if u <= math.MaxInt64 {
+			b = binary.AppendVarint(append(b, 'i'), int64(u))
+		} else {
+			b = binary.AppendUvarint(append(b, 'u'), u)
+		}
+	case zed.IsFloat(id):
+		f := zed.DecodeFloat(key.Bytes)
+		if f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+			b = binary.AppendVarint(append(b, 'i'), int64(f))
+		} else {
+			b = binary.BigEndian.AppendUint64(append(b, 'f'), math.Float64bits(f))
+		}
+	default:
+		b = binary.AppendUvarint(append(b, 'v'), uint64(id))
+		b = append(b, key.Bytes...)
+	}
+	h.keyBuf = b
+	return string(b)
+}
+
+type hashTable struct {
+	entries []*hashEntry
+	index   map[string]*hashEntry
+	nbytes  int
+}
+
+type hashEntry struct {
+	key     string
+	vals    []*zed.Value
+	matched bool
+}
+
+func newHashTable() *hashTable {
+	return &hashTable{index: make(map[string]*hashEntry)}
+}
+
+func (t *hashTable) insert(key string, val *zed.Value) {
+	entry, ok := t.index[key]
+	if !ok {
+		entry = &hashEntry{key: key}
+		t.index[key] = entry
+		t.entries = append(t.entries, entry)
+		t.nbytes += len(key)
+	}
+	entry.vals = append(entry.vals, val)
+	t.nbytes += len(val.Bytes)
+}
+
+func (t *hashTable) lookup(key string) *hashEntry {
+	return t.index[key]
+}
+
+// spill moves the contents of the table into a new set of righthand
+// partitions.
+func (t *hashTable) spill() (*partitions, error) {
+	parts := newPartitions(0)
+	for _, entry := range t.entries {
+		for _, val := range entry.vals {
+			if err := parts.writeRight(entry.key, val); err != nil {
+				parts.close()
+				return nil, err
+			}
+		}
+	}
+	return parts, nil
+}
+
+// partitions holds the temporary files of a spilled hash join.  Records
+// whose join keys hash to the same partition are written to the same file
+// so each pair of lefthand and righthand files can be joined independently.
+// Each set of partitions hashes with its own seed so that records sharing
+// a partition at one level are spread across partitions at the next.
+type partitions struct {
+	level      int
+	seed       maphash.Seed
+	left       [numPartitions]*spill.File
+	right      [numPartitions]*spill.File
+	rightBytes [numPartitions]int
+}
+
+func newPartitions(level int) *partitions {
+	return &partitions{level: level, seed: maphash.MakeSeed()}
+}
+
+func (p *partitions) index(key string) int {
+	return int(maphash.String(p.seed, key) % numPartitions)
+}
+
+func (p *partitions) writeRight(key string, val *zed.Value) error {
+	k := p.index(key)
+	p.rightBytes[k] += len(val.Bytes)
+	return p.write(p.right[:], k, val)
+}
+
+func (p *partitions) write(files []*spill.File, k int, val *zed.Value) error {
+	if files[k] == nil {
+		f, err := spill.NewTempFile()
+		if err != nil {
+			return err
+		}
+		files[k] = f
+	}
+	return files[k].Write(val)
+}
+
+// partition writes each record from r to its lefthand partition.
+func (p *partitions) partition(h *HashProc, r interface {
+	Read() (*zed.Value, error)
+}) error {
+	for {
+		val, err := r.Read()
+		if val == nil || err != nil {
+			return err
+		}
+		key := h.getLeftKey.Eval(h.ectx, val)
+		if key.IsMissing() {
+			continue
+		}
+		if err := p.write(p.left[:], p.index(h.hashKey(key)), val); err != nil {
+			return err
+		}
+	}
+}
+
+// join joins each pair of partitions in memory, first partitioning again
+// any pair whose righthand partition exceeds MemMaxBytes.
+func (p *partitions) join(h *HashProc) error {
+	for k := 0; k < numPartitions; k++ {
+		var err error
+		if p.right[k] != nil && p.rightBytes[k] >= MemMaxBytes && p.level < maxPartitionLevel {
+			err = p.repartition(h, k)
+		} else {
+			err = p.joinInMemory(h, k)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repartition partitions the kth pair of partitions into a new set of
+// partitions at the next level and joins them.
+func (p *partitions) repartition(h *HashProc, k int) error {
+	sub := newPartitions(p.level + 1)
+	defer sub.close()
+	if err := p.right[k].Rewind(h.pctx.Zctx); err != nil {
+		return err
+	}
+	for {
+		val, err := p.right[k].Read()
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			break
+		}
+		key := h.getRightKey.Eval(h.ectx, val)
+		if err := sub.writeRight(h.hashKey(key), val); err != nil {
+			return err
+		}
+	}
+	if f := p.left[k]; f != nil {
+		if err := f.Rewind(h.pctx.Zctx); err != nil {
+			return err
+		}
+		if err := sub.partition(h, f); err != nil {
+			return err
+		}
+	}
+	p.closePartition(k)
+	return sub.join(h)
+}
+
+func (p *partitions) joinInMemory(h *HashProc, k int) error {
+	table := newHashTable()
+	if f := p.right[k]; f != nil {
+		if err := f.Rewind(h.pctx.Zctx); err != nil {
+			return err
+		}
+		for {
+			val, err := f.Read()
+			if err != nil {
+				return err
+			}
+			if val == nil {
+				break
+			}
+			key := h.getRightKey.Eval(h.ectx, val)
+			table.insert(h.hashKey(key), val.Copy())
+		}
+	}
+	if f := p.left[k]; f != nil {
+		if err := f.Rewind(h.pctx.Zctx); err != nil {
+			return err
+		}
+		if err := h.probeAll(table, f); err != nil {
+			return err
+		}
+	}
+	return h.appendUnmatched(table)
+}
+
+// closePartition removes the temporary files of the kth pair of partitions.
+func (p *partitions) closePartition(k int) {
+	for _, files := range [][]*spill.File{p.left[:], p.right[:]} {
+		if f := files[k]; f != nil {
+			f.CloseAndRemove()
+			files[k] = nil
+		}
+	}
+}
+
+func (p *partitions) close() {
+	for k := 0; k < numPartitions; k++ {
+		p.closePartition(k)
+	}
+}
+
+// leftQueue buffers the lefthand records that arrive while the hash table
+// is being built, spilling them to a temporary file if they exceed
+// MemMaxBytes.
+type leftQueue struct {
+	vals   []zed.Value
+	nbytes int
+	file   *spill.File
+}
+
+func (q *leftQueue) push(batch zbuf.Batch) error {
+	for _, val := range batch.Values() {
+		if q.file != nil {
+			if err := q.file.Write(&val); err != nil {
+				return err
+			}
+			continue
+		}
+		q.vals = append(q.vals, *val.Copy())
+		q.nbytes += len(val.Bytes)
+		if q.nbytes >= MemMaxBytes {
+			f, err := spill.NewTempFile()
+			if err != nil {
+				return err
+			}
+			q.file = f
+		}
+	}
+	return nil
+}
+
+func (q *leftQueue) rewind(zctx *zed.Context) error {
+	if q.file != nil {
+		return q.file.Rewind(zctx)
+	}
+	return nil
+}
+
+// Read returns the buffered records in memory followed by those in the
+// spill file.
+func (q *leftQueue) Read() (*zed.Value, error) {
+	if len(q.vals) > 0 {
+		val := &q.vals[0]
+		q.vals = q.vals[1:]
+		return val, nil
+	}
+	if q.file != nil {
+		return q.file.Read()
+	}
+	return nil, nil
+}
+
+func (q *leftQueue) close() {
+	if q.file != nil {
+		q.file.CloseAndRemove()
+		q.file = nil
+	}
+}
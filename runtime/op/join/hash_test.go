@@ -0,0 +1,67 @@
+package join_test
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/zed/runtime/op/join"
+	"github.com/brimdata/zed/ztest"
+)
+
+func TestHashJoinSpill(t *testing.T) {
+	saved := join.MemMaxBytes
+	join.MemMaxBytes = 1024
+	defer func() {
+		join.MemMaxBytes = saved
+	}()
+	// Create enough records to exceed 4 * join.MemMaxBytes and
+	// present them out of order so the join can't be a merge join.
+	var in, out strings.Builder
+	n := 4 * join.MemMaxBytes / 16
+	for _, k := range rand.Perm(n) {
+		in.WriteString(fmt.Sprintf("{k:%d,s:\"%016x\"}\n", k, k))
+	}
+	for k := 0; k < n; k++ {
+		out.WriteString(fmt.Sprintf("{k:%d,s:\"%016x\",t:\"%016x\"}\n", k, k, k))
+	}
+	(&ztest.ZTest{
+		Zed:    "fork (=> pass => pass) | join on k=k t:=s | sort k",
+		Input:  in.String(),
+		Output: out.String(),
+	}).Run(t, "", "")
+}
+
+func TestHashJoinSpillRepartition(t *testing.T) {
+	saved := join.MemMaxBytes
+	join.MemMaxBytes = 1024
+	defer func() {
+		join.MemMaxBytes = saved
+	}()
+	// Create enough records that each of the spilled partitions exceeds
+	// join.MemMaxBytes and must be partitioned again, plus records
+	// sharing one key that no partitioning can split.
+	var in, out strings.Builder
+	n := 64 * join.MemMaxBytes / 16
+	for _, k := range rand.Perm(n) {
+		in.WriteString(fmt.Sprintf("{k:%d,s:\"%016x\"}\n", k, k))
+	}
+	const dups = 64
+	for k := 0; k < dups; k++ {
+		in.WriteString(fmt.Sprintf("{k:%d,s:\"%016x\"}\n", n, k))
+	}
+	for k := 0; k < n; k++ {
+		out.WriteString(fmt.Sprintf("{k:%d,s:\"%016x\",t:\"%016x\"}\n", k, k, k))
+	}
+	for i := 0; i < dups; i++ {
+		for j := 0; j < dups; j++ {
+			out.WriteString(fmt.Sprintf("{k:%d,s:\"%016x\",t:\"%016x\"}\n", n, i, j))
+		}
+	}
+	(&ztest.ZTest{
+		Zed:    "fork (=> pass => pass) | join on k=k t:=s | sort k, s, t",
+		Input:  in.String(),
+		Output: out.String(),
+	}).Run(t, "", "")
+}
@@ -2,7 +2,6 @@ package join
 
 import (
 	"context"
-	"fmt"
 	"sync"
 
 	"github.com/brimdata/zed"
@@ -18,6 +17,7 @@ type Proc struct {
 	pctx        *op.Context
 	anti        bool
 	inner       bool
+	full        bool
 	ctx         context.Context
 	cancel      context.CancelFunc
 	once        sync.Once
@@ -29,19 +29,31 @@ type Proc struct {
 	cutter      *expr.Cutter
 	joinKey     *zed.Value
 	joinSet     []*zed.Value
-	types       map[int]map[int]*zed.TypeRecord
+	splicer     *splicer
+	unmatcher   *unmatcher
+	unmatched   []zed.Value
 }
 
-func New(pctx *op.Context, anti, inner bool, left, right zbuf.Puller, leftKey, rightKey expr.Evaluator, lhs field.List, rhs []expr.Evaluator) (*Proc, error) {
+// New returns a merge join of left and right, which must be sorted by their
+// join keys.  For a full join, leftKeyPath is the field holding the left key,
+// or nil if the left key is not a field (see newUnmatcher).
+func New(pctx *op.Context, anti, inner, full bool, left, right zbuf.Puller, leftKey, rightKey expr.Evaluator, leftKeyPath field.Path, lhs field.List, rhs []expr.Evaluator) (*Proc, error) {
 	cutter, err := expr.NewCutter(pctx.Zctx, lhs, rhs)
 	if err != nil {
 		return nil, err
 	}
+	var u *unmatcher
+	if full {
+		if u, err = newUnmatcher(pctx.Zctx, leftKeyPath, rightKey, lhs, rhs); err != nil {
+			return nil, err
+		}
+	}
 	ctx, cancel := context.WithCancel(pctx.Context)
 	return &Proc{
 		pctx:        pctx,
 		anti:        anti,
 		inner:       inner,
+		full:        full,
 		ctx:         ctx,
 		cancel:      cancel,
 		getLeftKey:  leftKey,
@@ -49,9 +61,10 @@ func New(pctx *op.Context, anti, inner bool, left, right zbuf.Puller, leftKey, r
 		left:        newPuller(left, ctx),
 		right:       zio.NewPeeker(newPuller(right, ctx)),
 		// XXX need to make sure nullsmax agrees with inbound merge
-		compare: expr.NewValueCompareFn(order.Asc, false),
-		cutter:  cutter,
-		types:   make(map[int]map[int]*zed.TypeRecord),
+		compare:   expr.NewValueCompareFn(order.Asc, false),
+		cutter:    cutter,
+		splicer:   newSplicer(pctx.Zctx),
+		unmatcher: u,
 	}, nil
 }
 
@@ -71,6 +84,15 @@ func (p *Proc) Pull(done bool) (zbuf.Batch, error) {
 			return nil, err
 		}
 		if leftRec == nil {
+			if p.full {
+				// The left input is done so whatever remains
+				// on the right has no match.
+				if err := p.drainRight(); err != nil {
+					return nil, err
+				}
+				out = append(out, p.unmatched...)
+				p.unmatched = nil
+			}
 			if len(out) == 0 {
 				return nil, nil
 			}
@@ -88,6 +110,10 @@ func (p *Proc) Pull(done bool) (zbuf.Batch, error) {
 		if err != nil {
 			return nil, err
 		}
+		if len(p.unmatched) != 0 {
+			out = append(out, p.unmatched...)
+			p.unmatched = p.unmatched[:0]
+		}
 		if rightRecs == nil {
 			// Nothing to add to the left join.
 			// Accumulate this record for an outer join.
@@ -110,7 +136,7 @@ func (p *Proc) Pull(done bool) (zbuf.Batch, error) {
 		// release the batch with and bypass GC.
 		for _, rightRec := range rightRecs {
 			cutRec := p.cutter.Eval(ectx, rightRec)
-			rec, err := p.splice(leftRec, cutRec)
+			rec, err := p.splicer.splice(leftRec, cutRec)
 			if err != nil {
 				return nil, err
 			}
@@ -132,6 +158,11 @@ func (p *Proc) getJoinSet(leftKey *zed.Value) ([]*zed.Value, error) {
 		}
 		rightKey := p.getRightKey.Eval(ectx, rec)
 		if rightKey.IsMissing() {
+			if p.full {
+				if err := p.appendUnmatched(ectx, rec); err != nil {
+					return nil, err
+				}
+			}
 			p.right.Read()
 			continue
 		}
@@ -154,11 +185,112 @@ func (p *Proc) getJoinSet(leftKey *zed.Value) ([]*zed.Value, error) {
 		}
 		// Discard the peeked-at record and keep looking for
 		// a righthand key that either matches or exceeds the
-		// lefthand key.
+		// lefthand key.  For a full join, the discarded record
+		// has no match so it is kept as an unmatched record.
+		if p.full {
+			if err := p.appendUnmatched(ectx, rec); err != nil {
+				return nil, err
+			}
+		}
 		p.right.Read()
 	}
 }
 
+// drainRight reads the rest of the righthand stream into the unmatched
+// records of a full join.  Any records equal to the current join key
+// have already been consumed by readJoinSet so everything left is
+// unmatched.
+func (p *Proc) drainRight() error {
+	// See #3366
+	ectx := expr.NewContext()
+	for {
+		rec, err := p.right.Read()
+		if err != nil || rec == nil {
+			return err
+		}
+		if err := p.appendUnmatched(ectx, rec); err != nil {
+			return err
+		}
+	}
+}
+
+// appendUnmatched adds a righthand record with no matching lefthand key
+// to the output of a full join.
+func (p *Proc) appendUnmatched(ectx expr.Context, rec *zed.Value) error {
+	rec, err := p.unmatcher.eval(ectx, rec)
+	if err != nil {
+		return err
+	}
+	p.unmatched = append(p.unmatched, *rec.Copy())
+	return nil
+}
+
+// unmatcher builds the output of a full join for a righthand record that
+// matches no lefthand record.  Since there is no left side to splice the
+// record into, the output holds the values computed by the join's
+// assignments (or the whole record for a join without assignments) along
+// with the right key, which is stored in the field of the left key so
+// unmatched records can be reconciled with matched ones.
+type unmatcher struct {
+	// cutter is nil if the whole record is output.
+	cutter *expr.Cutter
+	// splicer is non-nil if the right key is spliced into the whole
+	// record.
+	splicer *splicer
+}
+
+// newUnmatcher returns an unmatcher for a join whose left key is in the field
+// leftKeyPath.  If leftKeyPath is nil, the right key is not stored.
+func newUnmatcher(zctx *zed.Context, leftKeyPath field.Path, rightKey expr.Evaluator, lhs field.List, rhs []expr.Evaluator) (*unmatcher, error) {
+	noArgs := len(lhs) == 0
+	if leftKeyPath != nil && !leftKeyPath.In(lhs) {
+		lhs = append(field.List{leftKeyPath}, lhs...)
+		rhs = append([]expr.Evaluator{&quietMissing{zctx, rightKey}}, rhs...)
+	}
+	if len(lhs) == 0 {
+		return &unmatcher{}, nil
+	}
+	cutter, err := expr.NewCutter(zctx, lhs, rhs)
+	if err != nil {
+		return nil, err
+	}
+	u := &unmatcher{cutter: cutter}
+	if noArgs {
+		u.splicer = newSplicer(zctx)
+	}
+	return u, nil
+}
+
+func (u *unmatcher) eval(ectx expr.Context, rec *zed.Value) (*zed.Value, error) {
+	if u.cutter == nil {
+		return rec, nil
+	}
+	out := u.cutter.Eval(ectx, rec)
+	if u.splicer == nil {
+		return out, nil
+	}
+	if typ := zed.TypeRecordOf(out.Type); typ == nil || len(typ.Fields) == 0 {
+		// The right key is missing.
+		return rec, nil
+	}
+	return u.splicer.splice(out, rec)
+}
+
+// quietMissing evaluates to quiet instead of missing so a Cutter leaves out
+// the right key of a record that doesn't have one.
+type quietMissing struct {
+	zctx *zed.Context
+	expr.Evaluator
+}
+
+func (q *quietMissing) Eval(ectx expr.Context, val *zed.Value) *zed.Value {
+	out := q.Evaluator.Eval(ectx, val)
+	if out.IsMissing() {
+		return q.zctx.Quiet()
+	}
+	return out
+}
+
 // fillJoinSet is called when a join key has been found that matches
 // the current lefthand key.  It returns the all the subsequent records
 // from the righthand stream that match this key.
@@ -186,67 +318,3 @@ func (p *Proc) readJoinSet(joinKey *zed.Value) ([]*zed.Value, error) {
 		p.right.Read()
 	}
 }
-
-func (p *Proc) lookupType(left, right *zed.TypeRecord) *zed.TypeRecord {
-	if table, ok := p.types[left.ID()]; ok {
-		return table[right.ID()]
-	}
-	return nil
-}
-
-func (p *Proc) enterType(combined, left, right *zed.TypeRecord) {
-	id := left.ID()
-	table := p.types[id]
-	if table == nil {
-		table = make(map[int]*zed.TypeRecord)
-		p.types[id] = table
-	}
-	table[right.ID()] = combined
-}
-
-func (p *Proc) buildType(left, right *zed.TypeRecord) (*zed.TypeRecord, error) {
-	fields := make([]zed.Field, 0, len(left.Fields)+len(right.Fields))
-	fields = append(fields, left.Fields...)
-	for _, f := range right.Fields {
-		name := f.Name
-		for k := 2; left.HasField(name); k++ {
-			name = fmt.Sprintf("%s_%d", f.Name, k)
-		}
-		fields = append(fields, zed.NewField(name, f.Type))
-	}
-	return p.pctx.Zctx.LookupTypeRecord(fields)
-}
-
-func (p *Proc) combinedType(left, right *zed.TypeRecord) (*zed.TypeRecord, error) {
-	if typ := p.lookupType(left, right); typ != nil {
-		return typ, nil
-	}
-	typ, err := p.buildType(left, right)
-	if err != nil {
-		return nil, err
-	}
-	p.enterType(typ, left, right)
-	return typ, nil
-}
-
-func (p *Proc) splice(left, right *zed.Value) (*zed.Value, error) {
-	if right == nil {
-		// This happens on a simple join, i.e., "join key",
-		// where there are no cut expressions.  For left joins,
-		// this does nothing, but for inner joins, it will
-		// filter the lefthand stream by what's in the righthand
-		// stream.
-		return left, nil
-	}
-	left = left.Under()
-	right = right.Under()
-	typ, err := p.combinedType(zed.TypeRecordOf(left.Type), zed.TypeRecordOf(right.Type))
-	if err != nil {
-		return nil, err
-	}
-	n := len(left.Bytes)
-	bytes := make([]byte, n+len(right.Bytes))
-	copy(bytes, left.Bytes)
-	copy(bytes[n:], right.Bytes)
-	return zed.NewValue(typ, bytes), nil
-}
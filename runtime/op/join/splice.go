@@ -0,0 +1,86 @@
+package join
+
+import (
+	"fmt"
+
+	"github.com/brimdata/zed"
+)
+
+// splicer combines a lefthand record with the values cut from a matching
+// righthand record, caching the combined record type for each pair of
+// input types.
+type splicer struct {
+	zctx  *zed.Context
+	types map[int]map[int]*zed.TypeRecord
+}
+
+func newSplicer(zctx *zed.Context) *splicer {
+	return &splicer{
+		zctx:  zctx,
+		types: make(map[int]map[int]*zed.TypeRecord),
+	}
+}
+
+func (s *splicer) lookupType(left, right *zed.TypeRecord) *zed.TypeRecord {
+	if table, ok := s.types[left.ID()]; ok {
+		return table[right.ID()]
+	}
+	return nil
+}
+
+func (s *splicer) enterType(combined, left, right *zed.TypeRecord) {
+	id := left.ID()
+	table := s.types[id]
+	if table == nil {
+		table = make(map[int]*zed.TypeRecord)
+		s.types[id] = table
+	}
+	table[right.ID()] = combined
+}
+
+func (s *splicer) buildType(left, right *zed.TypeRecord) (*zed.TypeRecord, error) {
+	fields := make([]zed.Field, 0, len(left.Fields)+len(right.Fields))
+	fields = append(fields, left.Fields...)
+	for _, f := range right.Fields {
+		name := f.Name
+		for k := 2; left.HasField(name); k++ {
+			name = fmt.Sprintf("%s_%d", f.Name, k)
+		}
+		fields = append(fields, zed.NewField(name, f.Type))
+	}
+	return s.zctx.LookupTypeRecord(fields)
+}
+
+func (s *splicer) combinedType(left, right *zed.TypeRecord) (*zed.TypeRecord, error) {
+	if typ := s.lookupType(left, right); typ != nil {
+		return typ, nil
+	}
+	typ, err := s.buildType(left, right)
+	if err != nil {
+		return nil, err
+	}
+	s.enterType(typ, left, right)
+	return typ, nil
+}
+
+func (s *splicer) splice(left, right *zed.Value) (*zed.Value, error) {
+	if right == nil {
+		// This happens on a simple join, i.e., "join key",
+		// where there are no cut expressions.  For left joins,
+		// this does nothing, but for inner joins, it will
+		// filter the lefthand stream by what's in the righthand
+		// stream.
+		return left, nil
+	}
+	left = left.Under()
+	right = right.Under()
+	typ, err := s.combinedType(zed.TypeRecordOf(left.Type), zed.TypeRecordOf(right.Type))
+	if err != nil {
+		return nil, err
+	}
+	n := len(left.Bytes)
+	bytes := make([]byte, n+len(right.Bytes))
+	copy(bytes, left.Bytes)
+	copy(bytes[n:], right.Bytes)
+	return zed.NewValue(typ, bytes), nil
+}
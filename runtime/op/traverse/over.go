@@ -94,12 +94,17 @@ func appendOver(zctx *zed.Context, vals []zed.Value, val zed.Value) []zed.Value
 		}
 		return vals
 	case *zed.TypeMap:
-		rtyp := zctx.MustLookupTypeRecord([]zed.Field{
-			zed.NewField("key", typ.KeyType),
-			zed.NewField("value", typ.ValType),
-		})
 		for it := val.Bytes.Iter(); !it.Done(); {
-			bytes := zcode.Append(zcode.Append(nil, it.Next()), it.Next())
+			// Remove the union tags from keys and values so entries of
+			// maps with mixed types, like those created by the map
+			// aggregation, are traversed as their underlying values.
+			ktyp, kbytes := untag(typ.KeyType, it.Next())
+			vtyp, vbytes := untag(typ.ValType, it.Next())
+			rtyp := zctx.MustLookupTypeRecord([]zed.Field{
+				zed.NewField("key", ktyp),
+				zed.NewField("value", vtyp),
+			})
+			bytes := zcode.Append(zcode.Append(nil, kbytes), vbytes)
 			vals = append(vals, *zed.NewValue(rtyp, bytes))
 		}
 		return vals
@@ -123,3 +128,10 @@ func appendOver(zctx *zed.Context, vals []zed.Value, val zed.Value) []zed.Value
 		return append(vals, val)
 	}
 }
+
+func untag(typ zed.Type, b zcode.Bytes) (zed.Type, zcode.Bytes) {
+	if union, ok := typ.(*zed.TypeUnion); ok {
+		return union.Untag(b)
+	}
+	return typ, b
+}
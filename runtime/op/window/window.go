@@ -0,0 +1,269 @@
+package window
+
+import (
+	"encoding/binary"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/runtime/expr/agg"
+	"github.com/brimdata/zed/runtime/op"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zcode"
+)
+
+// Proc computes aggregations over a frame of values surrounding each input
+// value and adds the results to that value.  The frame for a value is drawn
+// from its partition, i.e., the values with the same keys in input order,
+// and spans the values whose offsets from the value are between lower and
+// upper inclusive, where a nil bound extends to the start or end of the
+// partition.  Values are output in input order as soon as their frames are
+// complete, so a frame that extends to values following the current value
+// delays output until those values arrive or the input ends.
+type Proc struct {
+	pctx       *op.Context
+	parent     zbuf.Puller
+	keys       []expr.Evaluator
+	aggs       []*expr.Aggregator
+	lower      *int
+	upper      *int
+	putter     *expr.Putter
+	batch      zbuf.Batch
+	eos        bool
+	keyTypes   *zed.TypeVectorTable
+	typeCache  []zed.Type
+	keyCache   zcode.Bytes
+	partitions map[string]*partition
+	// queue holds the rows not yet output in input order.
+	queue []*row
+	// results holds the results for the row being output and is
+	// referenced by the putter's evaluators.
+	results []zed.Value
+}
+
+type row struct {
+	val     *zed.Value
+	results []zed.Value
+}
+
+type partition struct {
+	// rows holds the rows of the partition still needed to compute
+	// results, where rows[0] is the partition's base'th row.
+	rows []*row
+	base int
+	// next is the index of the first row whose results have not been
+	// computed.
+	next int
+	// fns and consumed are the running aggregations and the number of
+	// rows they have consumed when the frame has no lower bound.
+	fns      []agg.Function
+	consumed int
+}
+
+func New(pctx *op.Context, parent zbuf.Puller, keys []expr.Evaluator, names field.List, aggs []*expr.Aggregator, lower, upper *int) (*Proc, error) {
+	p := &Proc{
+		pctx:       pctx,
+		parent:     parent,
+		keys:       keys,
+		aggs:       aggs,
+		lower:      lower,
+		upper:      upper,
+		keyTypes:   zed.NewTypeVectorTable(),
+		typeCache:  make([]zed.Type, 0, len(keys)),
+		keyCache:   make(zcode.Bytes, 0, 128),
+		partitions: make(map[string]*partition),
+	}
+	clauses := make([]expr.Assignment, 0, len(names))
+	for k, name := range names {
+		clauses = append(clauses, expr.Assignment{LHS: name, RHS: &result{p, k}})
+	}
+	putter, err := expr.NewPutter(pctx.Zctx, clauses)
+	if err != nil {
+		return nil, err
+	}
+	p.putter = putter
+	return p, nil
+}
+
+// result is an Evaluator for an aggregation result of the row being output.
+type result struct {
+	proc *Proc
+	k    int
+}
+
+func (r *result) Eval(expr.Context, *zed.Value) *zed.Value {
+	return &r.proc.results[r.k]
+}
+
+func (p *Proc) Pull(done bool) (zbuf.Batch, error) {
+	if p.eos {
+		// We don't check done here because if we already got EOS,
+		// we don't propagate done.
+		p.eos = false
+		return nil, nil
+	}
+	if done {
+		p.reset()
+		return p.parent.Pull(true)
+	}
+	for {
+		batch, err := p.parent.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			for _, part := range p.partitions {
+				p.compute(part, true)
+			}
+			out := p.flush()
+			if out != nil {
+				p.eos = true
+			}
+			p.reset()
+			return out, nil
+		}
+		if p.batch == nil {
+			// The variables of the first batch provide the context
+			// for evaluating expressions over rows retained across
+			// batches.  The rows are copies, so the batch itself
+			// need not be retained.
+			p.batch = zbuf.NewBatch(batch, nil)
+		}
+		vals := batch.Values()
+		for i := range vals {
+			p.consume(batch, &vals[i])
+		}
+		batch.Unref()
+		if out := p.flush(); out != nil {
+			return out, nil
+		}
+	}
+}
+
+func (p *Proc) consume(ectx expr.Context, this *zed.Value) {
+	types := p.typeCache[:0]
+	keyBytes := p.keyCache[:0]
+	for _, e := range p.keys {
+		key := e.Eval(ectx, this)
+		types = append(types, key.Type)
+		keyBytes = zcode.Append(keyBytes, key.Bytes)
+	}
+	keyBytes = binary.AppendUvarint(keyBytes, uint64(p.keyTypes.Lookup(types)))
+	p.keyCache = keyBytes
+	part, ok := p.partitions[string(keyBytes)]
+	if !ok {
+		part = &partition{}
+		if p.lower == nil {
+			part.fns = p.newFunctions()
+		}
+		p.partitions[string(keyBytes)] = part
+	}
+	r := &row{val: this.Copy()}
+	part.rows = append(part.rows, r)
+	p.queue = append(p.queue, r)
+	p.compute(part, false)
+	if part.idle() {
+		delete(p.partitions, string(keyBytes))
+	}
+}
+
+func (p *Proc) newFunctions() []agg.Function {
+	fns := make([]agg.Function, 0, len(p.aggs))
+	for _, a := range p.aggs {
+		fns = append(fns, a.NewFunction())
+	}
+	return fns
+}
+
+func (p *Proc) apply(fns []agg.Function, val *zed.Value) {
+	for k, a := range p.aggs {
+		a.Apply(p.pctx.Zctx, p.batch, fns[k], val)
+	}
+}
+
+// compute computes the results of each row in part whose frame is complete
+// and discards the rows no longer needed by any frame.  If eos is true,
+// all rows of the partition have been seen.
+func (p *Proc) compute(part *partition, eos bool) {
+	n := part.base + len(part.rows)
+	for ; part.next < n; part.next++ {
+		i := part.next
+		hi := n - 1
+		if p.upper != nil {
+			if i+*p.upper > hi && !eos {
+				break
+			}
+			hi = min(i+*p.upper, hi)
+		} else if !eos {
+			break
+		}
+		fns := part.fns
+		if p.lower == nil {
+			for ; part.consumed <= hi; part.consumed++ {
+				p.apply(fns, part.rows[part.consumed-part.base].val)
+			}
+		} else {
+			fns = p.newFunctions()
+			for k := max(i+*p.lower, part.base); k <= hi; k++ {
+				p.apply(fns, part.rows[k-part.base].val)
+			}
+		}
+		results := make([]zed.Value, 0, len(fns))
+		for _, fn := range fns {
+			results = append(results, *fn.Result(p.pctx.Zctx).Copy())
+		}
+		part.rows[i-part.base].results = results
+	}
+	keep := part.next
+	if p.lower == nil {
+		keep = min(keep, part.consumed)
+	} else {
+		keep = max(min(keep, keep+*p.lower), part.base)
+	}
+	part.rows = part.rows[keep-part.base:]
+	part.base = keep
+}
+
+// idle returns true if part holds no rows and no running aggregations, in
+// which case it is equivalent to a new partition and can be discarded.
+func (part *partition) idle() bool {
+	return len(part.rows) == 0 && part.fns == nil
+}
+
+// flush returns a batch of the rows at the front of the queue whose
+// results have been computed or nil if there are no such rows.
+func (p *Proc) flush() zbuf.Batch {
+	var out []zed.Value
+	for len(p.queue) > 0 && p.queue[0].results != nil {
+		r := p.queue[0]
+		p.queue[0] = nil
+		p.queue = p.queue[1:]
+		p.results = r.results
+		out = append(out, *p.putter.Eval(p.batch, r.val).Copy())
+	}
+	if out == nil {
+		return nil
+	}
+	return zbuf.NewBatch(p.batch, out)
+}
+
+func (p *Proc) reset() {
+	p.batch = nil
+	p.partitions = make(map[string]*partition)
+	p.queue = nil
+	p.results = nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
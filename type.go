@@ -77,27 +77,36 @@ func (k Kind) String() string {
 }
 
 var (
-	TypeUint8    = &TypeOfUint8{}
-	TypeUint16   = &TypeOfUint16{}
-	TypeUint32   = &TypeOfUint32{}
-	TypeUint64   = &TypeOfUint64{}
-	TypeInt8     = &TypeOfInt8{}
-	TypeInt16    = &TypeOfInt16{}
-	TypeInt32    = &TypeOfInt32{}
-	TypeInt64    = &TypeOfInt64{}
-	TypeDuration = &TypeOfDuration{}
-	TypeTime     = &TypeOfTime{}
-	TypeFloat16  = &TypeOfFloat16{}
-	TypeFloat32  = &TypeOfFloat32{}
-	TypeFloat64  = &TypeOfFloat64{}
-	// XXX add TypeDecimal
-	TypeBool   = &TypeOfBool{}
-	TypeBytes  = &TypeOfBytes{}
-	TypeString = &TypeOfString{}
-	TypeIP     = &TypeOfIP{}
-	TypeNet    = &TypeOfNet{}
-	TypeType   = &TypeOfType{}
-	TypeNull   = &TypeOfNull{}
+	TypeUint8      = &TypeOfUint8{}
+	TypeUint16     = &TypeOfUint16{}
+	TypeUint32     = &TypeOfUint32{}
+	TypeUint64     = &TypeOfUint64{}
+	TypeUint128    = &TypeOfUint128{}
+	TypeUint256    = &TypeOfUint256{}
+	TypeInt8       = &TypeOfInt8{}
+	TypeInt16      = &TypeOfInt16{}
+	TypeInt32      = &TypeOfInt32{}
+	TypeInt64      = &TypeOfInt64{}
+	TypeInt128     = &TypeOfInt128{}
+	TypeInt256     = &TypeOfInt256{}
+	TypeDuration   = &TypeOfDuration{}
+	TypeTime       = &TypeOfTime{}
+	TypeFloat16    = &TypeOfFloat16{}
+	TypeFloat32    = &TypeOfFloat32{}
+	TypeFloat64    = &TypeOfFloat64{}
+	TypeFloat128   = &TypeOfFloat128{}
+	TypeFloat256   = &TypeOfFloat256{}
+	TypeDecimal32  = &TypeOfDecimal32{}
+	TypeDecimal64  = &TypeOfDecimal64{}
+	TypeDecimal128 = &TypeOfDecimal128{}
+	TypeDecimal256 = &TypeOfDecimal256{}
+	TypeBool       = &TypeOfBool{}
+	TypeBytes      = &TypeOfBytes{}
+	TypeString     = &TypeOfString{}
+	TypeIP         = &TypeOfIP{}
+	TypeNet        = &TypeOfNet{}
+	TypeType       = &TypeOfType{}
+	TypeNull       = &TypeOfNull{}
 )
 
 // Primary Type IDs
@@ -157,17 +166,36 @@ func IsInteger(id int) bool {
 }
 
 // True iff the type id is encoded as a zng signed or unsigned integer zcode.Bytes,
-// float16 zcode.Bytes, float32 zcode.Bytes, or float64 zcode.Bytes.
+// float zcode.Bytes, or decimal zcode.Bytes.
 func IsNumber(id int) bool {
 	return id <= IDDecimal256
 }
 
 // True iff the type id is encoded as a float encoding.
-// XXX add IDDecimal here when we implement coercible math with it.
 func IsFloat(id int) bool {
 	return id >= IDFloat16 && id <= IDFloat256
 }
 
+// True iff the type id is encoded as a decimal encoding.
+func IsDecimal(id int) bool {
+	return id >= IDDecimal32 && id <= IDDecimal256
+}
+
+// True iff the type id is a 128- or 256-bit integer, whose values may not fit
+// in an int64 or uint64.
+func IsBigInt(id int) bool {
+	switch id {
+	case IDUint128, IDUint256, IDInt128, IDInt256:
+		return true
+	}
+	return false
+}
+
+// True iff the type id is a 128- or 256-bit float.
+func IsBigFloat(id int) bool {
+	return id == IDFloat128 || id == IDFloat256
+}
+
 // True iff the type id is encoded as a number encoding and is signed.
 func IsSigned(id int) bool {
 	return id >= IDInt8 && id <= IDTime
@@ -183,6 +211,10 @@ func LookupPrimitive(name string) Type {
 		return TypeUint32
 	case "uint64":
 		return TypeUint64
+	case "uint128":
+		return TypeUint128
+	case "uint256":
+		return TypeUint256
 	case "int8":
 		return TypeInt8
 	case "int16":
@@ -191,6 +223,10 @@ func LookupPrimitive(name string) Type {
 		return TypeInt32
 	case "int64":
 		return TypeInt64
+	case "int128":
+		return TypeInt128
+	case "int256":
+		return TypeInt256
 	case "duration":
 		return TypeDuration
 	case "time":
@@ -201,6 +237,18 @@ func LookupPrimitive(name string) Type {
 		return TypeFloat32
 	case "float64":
 		return TypeFloat64
+	case "float128":
+		return TypeFloat128
+	case "float256":
+		return TypeFloat256
+	case "decimal32":
+		return TypeDecimal32
+	case "decimal64":
+		return TypeDecimal64
+	case "decimal128":
+		return TypeDecimal128
+	case "decimal256":
+		return TypeDecimal256
 	case "bool":
 		return TypeBool
 	case "bytes":
@@ -229,6 +277,10 @@ func PrimitiveName(typ Type) string {
 		return "uint32"
 	case *TypeOfUint64:
 		return "uint64"
+	case *TypeOfUint128:
+		return "uint128"
+	case *TypeOfUint256:
+		return "uint256"
 	case *TypeOfInt8:
 		return "int8"
 	case *TypeOfInt16:
@@ -237,6 +289,10 @@ func PrimitiveName(typ Type) string {
 		return "int32"
 	case *TypeOfInt64:
 		return "int64"
+	case *TypeOfInt128:
+		return "int128"
+	case *TypeOfInt256:
+		return "int256"
 	case *TypeOfDuration:
 		return "duration"
 	case *TypeOfTime:
@@ -247,6 +303,18 @@ func PrimitiveName(typ Type) string {
 		return "float32"
 	case *TypeOfFloat64:
 		return "float64"
+	case *TypeOfFloat128:
+		return "float128"
+	case *TypeOfFloat256:
+		return "float256"
+	case *TypeOfDecimal32:
+		return "decimal32"
+	case *TypeOfDecimal64:
+		return "decimal64"
+	case *TypeOfDecimal128:
+		return "decimal128"
+	case *TypeOfDecimal256:
+		return "decimal256"
 	case *TypeOfBool:
 		return "bool"
 	case *TypeOfBytes:
@@ -290,14 +358,34 @@ func LookupPrimitiveByID(id int) (Type, error) {
 		return TypeUint32, nil
 	case IDInt64:
 		return TypeInt64, nil
+	case IDInt128:
+		return TypeInt128, nil
+	case IDInt256:
+		return TypeInt256, nil
 	case IDUint64:
 		return TypeUint64, nil
+	case IDUint128:
+		return TypeUint128, nil
+	case IDUint256:
+		return TypeUint256, nil
 	case IDFloat16:
 		return TypeFloat16, nil
 	case IDFloat32:
 		return TypeFloat32, nil
 	case IDFloat64:
 		return TypeFloat64, nil
+	case IDFloat128:
+		return TypeFloat128, nil
+	case IDFloat256:
+		return TypeFloat256, nil
+	case IDDecimal32:
+		return TypeDecimal32, nil
+	case IDDecimal64:
+		return TypeDecimal64, nil
+	case IDDecimal128:
+		return TypeDecimal128, nil
+	case IDDecimal256:
+		return TypeDecimal256, nil
 	case IDBytes:
 		return TypeBytes, nil
 	case IDString:
@@ -6,6 +6,7 @@ import (
 	"sync/atomic"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/field"
 	"github.com/brimdata/zed/runtime/expr"
 	"github.com/brimdata/zed/zio"
 )
@@ -15,6 +16,19 @@ type Filter interface {
 	AsBufferFilter() (*expr.BufferFilter, error)
 }
 
+// Pushdown is implemented by Filters that carry additional information a
+// Scanner can use to avoid reading data.  Projection returns the fields
+// needed downstream of the Scanner or nil if every field might be needed.
+// AsStatsPruner returns an evaluator that, given a value of the form
+// {min:{...},max:{...}} holding the minimum and maximum values of the fields
+// in a block of values, returns true if no value in the block can match the
+// filter.  AsStatsPruner returns nil if there is no such evaluator.
+type Pushdown interface {
+	Filter
+	Projection() field.List
+	AsStatsPruner() (expr.Evaluator, error)
+}
+
 // ScannerAble is implemented by Readers that provide an optimized
 // implementation of the Scanner interface.
 type ScannerAble interface {
@@ -17,6 +17,7 @@ import (
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zio/anyio"
 	"github.com/brimdata/zed/zio/arrowio"
+	"github.com/brimdata/zed/zio/avroio"
 	"github.com/brimdata/zed/zio/parquetio"
 	"github.com/brimdata/zed/zio/zngio"
 	"github.com/brimdata/zed/ztest"
@@ -35,6 +36,7 @@ func TestZed(t *testing.T) {
 		data, err := loadZTestInputsAndOutputs(dirs)
 		require.NoError(t, err)
 		runAllBoomerangs(t, "arrows", data)
+		runAllBoomerangs(t, "avro", data)
 		runAllBoomerangs(t, "parquet", data)
 		runAllBoomerangs(t, "zson", data)
 	})
@@ -155,9 +157,16 @@ func runOneBoomerang(t *testing.T, format, data string) {
 		if errors.Is(err, arrowio.ErrMultipleTypes) ||
 			errors.Is(err, arrowio.ErrNotRecord) ||
 			errors.Is(err, arrowio.ErrUnsupportedType) ||
+			errors.Is(err, arrowio.ErrDecimalValue) ||
+			errors.Is(err, avroio.ErrMultipleTypes) ||
+			errors.Is(err, avroio.ErrNotRecord) ||
+			errors.Is(err, avroio.ErrUnsupportedType) ||
+			errors.Is(err, avroio.ErrUnrepresentableValue) ||
 			errors.Is(err, parquetio.ErrEmptyRecordType) ||
 			errors.Is(err, parquetio.ErrNullType) ||
 			errors.Is(err, parquetio.ErrUnionType) ||
+			errors.Is(err, parquetio.ErrDecimalValue) ||
+			errors.Is(err, parquetio.ErrUnsupportedType) ||
 			strings.Contains(err.Error(), "Parquet output encountered non-record value") ||
 			strings.Contains(err.Error(), "Parquet output requires uniform records but multiple types encountered") ||
 			strings.Contains(err.Error(), "column has no name") {
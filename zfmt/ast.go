@@ -23,6 +23,9 @@ type canon struct {
 	canonZed
 	head  bool
 	first bool
+	// ops holds the names of user-defined operators so their invocations
+	// are not formatted as expressions.
+	ops map[string]bool
 }
 
 func (c *canon) open(args ...interface{}) {
@@ -74,6 +77,13 @@ func (c *canon) exprs(exprs []ast.Expr) {
 	}
 }
 
+func lambdaParams(params []string) string {
+	if len(params) == 1 {
+		return params[0]
+	}
+	return "(" + strings.Join(params, ", ") + ")"
+}
+
 func (c *canon) exprsTight(exprs []ast.Expr) {
 	for k, e := range exprs {
 		if k > 0 {
@@ -92,6 +102,10 @@ func (c *canon) expr(e ast.Expr, parent string) {
 		if e.Expr != nil {
 			c.expr(e.Expr, "")
 		}
+		for _, p := range e.Params {
+			c.write(",")
+			c.expr(p, "")
+		}
 		c.write(")")
 		if e.Where != nil {
 			c.write(" where ")
@@ -119,6 +133,9 @@ func (c *canon) expr(e ast.Expr, parent string) {
 		c.write("%s(", e.Name)
 		c.exprs(e.Args)
 		c.write(")")
+	case *ast.Lambda:
+		c.write("%s => ", lambdaParams(e.Params))
+		c.expr(e.Expr, "")
 	case *ast.Cast:
 		c.expr(e.Type, "")
 		c.write("(")
@@ -344,6 +361,23 @@ func (c *canon) decl(d ast.Decl) {
 		c.ret()
 		c.flush()
 		c.write(")")
+	case *ast.OpDecl:
+		if c.ops == nil {
+			c.ops = make(map[string]bool)
+		}
+		c.ops[d.Name] = true
+		c.write("op %s(%s): (", d.Name, strings.Join(d.Params, ", "))
+		c.open()
+		first, head := c.first, c.head
+		c.first, c.head = false, true
+		c.proc(d.Body)
+		c.first, c.head = first, head
+		c.close()
+		c.ret()
+		c.flush()
+		c.write(")")
+	case *ast.ImportDecl:
+		c.write("import %s", zson.QuotedString([]byte(d.Path)))
 	default:
 		c.open("unknown decl: %T", d)
 		c.close()
@@ -542,6 +576,11 @@ func (c *canon) proc(p ast.Op) {
 		c.next()
 		c.write("pass")
 	case *ast.OpExpr:
+		if call, ok := p.Expr.(*ast.Call); ok && c.ops[call.Name] {
+			c.next()
+			c.expr(call, "")
+			return
+		}
 		if agg := isAggFunc(p.Expr); agg != nil {
 			c.proc(agg)
 			return
@@ -595,6 +634,31 @@ func (c *canon) proc(p ast.Op) {
 			c.assignments(p.Args)
 		}
 		c.close()
+	case *ast.Window:
+		c.next()
+		c.open("window ")
+		c.assignments(p.Aggs)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
+		if p.Sort != nil {
+			c.write(" sort")
+			if p.Sort.Order == order.Desc {
+				c.write(" -r")
+			}
+			if p.Sort.NullsFirst {
+				c.write(" -nulls first")
+			}
+			if len(p.Sort.Args) > 0 {
+				c.space()
+				c.exprs(p.Sort.Args)
+			}
+		}
+		if p.Frame != nil {
+			c.write(" rows %s to %s", windowBound(p.Frame.Lower), windowBound(p.Frame.Upper))
+		}
+		c.close()
 	case *ast.OpAssignment:
 		c.next()
 		which := "put "
@@ -774,6 +838,16 @@ func (c *canon) source(src ast.Source) {
 	}
 }
 
+func windowBound(b ast.WindowBound) string {
+	switch {
+	case b.Unbounded:
+		return "unbounded " + b.Type
+	case b.Type == "current":
+		return "current row"
+	}
+	return fmt.Sprintf("%d %s", b.Count, b.Type)
+}
+
 func isTrue(e ast.Expr) bool {
 	if p, ok := e.(*astzed.Primitive); ok {
 		return p.Type == "bool" && p.Text == "true"
@@ -77,6 +77,10 @@ func (c *canonDAG) expr(e dag.Expr, parent string) {
 		if e.Expr != nil {
 			c.expr(e.Expr, "")
 		}
+		for _, p := range e.Params {
+			c.write(",")
+			c.expr(p, "")
+		}
 		c.write(")")
 		if e.Where != nil {
 			c.write(" where ")
@@ -100,6 +104,9 @@ func (c *canonDAG) expr(e dag.Expr, parent string) {
 		c.write("%s(", e.Name)
 		c.exprs(e.Args)
 		c.write(")")
+	case *dag.Lambda:
+		c.write("%s => ", lambdaParams(e.Params))
+		c.expr(e.Expr, "")
 	case *dag.OverExpr:
 		c.open("(")
 		c.ret()
@@ -423,11 +430,24 @@ func (c *canonDAG) op(p dag.Op) {
 		c.expr(p.LeftKey, "")
 		c.write("=")
 		c.expr(p.RightKey, "")
+		if p.InputSortDir != 0 {
+			c.write(" sort-dir %d", p.InputSortDir)
+		}
 		if len(p.Args) != 0 {
 			c.write(" ")
 			c.assignments(p.Args)
 		}
 		c.close()
+	case *dag.Window:
+		c.next()
+		c.open("window ")
+		c.assignments(p.Aggs)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
+		c.write(" rows %s to %s", windowOffset(p.Lower, "preceding"), windowOffset(p.Upper, "following"))
+		c.close()
 	case *dag.From:
 		// XXX cleanup for single trunk
 		c.next()
@@ -442,6 +462,10 @@ func (c *canonDAG) op(p dag.Op) {
 				c.close()
 				c.ret()
 			}
+			if trunk.Projection != nil {
+				c.write("(projection %s)", trunk.Projection)
+				c.ret()
+			}
 			c.write("%s", source(trunk.Source))
 			if trunk.Seq != nil && len(trunk.Seq.Ops) != 0 {
 				c.open()
@@ -525,6 +549,18 @@ func source(src dag.Source) string {
 	}
 }
 
+func windowOffset(offset *int, unbounded string) string {
+	switch {
+	case offset == nil:
+		return "unbounded " + unbounded
+	case *offset < 0:
+		return fmt.Sprintf("%d preceding", -*offset)
+	case *offset > 0:
+		return fmt.Sprintf("%d following", *offset)
+	}
+	return "current row"
+}
+
 func isDAGTrue(e dag.Expr) bool {
 	if p, ok := e.(*astzed.Primitive); ok {
 		return p.Type == "bool" && p.Text == "true"
@@ -7,6 +7,7 @@ import (
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/pkg/storage"
 	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zio"
 )
 
 // Open uses engine to open path for reading.  path is a local file path or a
@@ -49,5 +50,20 @@ func NewFile(zctx *zed.Context, rc io.ReadCloser, path string, opts ReaderOpts)
 	if err != nil {
 		return nil, err
 	}
-	return zbuf.NewFile(zr, rc, path), nil
+	return zbuf.NewFile(zr, &fileCloser{zr, rc}, path), nil
+}
+
+// fileCloser closes a zio.ReadCloser, which may hold resources like a
+// temporary file, and then the io.ReadCloser underlying it.
+type fileCloser struct {
+	zr zio.ReadCloser
+	rc io.ReadCloser
+}
+
+func (f *fileCloser) Close() error {
+	err := f.zr.Close()
+	if err2 := f.rc.Close(); err == nil {
+		err = err2
+	}
+	return err
 }
@@ -7,10 +7,14 @@ import (
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zio/arrowio"
+	"github.com/brimdata/zed/zio/avroio"
+	"github.com/brimdata/zed/zio/cefio"
 	"github.com/brimdata/zed/zio/csvio"
 	"github.com/brimdata/zed/zio/jsonio"
+	"github.com/brimdata/zed/zio/leefio"
 	"github.com/brimdata/zed/zio/lineio"
 	"github.com/brimdata/zed/zio/parquetio"
+	"github.com/brimdata/zed/zio/syslogio"
 	"github.com/brimdata/zed/zio/vngio"
 	"github.com/brimdata/zed/zio/zeekio"
 	"github.com/brimdata/zed/zio/zjsonio"
@@ -22,8 +26,18 @@ func lookupReader(zctx *zed.Context, r io.Reader, opts ReaderOpts) (zio.ReadClos
 	switch opts.Format {
 	case "arrows":
 		return arrowio.NewReader(zctx, r)
+	case "avro":
+		zr, err := avroio.NewReader(zctx, r)
+		if err != nil {
+			return nil, err
+		}
+		return zio.NopReadCloser(zr), nil
+	case "cef":
+		return zio.NopReadCloser(cefio.NewReader(zctx, r)), nil
 	case "csv":
 		return zio.NopReadCloser(csvio.NewReader(zctx, r, opts.CSV)), nil
+	case "leef":
+		return zio.NopReadCloser(leefio.NewReader(zctx, r)), nil
 	case "line":
 		return zio.NopReadCloser(lineio.NewReader(r)), nil
 	case "json":
@@ -33,7 +47,9 @@ func lookupReader(zctx *zed.Context, r io.Reader, opts ReaderOpts) (zio.ReadClos
 		if err != nil {
 			return nil, err
 		}
-		return zio.NopReadCloser(zr), nil
+		return zr, nil
+	case "syslog":
+		return zio.NopReadCloser(syslogio.NewReader(zctx, r)), nil
 	case "vng":
 		zr, err := vngio.NewReader(zctx, r)
 		if err != nil {
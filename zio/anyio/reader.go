@@ -11,9 +11,13 @@ import (
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zio/arrowio"
+	"github.com/brimdata/zed/zio/avroio"
+	"github.com/brimdata/zed/zio/cefio"
 	"github.com/brimdata/zed/zio/csvio"
 	"github.com/brimdata/zed/zio/jsonio"
+	"github.com/brimdata/zed/zio/leefio"
 	"github.com/brimdata/zed/zio/parquetio"
+	"github.com/brimdata/zed/zio/syslogio"
 	"github.com/brimdata/zed/zio/vngio"
 	"github.com/brimdata/zed/zio/zeekio"
 	"github.com/brimdata/zed/zio/zjsonio"
@@ -40,9 +44,10 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, opts ReaderOpts) (zio.Rea
 	if rs, ok := r.(io.ReadSeeker); ok {
 		if n, err := rs.Seek(0, io.SeekCurrent); err == nil {
 			var zr zio.Reader
-			zr, parquetErr = parquetio.NewReader(zctx, rs)
+			var pr *parquetio.Reader
+			pr, parquetErr = parquetio.NewReader(zctx, rs)
 			if parquetErr == nil {
-				return zio.NopReadCloser(zr), nil
+				return pr, nil
 			}
 			if _, err := rs.Seek(n, io.SeekStart); err != nil {
 				return nil, err
@@ -54,14 +59,12 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, opts ReaderOpts) (zio.Rea
 			if _, err := rs.Seek(n, io.SeekStart); err != nil {
 				return nil, err
 			}
+			parquetErr = fmt.Errorf("parquet: %w", parquetErr)
 		} else {
-			parquetErr = err
 			vngErr = err
 		}
-		parquetErr = fmt.Errorf("parquet: %w", parquetErr)
 		vngErr = fmt.Errorf("vng: %w", vngErr)
 	} else {
-		parquetErr = errors.New("parquet: auto-detection requires seekable input")
 		vngErr = errors.New("vng: auto-detection requires seekable input")
 	}
 
@@ -75,6 +78,33 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, opts ReaderOpts) (zio.Rea
 	arrowsErr = fmt.Errorf("arrows: %w", arrowsErr)
 	track.Reset()
 
+	avroErr := isAvroOCF(track)
+	if avroErr == nil {
+		zr, err := avroio.NewReader(zctx, recorder)
+		if err != nil {
+			return nil, err
+		}
+		return zio.NopReadCloser(zr), nil
+	}
+	avroErr = fmt.Errorf("avro: %w", avroErr)
+	track.Reset()
+
+	// Parquet needs random access, so when the input isn't seekable, we
+	// match the magic number and let the Parquet reader copy the input to
+	// a temporary file.
+	if parquetErr == nil {
+		parquetErr = isParquet(track)
+		if parquetErr == nil {
+			zr, err := parquetio.NewReader(zctx, recorder)
+			if err != nil {
+				return nil, err
+			}
+			return zr, nil
+		}
+		parquetErr = fmt.Errorf("parquet: %w", parquetErr)
+		track.Reset()
+	}
+
 	zeekErr := match(zeekio.NewReader(zed.NewContext(), track), "zeek", 1)
 	if zeekErr == nil {
 		return zio.NopReadCloser(zeekio.NewReader(zctx, recorder)), nil
@@ -117,6 +147,27 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, opts ReaderOpts) (zio.Rea
 	}
 	track.Reset()
 
+	// CEF and LEEF come before syslog since their events often have a
+	// syslog header.
+	cefErr := match(cefio.NewReader(zed.NewContext(), track), "cef", 1)
+	if cefErr == nil {
+		return zio.NopReadCloser(cefio.NewReader(zctx, recorder)), nil
+	}
+	track.Reset()
+
+	leefErr := match(leefio.NewReader(zed.NewContext(), track), "leef", 1)
+	if leefErr == nil {
+		return zio.NopReadCloser(leefio.NewReader(zctx, recorder)), nil
+	}
+	track.Reset()
+
+	// Syslog comes before CSV since syslog messages often contain commas.
+	syslogErr := match(syslogio.NewReader(zed.NewContext(), track), "syslog", 1)
+	if syslogErr == nil {
+		return zio.NopReadCloser(syslogio.NewReader(zctx, recorder)), nil
+	}
+	track.Reset()
+
 	var csvErr error
 	if s, err := bufio.NewReader(track).ReadString('\n'); err != nil {
 		csvErr = fmt.Errorf("csv: line 1: %w", err)
@@ -134,10 +185,14 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, opts ReaderOpts) (zio.Rea
 	lineErr := errors.New("line: auto-detection not supported")
 	return nil, joinErrs([]error{
 		arrowsErr,
+		avroErr,
+		cefErr,
 		csvErr,
 		jsonErr,
+		leefErr,
 		lineErr,
 		parquetErr,
+		syslogErr,
 		vngErr,
 		zeekErr,
 		zjsonErr,
@@ -146,6 +201,28 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, opts ReaderOpts) (zio.Rea
 	})
 }
 
+func isAvroOCF(track *Track) error {
+	buf := make([]byte, len(avroio.Magic))
+	if _, err := io.ReadFull(track, buf); err != nil {
+		return err
+	}
+	if string(buf) != avroio.Magic {
+		return errors.New("magic number not found")
+	}
+	return nil
+}
+
+func isParquet(track *Track) error {
+	buf := make([]byte, len(parquetio.Magic))
+	if _, err := io.ReadFull(track, buf); err != nil {
+		return err
+	}
+	if string(buf) != parquetio.Magic {
+		return errors.New("magic number not found")
+	}
+	return nil
+}
+
 func isArrowStream(track *Track) error {
 	// Streams created by Arrow 0.15.0 or later begin with a 4-byte
 	// continuation indicator (0xffffffff) followed by a 4-byte
@@ -7,9 +7,12 @@ import (
 	"github.com/brimdata/zed"
 	"github.com/brimdata/zed/zio"
 	"github.com/brimdata/zed/zio/arrowio"
+	"github.com/brimdata/zed/zio/avroio"
+	"github.com/brimdata/zed/zio/cefio"
 	"github.com/brimdata/zed/zio/csvio"
 	"github.com/brimdata/zed/zio/jsonio"
 	"github.com/brimdata/zed/zio/lakeio"
+	"github.com/brimdata/zed/zio/leefio"
 	"github.com/brimdata/zed/zio/parquetio"
 	"github.com/brimdata/zed/zio/tableio"
 	"github.com/brimdata/zed/zio/textio"
@@ -32,12 +35,18 @@ func NewWriter(w io.WriteCloser, opts WriterOpts) (zio.WriteCloser, error) {
 	switch opts.Format {
 	case "arrows":
 		return arrowio.NewWriter(w), nil
+	case "avro":
+		return avroio.NewWriter(w), nil
+	case "cef":
+		return cefio.NewWriter(w), nil
 	case "csv":
 		return csvio.NewWriter(w), nil
 	case "json":
 		return jsonio.NewWriter(w), nil
 	case "lake":
 		return lakeio.NewWriter(w, opts.Lake), nil
+	case "leef":
+		return leefio.NewWriter(w), nil
 	case "null":
 		return &nullWriter{}, nil
 	case "parquet":
@@ -3,6 +3,7 @@ package arrowio
 import (
 	"fmt"
 	"io"
+	"math/big"
 	"strconv"
 	"unsafe"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/apache/arrow/go/v11/arrow/ipc"
 	"github.com/apache/arrow/go/v11/parquet/pqarrow"
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/zcode"
 	"golang.org/x/exp/slices"
@@ -117,10 +119,6 @@ var dayTimeIntervalFields = []zed.Field{
 	{Name: "days", Type: zed.TypeInt32},
 	{Name: "milliseconds", Type: zed.TypeUint32},
 }
-var decimal128Fields = []zed.Field{
-	{Name: "high", Type: zed.TypeInt64},
-	{Name: "low", Type: zed.TypeUint64},
-}
 var monthDayNanoIntervalFields = []zed.Field{
 	{Name: "month", Type: zed.TypeInt32},
 	{Name: "day", Type: zed.TypeInt32},
@@ -186,14 +184,8 @@ func (r *Reader) newZedType(dt arrow.DataType) (zed.Type, error) {
 			return nil, err
 		}
 		return r.zctx.LookupTypeNamed("arrow_day_time_interval", typ)
-	case arrow.DECIMAL128:
-		typ, err := r.zctx.LookupTypeRecord(decimal128Fields)
-		if err != nil {
-			return nil, err
-		}
-		return r.zctx.LookupTypeNamed("arrow_decimal128", typ)
-	case arrow.DECIMAL256:
-		return r.zctx.LookupTypeNamed("arrow_decimal256", r.zctx.LookupTypeArray(zed.TypeUint64))
+	case arrow.DECIMAL128, arrow.DECIMAL256:
+		return r.newZedDecimalType(dt.(arrow.DecimalType))
 	case arrow.LIST:
 		typ, err := r.newZedType(dt.(*arrow.ListType).Elem())
 		if err != nil {
@@ -257,6 +249,36 @@ func (r *Reader) newZedType(dt arrow.DataType) (zed.Type, error) {
 	}
 }
 
+// newZedDecimalType returns the narrowest Zed decimal type that can hold
+// values of dt.  The type is named for dt unless dt is the Arrow type
+// written for the Zed type by default (see defaultArrowDecimalType).
+func (r *Reader) newZedDecimalType(dt arrow.DecimalType) (zed.Type, error) {
+	typ := zed.DecimalTypeOfPrecision(int(dt.GetPrecision()))
+	if typ == nil {
+		return nil, fmt.Errorf("unimplemented Arrow type: %s", dt)
+	}
+	if arrow.TypeEqual(dt, defaultArrowDecimalType(typ)) {
+		return typ, nil
+	}
+	prefix := "arrow_decimal128_"
+	if dt.ID() == arrow.DECIMAL256 {
+		prefix = "arrow_decimal256_"
+	}
+	name := fmt.Sprintf("%s%d_%d", prefix, dt.GetPrecision(), dt.GetScale())
+	return r.zctx.LookupTypeNamed(name, typ)
+}
+
+func encodeDecimal(unscaled *big.Int, dt arrow.DecimalType) zcode.Bytes {
+	typ := zed.DecimalTypeOfPrecision(int(dt.GetPrecision()))
+	d := decimal.NewFromBigInt(unscaled, -int(dt.GetScale()))
+	if arrow.TypeEqual(dt, defaultArrowDecimalType(typ)) {
+		// The scale is the default for typ rather than that of the
+		// value written, so drop the zeros it added.
+		d = d.TrimFraction()
+	}
+	return zed.EncodeDecimal(typ.ID(), d)
+}
+
 func (r *Reader) newZedUnionType(union arrow.UnionType, fingerprint string) (zed.Type, error) {
 	var types []zed.Type
 	for _, f := range union.Fields() {
@@ -348,16 +370,10 @@ func (r *Reader) buildZcode(a arrow.Array, i int) error {
 		b.EndContainer()
 	case arrow.DECIMAL128:
 		v := array.NewDecimal128Data(data).Value(i)
-		b.BeginContainer()
-		b.Append(zed.EncodeInt(v.HighBits()))
-		b.Append(zed.EncodeUint(v.LowBits()))
-		b.EndContainer()
+		b.Append(encodeDecimal(v.BigInt(), a.DataType().(arrow.DecimalType)))
 	case arrow.DECIMAL256:
-		b.BeginContainer()
-		for _, u := range array.NewDecimal256Data(data).Value(i).Array() {
-			b.Append(zed.EncodeUint(u))
-		}
-		b.EndContainer()
+		v := array.NewDecimal256Data(data).Value(i)
+		b.Append(encodeDecimal(v.BigInt(), a.DataType().(arrow.DecimalType)))
 	case arrow.LIST:
 		v := array.NewListData(data)
 		start, end := v.ValueOffsets(i)
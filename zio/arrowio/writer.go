@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -27,6 +28,7 @@ var (
 	ErrMultipleTypes   = errors.New("arrowio: encountered multiple types (consider 'fuse')")
 	ErrNotRecord       = errors.New("arrowio: not a record")
 	ErrUnsupportedType = errors.New("arrowio: unsupported type")
+	ErrDecimalValue    = errors.New("arrowio: unrepresentable decimal value")
 )
 
 // Writer is a zio.Writer for the Arrow IPC stream format.  Given Zed values
@@ -40,6 +42,8 @@ type Writer struct {
 	builder          *array.RecordBuilder
 	unionTagMappings map[zed.Type][]int
 	typ              *zed.TypeRecord
+	// partial is true if a failed Write left a partial row in builder.
+	partial bool
 }
 
 func NewWriter(w io.WriteCloser) *Writer {
@@ -49,7 +53,9 @@ func NewWriter(w io.WriteCloser) *Writer {
 func (w *Writer) Close() error {
 	var err error
 	if w.writer != nil {
-		err = w.flush(1)
+		if !w.partial {
+			err = w.flush(1)
+		}
 		w.builder.Release()
 		if err2 := w.writer.Close(); err == nil {
 			err = err2
@@ -88,7 +94,10 @@ func (w *Writer) Write(val *zed.Value) error {
 		if it != nil {
 			b = it.Next()
 		}
-		w.buildArrowValue(builder, recType.Fields[i].Type, b)
+		if err := w.buildArrowValue(builder, recType.Fields[i].Type, b); err != nil {
+			w.partial = true
+			return err
+		}
 	}
 	return w.flush(recordBatchSize)
 }
@@ -170,6 +179,8 @@ func (w *Writer) newArrowDataType(typ zed.Type) (arrow.DataType, error) {
 		return arrow.PrimitiveTypes.Float32, nil
 	case *zed.TypeOfFloat64:
 		return arrow.PrimitiveTypes.Float64, nil
+	case *zed.TypeOfDecimal32, *zed.TypeOfDecimal64, *zed.TypeOfDecimal128, *zed.TypeOfDecimal256:
+		return newArrowDecimalType(typ, name), nil
 	case *zed.TypeOfBool:
 		return arrow.FixedWidthTypes.Boolean, nil
 	case *zed.TypeOfBytes:
@@ -201,10 +212,6 @@ func (w *Writer) newArrowDataType(typ zed.Type) (arrow.DataType, error) {
 			if fieldsEqual(typ.Fields, dayTimeIntervalFields) {
 				return arrow.FixedWidthTypes.DayTimeInterval, nil
 			}
-		case "arrow_decimal128":
-			if fieldsEqual(typ.Fields, decimal128Fields) {
-				return &arrow.Decimal128Type{}, nil
-			}
 		case "arrow_month_day_nano_interval":
 			if fieldsEqual(typ.Fields, monthDayNanoIntervalFields) {
 				return arrow.FixedWidthTypes.MonthDayNanoInterval, nil
@@ -234,10 +241,6 @@ func (w *Writer) newArrowDataType(typ zed.Type) (arrow.DataType, error) {
 			if n, err := strconv.Atoi(strings.TrimPrefix(name, prefix)); err == nil {
 				return arrow.FixedSizeListOf(int32(n), dt), nil
 			}
-		case name == "arrow_decimal256":
-			if inner := zed.InnerType(typ); inner == zed.TypeUint64 {
-				return &arrow.Decimal256Type{}, nil
-			}
 		case name == "arrow_large_list":
 			return arrow.LargeListOf(dt), nil
 		}
@@ -285,6 +288,45 @@ func (w *Writer) newArrowDataType(typ zed.Type) (arrow.DataType, error) {
 	}
 }
 
+// newArrowDecimalType returns the Arrow decimal type for the Zed decimal type
+// typ, which is given by name if name has the form
+// arrow_decimal{128,256}_<precision>_<scale> and is that of
+// defaultArrowDecimalType otherwise.
+func newArrowDecimalType(typ zed.Type, name string) arrow.DataType {
+	for _, prefix := range []string{"arrow_decimal128_", "arrow_decimal256_"} {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		p, s, ok := strings.Cut(strings.TrimPrefix(name, prefix), "_")
+		if !ok {
+			break
+		}
+		precision, err := strconv.ParseInt(p, 10, 32)
+		if err != nil {
+			break
+		}
+		scale, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			break
+		}
+		if prefix == "arrow_decimal128_" {
+			return &arrow.Decimal128Type{Precision: int32(precision), Scale: int32(scale)}
+		}
+		return &arrow.Decimal256Type{Precision: int32(precision), Scale: int32(scale)}
+	}
+	return defaultArrowDecimalType(typ)
+}
+
+// defaultArrowDecimalType returns the Arrow decimal type for the Zed decimal
+// type typ.  Its precision is that of typ and its scale is half that.
+func defaultArrowDecimalType(typ zed.Type) arrow.DataType {
+	precision := int32(zed.DecimalFormat(typ.ID()).Precision())
+	if typ.ID() == zed.IDDecimal256 {
+		return &arrow.Decimal256Type{Precision: precision, Scale: precision / 2}
+	}
+	return &arrow.Decimal128Type{Precision: precision, Scale: precision / 2}
+}
+
 func fieldsEqual(a, b []zed.Field) bool {
 	if len(a) != len(b) {
 		return false
@@ -297,10 +339,10 @@ func fieldsEqual(a, b []zed.Field) bool {
 	return true
 }
 
-func (w *Writer) buildArrowValue(b array.Builder, typ zed.Type, bytes zcode.Bytes) {
+func (w *Writer) buildArrowValue(b array.Builder, typ zed.Type, bytes zcode.Bytes) error {
 	if bytes == nil {
 		b.AppendNull()
-		return
+		return nil
 	}
 	var name string
 	if n, ok := typ.(*zed.TypeNamed); ok {
@@ -401,40 +443,46 @@ func (w *Writer) buildArrowValue(b array.Builder, typ zed.Type, bytes zcode.Byte
 			Milliseconds: int32(zed.DecodeInt(it.Next())),
 		})
 	case *array.Decimal128Builder:
-		it := bytes.Iter()
-		high := zed.DecodeInt(it.Next())
-		low := zed.DecodeUint(it.Next())
-		b.Append(decimal128.New(high, low))
+		unscaled, err := unscaledDecimal(b.Type().(arrow.DecimalType), typ, bytes)
+		if err != nil {
+			return err
+		}
+		b.Append(decimal128.FromBigInt(unscaled))
 	case *array.Decimal256Builder:
-		it := bytes.Iter()
-		x4 := zed.DecodeUint(it.Next())
-		x3 := zed.DecodeUint(it.Next())
-		x2 := zed.DecodeUint(it.Next())
-		x1 := zed.DecodeUint(it.Next())
-		b.Append(decimal256.New(x1, x2, x3, x4))
+		unscaled, err := unscaledDecimal(b.Type().(arrow.DecimalType), typ, bytes)
+		if err != nil {
+			return err
+		}
+		b.Append(decimal256.FromBigInt(unscaled))
 	case *array.ListBuilder:
-		w.buildArrowListValue(b, typ, bytes)
+		return w.buildArrowListValue(b, typ, bytes)
 	case *array.StructBuilder:
 		b.Append(true)
 		it := bytes.Iter()
 		for i, field := range zed.TypeRecordOf(typ).Fields {
-			w.buildArrowValue(b.FieldBuilder(i), field.Type, it.Next())
+			if err := w.buildArrowValue(b.FieldBuilder(i), field.Type, it.Next()); err != nil {
+				return err
+			}
 		}
 	case *array.DenseUnionBuilder:
 		it := bytes.Iter()
 		tag := zed.DecodeInt(it.Next())
 		typeCode := w.unionTagMappings[typ][tag]
 		b.Append(arrow.UnionTypeCode(typeCode))
-		w.buildArrowValue(b.Child(typeCode), typ.(*zed.TypeUnion).Types[tag], it.Next())
+		return w.buildArrowValue(b.Child(typeCode), typ.(*zed.TypeUnion).Types[tag], it.Next())
 	case *array.MapBuilder:
 		b.Append(true)
 		typ := zed.TypeUnder(typ).(*zed.TypeMap)
 		for it := bytes.Iter(); !it.Done(); {
-			w.buildArrowValue(b.KeyBuilder(), typ.KeyType, it.Next())
-			w.buildArrowValue(b.ItemBuilder(), typ.ValType, it.Next())
+			if err := w.buildArrowValue(b.KeyBuilder(), typ.KeyType, it.Next()); err != nil {
+				return err
+			}
+			if err := w.buildArrowValue(b.ItemBuilder(), typ.ValType, it.Next()); err != nil {
+				return err
+			}
 		}
 	case *array.FixedSizeListBuilder:
-		w.buildArrowListValue(b, typ, bytes)
+		return w.buildArrowListValue(b, typ, bytes)
 	case *array.DurationBuilder:
 		d := zed.DecodeDuration(bytes)
 		switch name {
@@ -449,7 +497,7 @@ func (w *Writer) buildArrowValue(b array.Builder, typ zed.Type, bytes zcode.Byte
 	case *array.LargeStringBuilder:
 		b.Append(zed.DecodeString(bytes))
 	case *array.LargeListBuilder:
-		w.buildArrowListValue(b, typ, bytes)
+		return w.buildArrowListValue(b, typ, bytes)
 	case *array.MonthDayNanoIntervalBuilder:
 		it := bytes.Iter()
 		b.Append(arrow.MonthDayNanoInterval{
@@ -460,11 +508,32 @@ func (w *Writer) buildArrowValue(b array.Builder, typ zed.Type, bytes zcode.Byte
 	default:
 		panic(fmt.Sprintf("unknown builder type %T", b))
 	}
+	return nil
 }
 
-func (w *Writer) buildArrowListValue(b array.ListLikeBuilder, typ zed.Type, bytes zcode.Bytes) {
+func (w *Writer) buildArrowListValue(b array.ListLikeBuilder, typ zed.Type, bytes zcode.Bytes) error {
 	b.Append(true)
 	for it := bytes.Iter(); !it.Done(); {
-		w.buildArrowValue(b.ValueBuilder(), zed.InnerType(typ), it.Next())
+		if err := w.buildArrowValue(b.ValueBuilder(), zed.InnerType(typ), it.Next()); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// unscaledDecimal returns the decimal value in bytes, which has type typ,
+// multiplied by ten to the scale of dt.  It returns an error if the value is
+// not finite, has more digits after the decimal point than the scale of dt,
+// or has too many digits for dt, so values are never rounded.
+func unscaledDecimal(dt arrow.DecimalType, typ zed.Type, bytes zcode.Bytes) (*big.Int, error) {
+	d, ok := zed.DecodeDecimal(bytes).Rescale(-int(dt.GetScale()))
+	if !ok || d.Coef.Cmp(pow10(dt.GetPrecision())) >= 0 {
+		val := zed.NewValue(typ, bytes)
+		return nil, fmt.Errorf("%w %s for Arrow type %s", ErrDecimalValue, zson.String(val), dt)
+	}
+	return d.BigInt(), nil
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
 }
@@ -0,0 +1,583 @@
+package avroio
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"math/big"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/decimal"
+	"github.com/brimdata/zed/pkg/nano"
+	"github.com/brimdata/zed/zcode"
+	"github.com/golang/snappy"
+	"golang.org/x/exp/slices"
+)
+
+// Magic is the first four bytes of an Avro object container file.
+const Magic = "Obj\x01"
+
+const syncSize = 16
+
+var errTruncated = errors.New("avroio: truncated data")
+
+// Reader is a zio.Reader for the Avro object container file format.
+type Reader struct {
+	zctx   *zed.Context
+	reader *bufio.Reader
+	schema *schema
+	codec  string
+	sync   [syncSize]byte
+
+	block   []byte
+	data    decoder
+	count   int64
+	flate   io.ReadCloser
+	builder zcode.Builder
+	val     zed.Value
+}
+
+func NewReader(zctx *zed.Context, r io.Reader) (*Reader, error) {
+	reader := &Reader{
+		zctx:   zctx,
+		reader: bufio.NewReader(r),
+	}
+	if err := reader.readHeader(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (r *Reader) readHeader() error {
+	magic := make([]byte, len(Magic))
+	if _, err := io.ReadFull(r.reader, magic); err != nil {
+		return noEOF(err)
+	}
+	if string(magic) != Magic {
+		return errors.New("avroio: magic number not found")
+	}
+	meta := map[string][]byte{}
+	for {
+		count, err := r.readBlockCount()
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			break
+		}
+		for ; count > 0; count-- {
+			key, err := r.readBytes()
+			if err != nil {
+				return err
+			}
+			val, err := r.readBytes()
+			if err != nil {
+				return err
+			}
+			meta[string(key)] = val
+		}
+	}
+	s, err := parseSchema(meta["avro.schema"])
+	if err != nil {
+		return err
+	}
+	if _, err := r.newZedType(s); err != nil {
+		return err
+	}
+	r.schema = s
+	switch codec := string(meta["avro.codec"]); codec {
+	case "", "null":
+		r.codec = "null"
+	case "deflate", "snappy":
+		r.codec = codec
+	default:
+		return fmt.Errorf("avroio: unsupported codec %q", codec)
+	}
+	if _, err := io.ReadFull(r.reader, r.sync[:]); err != nil {
+		return noEOF(err)
+	}
+	return nil
+}
+
+// readBlockCount reads the item count of a map or array block in the
+// header.  A negative count is followed by the size of the block.
+func (r *Reader) readBlockCount() (int64, error) {
+	count, err := binary.ReadVarint(r.reader)
+	if err != nil {
+		return 0, noEOF(err)
+	}
+	if count < 0 {
+		count = -count
+		if _, err := binary.ReadVarint(r.reader); err != nil {
+			return 0, noEOF(err)
+		}
+	}
+	return count, nil
+}
+
+func (r *Reader) readBytes() ([]byte, error) {
+	n, err := binary.ReadVarint(r.reader)
+	if err != nil {
+		return nil, noEOF(err)
+	}
+	if n < 0 || n > math.MaxInt32 {
+		return nil, fmt.Errorf("avroio: invalid length %d", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r.reader, b); err != nil {
+		return nil, noEOF(err)
+	}
+	return b, nil
+}
+
+func noEOF(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errTruncated
+	}
+	return err
+}
+
+func (r *Reader) Read() (*zed.Value, error) {
+	for r.count == 0 {
+		if err := r.readBlock(); err != nil {
+			if err == io.EOF {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+	r.builder.Truncate()
+	if err := r.decode(r.schema); err != nil {
+		return nil, err
+	}
+	r.count--
+	r.val = *zed.NewValue(r.schema.zedType, r.builder.Bytes().Body())
+	return &r.val, nil
+}
+
+func (r *Reader) readBlock() error {
+	count, err := binary.ReadVarint(r.reader)
+	if err != nil {
+		// EOF here means there are no more blocks.
+		return err
+	}
+	size, err := binary.ReadVarint(r.reader)
+	if err != nil {
+		return noEOF(err)
+	}
+	if count < 0 || size < 0 || size > math.MaxInt32 {
+		return fmt.Errorf("avroio: invalid block with count %d and size %d", count, size)
+	}
+	r.block = slices.Grow(r.block[:0], int(size))[:size]
+	if _, err := io.ReadFull(r.reader, r.block); err != nil {
+		return noEOF(err)
+	}
+	var sync [syncSize]byte
+	if _, err := io.ReadFull(r.reader, sync[:]); err != nil {
+		return noEOF(err)
+	}
+	if sync != r.sync {
+		return errors.New("avroio: sync marker mismatch")
+	}
+	data, err := r.decompress(r.block)
+	if err != nil {
+		return err
+	}
+	r.data = decoder(data)
+	r.count = count
+	return nil
+}
+
+func (r *Reader) decompress(block []byte) ([]byte, error) {
+	switch r.codec {
+	case "deflate":
+		if r.flate == nil {
+			r.flate = flate.NewReader(bytes.NewReader(block))
+		} else if err := r.flate.(flate.Resetter).Reset(bytes.NewReader(block), nil); err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(r.flate)
+		if err != nil {
+			return nil, fmt.Errorf("avroio: deflate: %w", err)
+		}
+		return data, nil
+	case "snappy":
+		// The snappy codec appends a big-endian CRC32 checksum of
+		// the uncompressed data to each block.
+		if len(block) < 4 {
+			return nil, errTruncated
+		}
+		n := len(block) - 4
+		data, err := snappy.Decode(nil, block[:n])
+		if err != nil {
+			return nil, fmt.Errorf("avroio: snappy: %w", err)
+		}
+		if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(block[n:]) {
+			return nil, errors.New("avroio: snappy: checksum mismatch")
+		}
+		return data, nil
+	}
+	return block, nil
+}
+
+func (r *Reader) newZedType(s *schema) (zed.Type, error) {
+	if s.zedType != nil {
+		return s.zedType, nil
+	}
+	typ, err := r.newZedTypeFromLogicalType(s)
+	if err != nil {
+		return nil, err
+	}
+	if typ == nil {
+		// Unknown or invalid logical types are ignored.
+		s.LogicalType = ""
+		if typ, err = r.newZedTypeFromType(s); err != nil {
+			return nil, err
+		}
+	}
+	s.zedType = typ
+	return typ, nil
+}
+
+func (r *Reader) newZedTypeFromLogicalType(s *schema) (zed.Type, error) {
+	switch s.LogicalType {
+	case "decimal":
+		if s.Type != "bytes" && s.Type != "fixed" || s.Precision <= 0 || s.Scale > s.Precision {
+			return nil, nil
+		}
+		return r.newZedDecimalType(s.Precision, s.Scale)
+	case "date":
+		if s.Type == "int" {
+			return r.zctx.LookupTypeNamed("avro_date", zed.TypeTime)
+		}
+	case "time-millis":
+		if s.Type == "int" {
+			return r.zctx.LookupTypeNamed("avro_time_millis", zed.TypeTime)
+		}
+	case "time-micros":
+		if s.Type == "long" {
+			return r.zctx.LookupTypeNamed("avro_time_micros", zed.TypeTime)
+		}
+	case "timestamp-millis", "timestamp-micros", "local-timestamp-millis", "local-timestamp-micros", "local-timestamp-nanos":
+		if s.Type == "long" {
+			return r.zctx.LookupTypeNamed(logicalTypeToName(s.LogicalType), zed.TypeTime)
+		}
+	case "timestamp-nanos":
+		if s.Type == "long" {
+			return zed.TypeTime, nil
+		}
+	}
+	return nil, nil
+}
+
+func logicalTypeToName(logicalType string) string {
+	b := []byte("avro_" + logicalType)
+	for i, c := range b {
+		if c == '-' {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+// newZedDecimalType returns the narrowest Zed decimal type that can hold
+// precision digits.  The type is named unless precision and scale match those
+// written for the Zed type by default (see defaultDecimalPrecisionAndScale).
+func (r *Reader) newZedDecimalType(precision, scale int) (zed.Type, error) {
+	typ := zed.DecimalTypeOfPrecision(precision)
+	if typ == nil {
+		return nil, fmt.Errorf("%w: decimal with precision %d", ErrUnsupportedType, precision)
+	}
+	if p, s := defaultDecimalPrecisionAndScale(typ); p == precision && s == scale {
+		return typ, nil
+	}
+	return r.zctx.LookupTypeNamed(fmt.Sprintf("avro_decimal_%d_%d", precision, scale), typ)
+}
+
+func (r *Reader) newZedTypeFromType(s *schema) (zed.Type, error) {
+	switch s.Type {
+	case "null":
+		return zed.TypeNull, nil
+	case "boolean":
+		return zed.TypeBool, nil
+	case "int":
+		return zed.TypeInt32, nil
+	case "long":
+		return zed.TypeInt64, nil
+	case "float":
+		return zed.TypeFloat32, nil
+	case "double":
+		return zed.TypeFloat64, nil
+	case "bytes":
+		return zed.TypeBytes, nil
+	case "string":
+		return zed.TypeString, nil
+	case "record":
+		var fields []zed.Field
+		for _, f := range s.Fields {
+			typ, err := r.newZedType(f.Schema)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, zed.NewField(f.Name, typ))
+		}
+		return r.zctx.LookupTypeRecord(fields)
+	case "enum":
+		return r.zctx.LookupTypeEnum(s.Symbols), nil
+	case "array":
+		typ, err := r.newZedType(s.Items)
+		if err != nil {
+			return nil, err
+		}
+		return r.zctx.LookupTypeArray(typ), nil
+	case "map":
+		typ, err := r.newZedType(s.Values)
+		if err != nil {
+			return nil, err
+		}
+		return r.zctx.LookupTypeMap(zed.TypeString, typ), nil
+	case "fixed":
+		return r.zctx.LookupTypeNamed(fmt.Sprintf("avro_fixed_%d", s.Size), zed.TypeBytes)
+	case "union":
+		return r.newZedUnionType(s)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, s.Type)
+}
+
+// newZedUnionType returns the Zed type for union s.  Since any Zed value may
+// be null, a null branch is dropped, and a union with a single remaining
+// branch (e.g., ["null", "string"]) becomes the type of that branch.
+func (r *Reader) newZedUnionType(s *schema) (zed.Type, error) {
+	var types []zed.Type
+	for _, branch := range s.Branches {
+		typ, err := r.newZedType(branch)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, typ)
+	}
+	var nonNull []zed.Type
+	for _, typ := range types {
+		if typ != zed.TypeNull {
+			nonNull = append(nonNull, typ)
+		}
+	}
+	uniqueTypes := zed.UniqueTypes(nonNull)
+	switch len(uniqueTypes) {
+	case 0:
+		return zed.TypeNull, nil
+	case 1:
+		return uniqueTypes[0], nil
+	}
+	union := r.zctx.LookupTypeUnion(uniqueTypes)
+	for _, typ := range types {
+		tag := -1
+		if typ != zed.TypeNull {
+			tag = union.TagOf(typ)
+		}
+		s.tags = append(s.tags, tag)
+	}
+	return union, nil
+}
+
+func (r *Reader) decode(s *schema) error {
+	b := &r.builder
+	d := &r.data
+	switch s.Type {
+	case "null":
+		b.Append(nil)
+	case "boolean":
+		v, err := d.bytes(1)
+		if err != nil {
+			return err
+		}
+		b.Append(zed.EncodeBool(v[0] != 0))
+	case "int", "long":
+		v, err := d.long()
+		if err != nil {
+			return err
+		}
+		switch s.LogicalType {
+		case "":
+			b.Append(zed.EncodeInt(v))
+		case "date":
+			b.Append(zed.EncodeTime(nano.Ts(v * 86400 * int64(nano.Second))))
+		case "time-millis", "timestamp-millis", "local-timestamp-millis":
+			b.Append(zed.EncodeTime(nano.Ts(v * int64(nano.Millisecond))))
+		case "time-micros", "timestamp-micros", "local-timestamp-micros":
+			b.Append(zed.EncodeTime(nano.Ts(v * int64(nano.Microsecond))))
+		case "timestamp-nanos", "local-timestamp-nanos":
+			b.Append(zed.EncodeTime(nano.Ts(v)))
+		}
+	case "float":
+		v, err := d.bytes(4)
+		if err != nil {
+			return err
+		}
+		b.Append(zed.EncodeFloat32(math.Float32frombits(binary.LittleEndian.Uint32(v))))
+	case "double":
+		v, err := d.bytes(8)
+		if err != nil {
+			return err
+		}
+		b.Append(zed.EncodeFloat64(math.Float64frombits(binary.LittleEndian.Uint64(v))))
+	case "bytes", "string", "fixed":
+		var v []byte
+		var err error
+		if s.Type == "fixed" {
+			v, err = d.bytes(s.Size)
+		} else {
+			v, err = d.lengthBytes()
+		}
+		if err != nil {
+			return err
+		}
+		if s.LogicalType == "decimal" {
+			d := decimal.NewFromBigInt(decodeTwosComplement(v), -s.Scale)
+			if _, ok := s.zedType.(*zed.TypeNamed); !ok {
+				// The scale is the default for the Zed type rather
+				// than that of the value written, so drop the zeros
+				// it added.
+				d = d.TrimFraction()
+			}
+			b.Append(zed.EncodeDecimal(zed.TypeUnder(s.zedType).ID(), d))
+		} else {
+			b.Append(v)
+		}
+	case "record":
+		b.BeginContainer()
+		for _, f := range s.Fields {
+			if err := r.decode(f.Schema); err != nil {
+				return err
+			}
+		}
+		b.EndContainer()
+	case "enum":
+		v, err := d.long()
+		if err != nil {
+			return err
+		}
+		if v < 0 || v >= int64(len(s.Symbols)) {
+			return fmt.Errorf("avroio: enum index %d out of range", v)
+		}
+		b.Append(zed.EncodeUint(uint64(v)))
+	case "array", "map":
+		b.BeginContainer()
+		for {
+			count, err := d.blockCount()
+			if err != nil {
+				return err
+			}
+			if count == 0 {
+				break
+			}
+			for ; count > 0; count-- {
+				if s.Type == "array" {
+					err = r.decode(s.Items)
+				} else {
+					err = r.decodeMapEntry(s)
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if s.Type == "map" {
+			b.TransformContainer(zed.NormalizeMap)
+		}
+		b.EndContainer()
+	case "union":
+		v, err := d.long()
+		if err != nil {
+			return err
+		}
+		if v < 0 || v >= int64(len(s.Branches)) {
+			return fmt.Errorf("avroio: union index %d out of range", v)
+		}
+		branch := s.Branches[v]
+		if s.tags == nil || branch.zedType == zed.TypeNull {
+			return r.decode(branch)
+		}
+		b.BeginContainer()
+		b.Append(zed.EncodeInt(int64(s.tags[v])))
+		if err := r.decode(branch); err != nil {
+			return err
+		}
+		b.EndContainer()
+	default:
+		panic(fmt.Sprintf("unknown Avro type %q", s.Type))
+	}
+	return nil
+}
+
+func (r *Reader) decodeMapEntry(s *schema) error {
+	key, err := r.data.lengthBytes()
+	if err != nil {
+		return err
+	}
+	r.builder.Append(key)
+	return r.decode(s.Values)
+}
+
+// decodeTwosComplement returns the big-endian two's-complement integer in b.
+func decodeTwosComplement(b []byte) *big.Int {
+	x := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		x.Sub(x, new(big.Int).Lsh(big.NewInt(1), uint(len(b))*8))
+	}
+	return x
+}
+
+// decoder decodes Avro binary encoding.
+type decoder []byte
+
+func (d *decoder) long() (int64, error) {
+	v, n := binary.Varint(*d)
+	if n <= 0 {
+		return 0, errTruncated
+	}
+	*d = (*d)[n:]
+	return v, nil
+}
+
+// blockCount returns the item count of an array or map block.
+func (d *decoder) blockCount() (int64, error) {
+	count, err := d.long()
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 {
+		// A negative count is followed by the size of the block.
+		count = -count
+		if _, err := d.long(); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+func (d *decoder) bytes(n int) ([]byte, error) {
+	if n < 0 || n > len(*d) {
+		return nil, errTruncated
+	}
+	b := (*d)[:n]
+	*d = (*d)[n:]
+	return b, nil
+}
+
+func (d *decoder) lengthBytes() ([]byte, error) {
+	n, err := d.long()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > int64(len(*d)) {
+		return nil, errTruncated
+	}
+	return d.bytes(int(n))
+}
@@ -0,0 +1,134 @@
+package avroio
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zson"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+	"type": "record",
+	"name": "r",
+	"namespace": "ns",
+	"fields": [
+		{"name": "s", "type": ["string", "null"]},
+		{"name": "p", "type": {"type": "record", "name": "point", "fields": [{"name": "x", "type": "int"}]}},
+		{"name": "q", "type": "point"},
+		{"name": "ts", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+		{"name": "d", "type": {"type": "bytes", "logicalType": "decimal", "precision": 5, "scale": 2}},
+		{"name": "a", "type": {"type": "array", "items": "long"}}
+	]
+}`
+
+// testRecords returns two records encoded with testSchema.
+func testRecords() [][]byte {
+	// {s:"hi",p:{x:1},q:{x:-1},ts:1000,d:123.45,a:[1,2]}
+	var b []byte
+	b = appendVarints(b, 0, 2)
+	b = append(b, "hi"...)
+	b = appendVarints(b, 1, -1, 1000, 2)
+	b = append(b, 0x30, 0x39)
+	// Write the array as one block with a negative count and a size.
+	b = appendVarints(b, -2, 2, 1, 2, 0)
+	// {s:null,p:{x:0},q:{x:0},ts:0,d:0,a:[]}
+	b2 := appendVarints(nil, 1, 0, 0, 0, 1)
+	b2 = append(b2, 0)
+	b2 = appendVarints(b2, 0)
+	return [][]byte{b, b2}
+}
+
+const testOutput = `{s:"hi",p:{x:1(int32)},q:{x:-1(int32)},ts:1970-01-01T00:00:01Z(=avro_timestamp_millis),d:123.45(avro_decimal_5_2=decimal32),a:[1,2]}
+{s:null(string),p:{x:0(int32)},q:{x:0(int32)},ts:1970-01-01T00:00:00Z(=avro_timestamp_millis),d:0.00(avro_decimal_5_2=decimal32),a:[]([int64])}
+`
+
+func appendVarints(b []byte, vals ...int64) []byte {
+	for _, v := range vals {
+		b = binary.AppendVarint(b, v)
+	}
+	return b
+}
+
+func newTestFile(codec string, blocks ...[]byte) []byte {
+	sync := []byte("0123456789abcdef")
+	b := []byte(Magic)
+	b = appendVarints(b, 2)
+	b = appendBytes(b, []byte("avro.schema"))
+	b = appendBytes(b, []byte(testSchema))
+	b = appendBytes(b, []byte("avro.codec"))
+	b = appendBytes(b, []byte(codec))
+	b = appendVarints(b, 0)
+	b = append(b, sync...)
+	for _, block := range blocks {
+		b = appendVarints(b, 1, int64(len(block)))
+		b = append(b, block...)
+		b = append(b, sync...)
+	}
+	return b
+}
+
+func readAll(t *testing.T, data []byte) string {
+	r, err := NewReader(zed.NewContext(), bytes.NewReader(data))
+	require.NoError(t, err)
+	var out string
+	for {
+		val, err := r.Read()
+		require.NoError(t, err)
+		if val == nil {
+			return out
+		}
+		out += zson.String(val) + "\n"
+	}
+}
+
+func TestReaderCodecs(t *testing.T) {
+	blocks := testRecords()
+	t.Run("null", func(t *testing.T) {
+		require.Equal(t, testOutput, readAll(t, newTestFile("null", blocks...)))
+	})
+	t.Run("deflate", func(t *testing.T) {
+		var compressed [][]byte
+		for _, b := range blocks {
+			var buf bytes.Buffer
+			w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+			require.NoError(t, err)
+			_, err = w.Write(b)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			compressed = append(compressed, buf.Bytes())
+		}
+		require.Equal(t, testOutput, readAll(t, newTestFile("deflate", compressed...)))
+	})
+	t.Run("snappy", func(t *testing.T) {
+		var compressed [][]byte
+		for _, b := range blocks {
+			c := snappy.Encode(nil, b)
+			c = binary.BigEndian.AppendUint32(c, crc32.ChecksumIEEE(b))
+			compressed = append(compressed, c)
+		}
+		require.Equal(t, testOutput, readAll(t, newTestFile("snappy", compressed...)))
+	})
+}
+
+func TestReaderErrors(t *testing.T) {
+	blocks := testRecords()
+	file := newTestFile("null", blocks...)
+
+	_, err := NewReader(zed.NewContext(), bytes.NewReader(file[:len(Magic)+4]))
+	require.ErrorIs(t, err, errTruncated)
+
+	// Corrupt the final sync marker.
+	file[len(file)-1] ^= 0xff
+	r, err := NewReader(zed.NewContext(), bytes.NewReader(file))
+	require.NoError(t, err)
+	_, err = r.Read()
+	require.NoError(t, err)
+	_, err = r.Read()
+	require.EqualError(t, err, "avroio: sync marker mismatch")
+}
@@ -0,0 +1,239 @@
+package avroio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/zed"
+)
+
+// schema is a parsed Avro schema.
+type schema struct {
+	Type string // Primitive type name, "record", "enum", "array", "map", "fixed", or "union".
+	Name string // Full name of a record, enum, or fixed.
+
+	Fields   []field   // For "record".
+	Symbols  []string  // For "enum".
+	Items    *schema   // For "array".
+	Values   *schema   // For "map".
+	Size     int       // For "fixed".
+	Branches []*schema // For "union".
+
+	LogicalType string
+	Precision   int
+	Scale       int
+
+	// zedType and tags are set by Reader.newZedType.  For a union whose
+	// Zed type is a union, tags maps each branch to its Zed union tag.
+	zedType zed.Type
+	tags    []int
+}
+
+type field struct {
+	Name   string
+	Schema *schema
+}
+
+func isPrimitive(name string) bool {
+	switch name {
+	case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+		return true
+	}
+	return false
+}
+
+func parseSchema(data []byte) (*schema, error) {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("avroio: invalid schema: %w", err)
+	}
+	p := &schemaParser{
+		names:   map[string]*schema{},
+		pending: map[string]bool{},
+	}
+	s, err := p.parse(v, "")
+	if err != nil {
+		return nil, fmt.Errorf("avroio: invalid schema: %w", err)
+	}
+	return s, nil
+}
+
+type schemaParser struct {
+	// names holds named types by full name.
+	names map[string]*schema
+	// pending holds the full names of records whose fields are being
+	// parsed.
+	pending map[string]bool
+}
+
+func (p *schemaParser) parse(v interface{}, namespace string) (*schema, error) {
+	switch v := v.(type) {
+	case string:
+		if isPrimitive(v) {
+			return &schema{Type: v}, nil
+		}
+		return p.lookup(v, namespace)
+	case []interface{}:
+		if len(v) == 0 {
+			return nil, errors.New("empty union")
+		}
+		s := &schema{Type: "union"}
+		for _, b := range v {
+			branch, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			if branch.Type == "union" {
+				return nil, errors.New("union contains a union")
+			}
+			s.Branches = append(s.Branches, branch)
+		}
+		return s, nil
+	case map[string]interface{}:
+		return p.parseObject(v, namespace)
+	}
+	return nil, fmt.Errorf("unexpected JSON value: %v", v)
+}
+
+func (p *schemaParser) parseObject(obj map[string]interface{}, namespace string) (*schema, error) {
+	typ, ok := obj["type"].(string)
+	if !ok {
+		// The type is itself a schema and any other attributes are
+		// annotations.
+		if obj["type"] == nil {
+			return nil, errors.New("missing type")
+		}
+		return p.parse(obj["type"], namespace)
+	}
+	var s *schema
+	switch typ {
+	case "record", "error", "enum", "fixed":
+		var err error
+		if s, err = p.parseNamed(obj, typ, namespace); err != nil {
+			return nil, err
+		}
+	case "array":
+		items, err := p.parse(obj["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s = &schema{Type: typ, Items: items}
+	case "map":
+		values, err := p.parse(obj["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s = &schema{Type: typ, Values: values}
+	default:
+		if !isPrimitive(typ) {
+			return p.lookup(typ, namespace)
+		}
+		s = &schema{Type: typ}
+	}
+	if logicalType, ok := obj["logicalType"].(string); ok {
+		s.LogicalType = logicalType
+		s.Precision = intAttr(obj, "precision")
+		s.Scale = intAttr(obj, "scale")
+		if s.Scale < 0 {
+			// The scale defaults to zero.
+			s.Scale = 0
+		}
+	}
+	return s, nil
+}
+
+func (p *schemaParser) parseNamed(obj map[string]interface{}, typ, namespace string) (*schema, error) {
+	name, _ := obj["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("%s has no name", typ)
+	}
+	if ns, ok := obj["namespace"].(string); ok && !strings.Contains(name, ".") {
+		namespace = ns
+	}
+	name = fullName(name, namespace)
+	if _, ok := p.names[name]; ok {
+		return nil, fmt.Errorf("duplicate name %q", name)
+	}
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		namespace = name[:i]
+	} else {
+		namespace = ""
+	}
+	s := &schema{Type: typ, Name: name}
+	p.names[name] = s
+	switch typ {
+	case "record", "error":
+		s.Type = "record"
+		fields, ok := obj["fields"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("record %q has no fields", name)
+		}
+		p.pending[name] = true
+		for _, f := range fields {
+			f, ok := f.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("record %q has invalid field", name)
+			}
+			fieldName, _ := f["name"].(string)
+			if fieldName == "" {
+				return nil, fmt.Errorf("record %q has field with no name", name)
+			}
+			fieldSchema, err := p.parse(f["type"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.Fields = append(s.Fields, field{fieldName, fieldSchema})
+		}
+		delete(p.pending, name)
+	case "enum":
+		symbols, ok := obj["symbols"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("enum %q has no symbols", name)
+		}
+		for _, sym := range symbols {
+			sym, ok := sym.(string)
+			if !ok {
+				return nil, fmt.Errorf("enum %q has invalid symbol", name)
+			}
+			s.Symbols = append(s.Symbols, sym)
+		}
+	case "fixed":
+		s.Size = intAttr(obj, "size")
+		if s.Size < 0 {
+			return nil, fmt.Errorf("fixed %q has invalid size", name)
+		}
+	}
+	return s, nil
+}
+
+func (p *schemaParser) lookup(name, namespace string) (*schema, error) {
+	s, ok := p.names[fullName(name, namespace)]
+	if !ok {
+		// A name without a dot may also refer to a type in the null
+		// namespace.
+		s, ok = p.names[name]
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q", name)
+	}
+	if p.pending[s.Name] {
+		return nil, fmt.Errorf("recursive type %q is not supported", s.Name)
+	}
+	return s, nil
+}
+
+func fullName(name, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func intAttr(obj map[string]interface{}, name string) int {
+	if f, ok := obj[name].(float64); ok {
+		return int(f)
+	}
+	return -1
+}
@@ -0,0 +1,517 @@
+package avroio
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/nano"
+	"github.com/brimdata/zed/zcode"
+	"github.com/brimdata/zed/zson"
+)
+
+var (
+	ErrMultipleTypes        = errors.New("avroio: encountered multiple types (consider 'fuse')")
+	ErrNotRecord            = errors.New("avroio: not a record")
+	ErrUnsupportedType      = errors.New("avroio: unsupported type")
+	ErrUnrepresentableValue = errors.New("avroio: unrepresentable value")
+)
+
+// Writer is a zio.Writer for the Avro object container file format.  Since
+// an object container file has a single schema, all values written must be
+// records of the same type.  Every field is written as a union with null so
+// that any Zed value can be represented.  Zed types with no Avro counterpart
+// are written as the nearest Avro type (e.g., uint16 as int and ip as
+// string), while Zed types named by Reader for Avro logical and fixed types
+// are written as those types.
+type Writer struct {
+	w                io.WriteCloser
+	typ              *zed.TypeRecord
+	names            map[zed.Type]string
+	unionTagMappings map[zed.Type][]int
+	sync             [syncSize]byte
+
+	block []byte
+	count int
+	buf   []byte
+}
+
+func NewWriter(w io.WriteCloser) *Writer {
+	return &Writer{
+		w:                w,
+		names:            map[zed.Type]string{},
+		unionTagMappings: map[zed.Type][]int{},
+	}
+}
+
+func (w *Writer) Close() error {
+	err := w.flush()
+	if err2 := w.w.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+const (
+	blockCount = 1024
+	blockSize  = 1024 * 1024
+)
+
+func (w *Writer) Write(val *zed.Value) error {
+	recType, ok := zed.TypeUnder(val.Type).(*zed.TypeRecord)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotRecord, zson.MustFormatValue(val))
+	}
+	if w.typ == nil {
+		if err := w.writeHeader(recType); err != nil {
+			return err
+		}
+		w.typ = recType
+	} else if w.typ != recType {
+		return fmt.Errorf("%w: %s and %s", ErrMultipleTypes, zson.FormatType(w.typ), zson.FormatType(recType))
+	}
+	block, err := w.encode(w.block, recType, val.Bytes)
+	if err != nil {
+		return err
+	}
+	w.block = block
+	w.count++
+	if w.count >= blockCount || len(w.block) >= blockSize {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *Writer) writeHeader(typ *zed.TypeRecord) error {
+	s, err := w.newAvroSchema(typ)
+	if err != nil {
+		return err
+	}
+	schemaJSON, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	// The sync marker is derived from the schema so that output is
+	// deterministic.
+	w.sync = md5.Sum(schemaJSON)
+	b := append([]byte(nil), Magic...)
+	b = appendLong(b, 2)
+	b = appendBytes(b, []byte("avro.codec"))
+	b = appendBytes(b, []byte("null"))
+	b = appendBytes(b, []byte("avro.schema"))
+	b = appendBytes(b, schemaJSON)
+	b = appendLong(b, 0)
+	b = append(b, w.sync[:]...)
+	_, err = w.w.Write(b)
+	return err
+}
+
+func (w *Writer) flush() error {
+	if w.count == 0 {
+		return nil
+	}
+	w.buf = appendLong(w.buf[:0], int64(w.count))
+	w.buf = appendLong(w.buf, int64(len(w.block)))
+	if _, err := w.w.Write(w.buf); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(w.block); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(w.sync[:]); err != nil {
+		return err
+	}
+	w.block = w.block[:0]
+	w.count = 0
+	return nil
+}
+
+// newAvroSchema returns the JSON form of the Avro schema for typ.
+func (w *Writer) newAvroSchema(typ zed.Type) (interface{}, error) {
+	// Avro named types are defined once and then referenced by name.
+	// Records and enums are keyed by their Zed type and fixed types by
+	// their Zed named type.
+	if name, ok := w.names[typ]; ok {
+		return name, nil
+	}
+	named := typ
+	var name string
+	if n, ok := typ.(*zed.TypeNamed); ok {
+		name = n.Name
+		typ = zed.TypeUnder(n.Type)
+		if name, ok := w.names[typ]; ok {
+			return name, nil
+		}
+	}
+	// Order here follows that of the zed.ID* and zed.TypeValue* constants.
+	switch typ := typ.(type) {
+	case *zed.TypeOfUint8, *zed.TypeOfUint16, *zed.TypeOfInt8, *zed.TypeOfInt16, *zed.TypeOfInt32:
+		return "int", nil
+	case *zed.TypeOfUint32, *zed.TypeOfUint64, *zed.TypeOfInt64, *zed.TypeOfDuration:
+		return "long", nil
+	case *zed.TypeOfTime:
+		switch name {
+		case "avro_date":
+			return logicalType("int", "date"), nil
+		case "avro_time_millis":
+			return logicalType("int", "time-millis"), nil
+		case "avro_time_micros":
+			return logicalType("long", "time-micros"), nil
+		case "avro_timestamp_millis", "avro_timestamp_micros",
+			"avro_local_timestamp_millis", "avro_local_timestamp_micros", "avro_local_timestamp_nanos":
+			lt := strings.ReplaceAll(strings.TrimPrefix(name, "avro_"), "_", "-")
+			return logicalType("long", lt), nil
+		}
+		return logicalType("long", "timestamp-nanos"), nil
+	case *zed.TypeOfFloat16, *zed.TypeOfFloat32:
+		return "float", nil
+	case *zed.TypeOfFloat64:
+		return "double", nil
+	case *zed.TypeOfDecimal32, *zed.TypeOfDecimal64, *zed.TypeOfDecimal128, *zed.TypeOfDecimal256:
+		precision, scale := decimalPrecisionAndScale(typ, name)
+		s := logicalType("bytes", "decimal")
+		s["precision"] = precision
+		s["scale"] = scale
+		return s, nil
+	case *zed.TypeOfBool:
+		return "boolean", nil
+	case *zed.TypeOfBytes:
+		if size, ok := fixedSize(name); ok {
+			return w.newNamedSchema(named, "fixed", map[string]interface{}{"size": size}), nil
+		}
+		return "bytes", nil
+	case *zed.TypeOfString, *zed.TypeOfIP, *zed.TypeOfNet, *zed.TypeOfType:
+		return "string", nil
+	case *zed.TypeOfNull:
+		return "null", nil
+	case *zed.TypeRecord:
+		fields := []interface{}{}
+		for _, f := range typ.Fields {
+			if f.Name == "" {
+				return nil, fmt.Errorf("%w: record with empty field name: %s", ErrUnsupportedType, zson.FormatType(typ))
+			}
+			s, err := w.newNullableAvroSchema(f.Type)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, map[string]interface{}{"name": f.Name, "type": s})
+		}
+		return w.newNamedSchema(typ, "record", map[string]interface{}{"fields": fields}), nil
+	case *zed.TypeArray, *zed.TypeSet:
+		items, err := w.newNullableAvroSchema(zed.InnerType(typ))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "array", "items": items}, nil
+	case *zed.TypeMap:
+		if zed.TypeUnder(typ.KeyType) != zed.TypeString {
+			return nil, fmt.Errorf("%w: map with non-string key: %s", ErrUnsupportedType, zson.FormatType(typ))
+		}
+		values, err := w.newNullableAvroSchema(typ.ValType)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "map", "values": values}, nil
+	case *zed.TypeEnum:
+		return w.newNamedSchema(typ, "enum", map[string]interface{}{"symbols": typ.Symbols}), nil
+	case *zed.TypeError:
+		return "string", nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, zson.FormatType(typ))
+}
+
+// newNullableAvroSchema returns the JSON form of an Avro union of null and
+// the schema for typ.  If typ is a Zed union, its types become additional
+// branches of the Avro union.
+func (w *Writer) newNullableAvroSchema(typ zed.Type) (interface{}, error) {
+	union, ok := zed.TypeUnder(typ).(*zed.TypeUnion)
+	if !ok {
+		s, err := w.newAvroSchema(typ)
+		if err != nil || s == "null" {
+			return s, err
+		}
+		return []interface{}{"null", s}, nil
+	}
+	branches := []interface{}{"null"}
+	// kinds holds the Avro type of each unnamed branch since a union may
+	// not contain two of them.
+	kinds := map[string]int{"null": 0}
+	var mapping []int
+	for _, typ := range union.Types {
+		if zed.TypeUnder(typ) == zed.TypeNull {
+			mapping = append(mapping, 0)
+			continue
+		}
+		if _, ok := zed.TypeUnder(typ).(*zed.TypeUnion); ok {
+			return nil, fmt.Errorf("%w: union containing a union: %s", ErrUnsupportedType, zson.FormatType(union))
+		}
+		s, err := w.newAvroSchema(typ)
+		if err != nil {
+			return nil, err
+		}
+		if kind := unnamedKind(s); kind != "" {
+			if i, ok := kinds[kind]; ok {
+				if !schemasEqual(branches[i], s) {
+					return nil, fmt.Errorf("%w: union with multiple Avro %s types: %s", ErrUnsupportedType, kind, zson.FormatType(union))
+				}
+				mapping = append(mapping, i)
+				continue
+			}
+			kinds[kind] = len(branches)
+		}
+		mapping = append(mapping, len(branches))
+		branches = append(branches, s)
+	}
+	w.unionTagMappings[union] = mapping
+	return branches, nil
+}
+
+func (w *Writer) newNamedSchema(typ zed.Type, avroType string, attrs map[string]interface{}) map[string]interface{} {
+	name := avroType + strconv.Itoa(len(w.names))
+	w.names[typ] = name
+	attrs["type"] = avroType
+	attrs["name"] = name
+	return attrs
+}
+
+func logicalType(typ, logicalType string) map[string]interface{} {
+	return map[string]interface{}{"type": typ, "logicalType": logicalType}
+}
+
+// unnamedKind returns the Avro type of schema s if s is not a named type
+// and the empty string otherwise.
+func unnamedKind(s interface{}) string {
+	switch s := s.(type) {
+	case string:
+		if isPrimitive(s) {
+			return s
+		}
+	case map[string]interface{}:
+		if _, ok := s["name"]; !ok {
+			return s["type"].(string)
+		}
+	}
+	return ""
+}
+
+func schemasEqual(a, b interface{}) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	return err == nil && string(aJSON) == string(bJSON)
+}
+
+// fixedSize returns the size of the Avro fixed type that name (as created by
+// Reader) represents.
+func fixedSize(name string) (int, bool) {
+	const prefix = "avro_fixed_"
+	if !strings.HasPrefix(name, prefix) {
+		return 0, false
+	}
+	size, err := strconv.Atoi(strings.TrimPrefix(name, prefix))
+	return size, err == nil && size >= 0
+}
+
+// decimalPrecisionAndScale returns the precision and scale of the Avro
+// decimal type for the Zed decimal type typ, which are given by name if name
+// has the form avro_decimal_<precision>_<scale> and are those of
+// defaultDecimalPrecisionAndScale otherwise.
+func decimalPrecisionAndScale(typ zed.Type, name string) (int, int) {
+	const prefix = "avro_decimal_"
+	if strings.HasPrefix(name, prefix) {
+		if p, s, ok := strings.Cut(strings.TrimPrefix(name, prefix), "_"); ok {
+			precision, err1 := strconv.Atoi(p)
+			scale, err2 := strconv.Atoi(s)
+			if err1 == nil && err2 == nil && precision > 0 && scale >= 0 && scale <= precision {
+				return precision, scale
+			}
+		}
+	}
+	return defaultDecimalPrecisionAndScale(typ)
+}
+
+// defaultDecimalPrecisionAndScale returns the precision and scale of the Avro
+// decimal type for the Zed decimal type typ.  Its precision is that of typ
+// and its scale is half that.
+func defaultDecimalPrecisionAndScale(typ zed.Type) (int, int) {
+	precision := zed.DecimalFormat(typ.ID()).Precision()
+	return precision, precision / 2
+}
+
+// encodeNullable appends the Avro encoding of the value in bytes, which has
+// type typ, as a union of null and typ (see newNullableAvroSchema).
+func (w *Writer) encodeNullable(dst []byte, typ zed.Type, bytes zcode.Bytes) ([]byte, error) {
+	under := zed.TypeUnder(typ)
+	if under == zed.TypeNull {
+		return dst, nil
+	}
+	if bytes == nil {
+		return appendLong(dst, 0), nil
+	}
+	union, ok := under.(*zed.TypeUnion)
+	if !ok {
+		return w.encode(appendLong(dst, 1), typ, bytes)
+	}
+	it := bytes.Iter()
+	tag := zed.DecodeInt(it.Next())
+	bytes = it.Next()
+	index := w.unionTagMappings[union][tag]
+	if index == 0 || bytes == nil {
+		return appendLong(dst, 0), nil
+	}
+	return w.encode(appendLong(dst, int64(index)), union.Types[tag], bytes)
+}
+
+// encode appends the Avro encoding of the value in bytes, which has type typ
+// and is not null.
+func (w *Writer) encode(dst []byte, typ zed.Type, bytes zcode.Bytes) ([]byte, error) {
+	var name string
+	if n, ok := typ.(*zed.TypeNamed); ok {
+		name = n.Name
+		typ = zed.TypeUnder(n.Type)
+	}
+	switch typ := typ.(type) {
+	case *zed.TypeOfUint8, *zed.TypeOfUint16, *zed.TypeOfUint32:
+		return appendLong(dst, int64(zed.DecodeUint(bytes))), nil
+	case *zed.TypeOfUint64:
+		v := zed.DecodeUint(bytes)
+		if v > math.MaxInt64 {
+			return nil, fmt.Errorf("%w: %d(uint64) exceeds maximum Avro long", ErrUnrepresentableValue, v)
+		}
+		return appendLong(dst, int64(v)), nil
+	case *zed.TypeOfInt8, *zed.TypeOfInt16, *zed.TypeOfInt32, *zed.TypeOfInt64:
+		return appendLong(dst, zed.DecodeInt(bytes)), nil
+	case *zed.TypeOfDuration:
+		return appendLong(dst, int64(zed.DecodeDuration(bytes))), nil
+	case *zed.TypeOfTime:
+		ts := zed.DecodeTime(bytes)
+		switch name {
+		case "avro_date":
+			ts /= 86400 * nano.Ts(nano.Second)
+		case "avro_time_millis", "avro_timestamp_millis", "avro_local_timestamp_millis":
+			ts /= nano.Ts(nano.Millisecond)
+		case "avro_time_micros", "avro_timestamp_micros", "avro_local_timestamp_micros":
+			ts /= nano.Ts(nano.Microsecond)
+		}
+		return appendLong(dst, int64(ts)), nil
+	case *zed.TypeOfFloat16:
+		return binary.LittleEndian.AppendUint32(dst, math.Float32bits(zed.DecodeFloat16(bytes))), nil
+	case *zed.TypeOfFloat32:
+		return binary.LittleEndian.AppendUint32(dst, math.Float32bits(zed.DecodeFloat32(bytes))), nil
+	case *zed.TypeOfFloat64:
+		return binary.LittleEndian.AppendUint64(dst, math.Float64bits(zed.DecodeFloat64(bytes))), nil
+	case *zed.TypeOfDecimal32, *zed.TypeOfDecimal64, *zed.TypeOfDecimal128, *zed.TypeOfDecimal256:
+		precision, scale := decimalPrecisionAndScale(typ, name)
+		d, ok := zed.DecodeDecimal(bytes).Rescale(-scale)
+		if !ok || d.Coef.Cmp(pow10(precision)) >= 0 {
+			val := zed.NewValue(typ, bytes)
+			return nil, fmt.Errorf("%w: %s for Avro decimal(%d,%d)", ErrUnrepresentableValue, zson.String(val), precision, scale)
+		}
+		return appendBytes(dst, encodeTwosComplement(d.BigInt())), nil
+	case *zed.TypeOfBool:
+		if zed.DecodeBool(bytes) {
+			return append(dst, 1), nil
+		}
+		return append(dst, 0), nil
+	case *zed.TypeOfBytes:
+		if size, ok := fixedSize(name); ok {
+			if len(bytes) != size {
+				return nil, fmt.Errorf("%w: %d bytes for Avro fixed of size %d", ErrUnrepresentableValue, len(bytes), size)
+			}
+			return append(dst, bytes...), nil
+		}
+		return appendBytes(dst, bytes), nil
+	case *zed.TypeOfString:
+		return appendBytes(dst, bytes), nil
+	case *zed.TypeOfIP:
+		return appendBytes(dst, []byte(zed.DecodeIP(bytes).String())), nil
+	case *zed.TypeOfNet:
+		return appendBytes(dst, []byte(zed.DecodeNet(bytes).String())), nil
+	case *zed.TypeOfType:
+		return appendBytes(dst, []byte(zson.FormatTypeValue(bytes))), nil
+	case *zed.TypeRecord:
+		// A null top-level record is written with null fields.
+		it := bytes.Iter()
+		for _, f := range typ.Fields {
+			var b zcode.Bytes
+			if it != nil {
+				b = it.Next()
+			}
+			var err error
+			if dst, err = w.encodeNullable(dst, f.Type, b); err != nil {
+				return nil, err
+			}
+		}
+		return dst, nil
+	case *zed.TypeArray, *zed.TypeSet:
+		inner := zed.InnerType(typ)
+		var err error
+		if n := countElements(bytes); n > 0 {
+			dst = appendLong(dst, int64(n))
+			for it := bytes.Iter(); !it.Done(); {
+				if dst, err = w.encodeNullable(dst, inner, it.Next()); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return appendLong(dst, 0), nil
+	case *zed.TypeMap:
+		var err error
+		if n := countElements(bytes); n > 0 {
+			dst = appendLong(dst, int64(n/2))
+			for it := bytes.Iter(); !it.Done(); {
+				dst = appendBytes(dst, it.Next())
+				if dst, err = w.encodeNullable(dst, typ.ValType, it.Next()); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return appendLong(dst, 0), nil
+	case *zed.TypeEnum:
+		return appendLong(dst, int64(zed.DecodeUint(bytes))), nil
+	case *zed.TypeError:
+		return appendBytes(dst, []byte(zson.MustFormatValue(zed.NewValue(typ, bytes)))), nil
+	}
+	panic(fmt.Sprintf("unexpected Zed type: %s", zson.FormatType(typ)))
+}
+
+func countElements(bytes zcode.Bytes) int {
+	var n int
+	for it := bytes.Iter(); !it.Done(); it.Next() {
+		n++
+	}
+	return n
+}
+
+// encodeTwosComplement returns the shortest big-endian two's-complement
+// representation of x.
+func encodeTwosComplement(x *big.Int) []byte {
+	n := x.BitLen()/8 + 1
+	if x.Sign() < 0 {
+		n = new(big.Int).Not(x).BitLen()/8 + 1
+		x = new(big.Int).Add(x, new(big.Int).Lsh(big.NewInt(1), uint(n)*8))
+	}
+	return x.FillBytes(make([]byte, n))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func appendLong(dst []byte, v int64) []byte {
+	return binary.AppendVarint(dst, v)
+}
+
+func appendBytes(dst, b []byte) []byte {
+	return append(appendLong(dst, int64(len(b))), b...)
+}
@@ -0,0 +1,296 @@
+package cefio
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/nano"
+	"github.com/brimdata/zed/zcode"
+)
+
+// headerFields are the names of the fields holding the CEF header, which
+// precede the extension field.
+var headerFields = []string{
+	"cef_version",
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"signature_id",
+	"name",
+	"severity",
+}
+
+// extensionTypes gives the types of the extension keys in the CEF
+// dictionary whose values are not strings.  Values of these keys that fail
+// to parse are kept as strings.
+var extensionTypes = map[string]zed.Type{
+	"agt":                          zed.TypeIP,
+	"agentTranslatedAddress":       zed.TypeIP,
+	"c6a1":                         zed.TypeIP,
+	"c6a2":                         zed.TypeIP,
+	"c6a3":                         zed.TypeIP,
+	"c6a4":                         zed.TypeIP,
+	"destinationTranslatedAddress": zed.TypeIP,
+	"deviceTranslatedAddress":      zed.TypeIP,
+	"dst":                          zed.TypeIP,
+	"dvc":                          zed.TypeIP,
+	"sourceTranslatedAddress":      zed.TypeIP,
+	"src":                          zed.TypeIP,
+
+	"cn1":                       zed.TypeInt64,
+	"cn2":                       zed.TypeInt64,
+	"cn3":                       zed.TypeInt64,
+	"cnt":                       zed.TypeInt64,
+	"destinationTranslatedPort": zed.TypeInt64,
+	"dpid":                      zed.TypeInt64,
+	"dpt":                       zed.TypeInt64,
+	"dvcpid":                    zed.TypeInt64,
+	"fsize":                     zed.TypeInt64,
+	"in":                        zed.TypeInt64,
+	"oldFileSize":               zed.TypeInt64,
+	"out":                       zed.TypeInt64,
+	"sourceTranslatedPort":      zed.TypeInt64,
+	"spid":                      zed.TypeInt64,
+	"spt":                       zed.TypeInt64,
+	"type":                      zed.TypeInt64,
+
+	"cfp1":  zed.TypeFloat64,
+	"cfp2":  zed.TypeFloat64,
+	"cfp3":  zed.TypeFloat64,
+	"cfp4":  zed.TypeFloat64,
+	"dlat":  zed.TypeFloat64,
+	"dlong": zed.TypeFloat64,
+	"slat":  zed.TypeFloat64,
+	"slong": zed.TypeFloat64,
+
+	"art":                     zed.TypeTime,
+	"deviceCustomDate1":       zed.TypeTime,
+	"deviceCustomDate2":       zed.TypeTime,
+	"end":                     zed.TypeTime,
+	"fileCreateTime":          zed.TypeTime,
+	"fileModificationTime":    zed.TypeTime,
+	"oldFileCreateTime":       zed.TypeTime,
+	"oldFileModificationTime": zed.TypeTime,
+	"rt":                      zed.TypeTime,
+	"start":                   zed.TypeTime,
+}
+
+type parser struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	fields  []zed.Field
+	ext     []zed.Field
+	val     zed.Value
+}
+
+// parse turns a CEF event into a record with the fields in headerFields
+// followed by an extension field holding a record of the extension's
+// key-value pairs.
+func (p *parser) parse(line string) (*zed.Value, error) {
+	i := strings.Index(line, "CEF:")
+	if i < 0 {
+		return nil, errors.New("CEF header not found")
+	}
+	header, ext, err := splitHeader(line[i+len("CEF:"):])
+	if err != nil {
+		return nil, err
+	}
+	p.builder.Truncate()
+	p.fields = p.fields[:0]
+	version, err := strconv.ParseInt(header[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CEF version %q", header[0])
+	}
+	p.append(headerFields[0], zed.TypeInt64, zed.EncodeInt(version))
+	for k, s := range header[1:6] {
+		p.append(headerFields[k+1], zed.TypeString, zed.EncodeString(s))
+	}
+	// Severity is an integer from 0 to 10 or a string like "High".
+	if sev := header[6]; sev == "" {
+		p.append("severity", zed.TypeInt64, nil)
+	} else if n, err := strconv.ParseInt(sev, 10, 64); err == nil {
+		p.append("severity", zed.TypeInt64, zed.EncodeInt(n))
+	} else {
+		p.append("severity", zed.TypeString, zed.EncodeString(sev))
+	}
+	p.ext = p.ext[:0]
+	p.builder.BeginContainer()
+	pairs, err := splitExtension(ext)
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range pairs {
+		typ, b := extensionValue(pair[0], pair[1])
+		p.ext = append(p.ext, zed.NewField(pair[0], typ))
+		p.builder.Append(b)
+	}
+	p.builder.EndContainer()
+	extType, err := p.zctx.LookupTypeRecord(p.ext)
+	if err != nil {
+		return nil, fmt.Errorf("extension: %w", err)
+	}
+	p.fields = append(p.fields, zed.NewField("extension", extType))
+	typ, err := p.zctx.LookupTypeRecord(p.fields)
+	if err != nil {
+		return nil, err
+	}
+	p.val = *zed.NewValue(typ, p.builder.Bytes())
+	return &p.val, nil
+}
+
+func (p *parser) append(name string, typ zed.Type, b zcode.Bytes) {
+	p.fields = append(p.fields, zed.NewField(name, typ))
+	p.builder.Append(b)
+}
+
+// splitHeader splits the seven pipe-delimited header fields of a CEF event
+// from its extension and unescapes them.
+func splitHeader(s string) ([]string, string, error) {
+	var fields []string
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) && (s[i+1] == '|' || s[i+1] == '\\') {
+				i++
+				c = s[i]
+			}
+			b.WriteByte(c)
+		case '|':
+			fields = append(fields, b.String())
+			b.Reset()
+			if len(fields) == len(headerFields) {
+				return fields, s[i+1:], nil
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return nil, "", fmt.Errorf("CEF header has %d fields but should have %d", len(fields), len(headerFields))
+}
+
+// splitExtension splits a CEF extension into unescaped key-value pairs.  A
+// value extends to the space preceding the next key, so an unescaped equal
+// sign is taken to begin a value only if it follows a valid key preceded by
+// a space.
+func splitExtension(s string) ([][2]string, error) {
+	s = strings.TrimLeft(s, " ")
+	if s == "" {
+		return nil, nil
+	}
+	var pairs [][2]string
+	var valStart int
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '=':
+			start := strings.LastIndexByte(s[valStart:i], ' ') + 1 + valStart
+			if len(pairs) == 0 {
+				start = 0
+			} else if start == valStart {
+				// No space precedes the key, so the equal sign
+				// is part of the current value.
+				continue
+			}
+			key := s[start:i]
+			if !isKey(key) {
+				if len(pairs) == 0 {
+					return nil, fmt.Errorf("invalid extension key %q", key)
+				}
+				continue
+			}
+			if len(pairs) > 0 {
+				pairs[len(pairs)-1][1] = unescape(strings.TrimRight(s[valStart:start], " "))
+			}
+			pairs = append(pairs, [2]string{key})
+			valStart = i + 1
+		}
+	}
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("invalid extension %q", s)
+	}
+	pairs[len(pairs)-1][1] = unescape(strings.TrimRight(s[valStart:], " "))
+	return pairs, nil
+}
+
+func isKey(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.' || c == '-' || c == '[' || c == ']') {
+			return false
+		}
+	}
+	return true
+}
+
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) {
+			switch s[i+1] {
+			case '\\', '=':
+				c = s[i+1]
+				i++
+			case 'n':
+				c = '\n'
+				i++
+			case 'r':
+				c = '\r'
+				i++
+			}
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// extensionValue returns the type and encoding of the value of key in an
+// extension.
+func extensionValue(key, s string) (zed.Type, zcode.Bytes) {
+	switch extensionTypes[key] {
+	case zed.TypeIP:
+		if a, err := netip.ParseAddr(s); err == nil {
+			return zed.TypeIP, zed.EncodeIP(a)
+		}
+	case zed.TypeInt64:
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return zed.TypeInt64, zed.EncodeInt(n)
+		}
+	case zed.TypeFloat64:
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return zed.TypeFloat64, zed.EncodeFloat64(f)
+		}
+	case zed.TypeTime:
+		if ts, ok := parseTime(s); ok {
+			return zed.TypeTime, zed.EncodeTime(ts)
+		}
+	}
+	return zed.TypeString, zed.EncodeString(s)
+}
+
+// parseTime parses a CEF timestamp, which is either milliseconds since the
+// Unix epoch or has the form "Jan 02 2006 15:04:05" with optional
+// milliseconds and an optional UTC or GMT time zone.
+func parseTime(s string) (nano.Ts, bool) {
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return nano.Ts(ms * 1_000_000), true
+	}
+	s = strings.TrimSuffix(strings.TrimSuffix(s, " UTC"), " GMT")
+	t, err := time.Parse("Jan 2 2006 15:04:05", s)
+	if err != nil {
+		return 0, false
+	}
+	return nano.TimeToTs(t), true
+}
@@ -0,0 +1,33 @@
+package cefio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitExtension(t *testing.T) {
+	cases := []struct {
+		in  string
+		out [][2]string
+	}{
+		{"", nil},
+		{"a=1", [][2]string{{"a", "1"}}},
+		{"  a=1  b=2 ", [][2]string{{"a", "1"}, {"b", "2"}}},
+		{"msg=hello world b=", [][2]string{{"msg", "hello world"}, {"b", ""}}},
+		{`a=x\=y b=c\\ d=e`, [][2]string{{"a", "x=y"}, {"b", `c\`}, {"d", "e"}}},
+		{"url=http://x/?q=1&r=2 c=3", [][2]string{{"url", "http://x/?q=1&r=2"}, {"c", "3"}}},
+		{`a=1\n2\r\t`, [][2]string{{"a", "1\n2\r\\t"}}},
+		{"cs1=x=y", [][2]string{{"cs1", "x=y"}}},
+		{"a=x y=z=w", [][2]string{{"a", "x"}, {"y", "z=w"}}},
+	}
+	for _, c := range cases {
+		out, err := splitExtension(c.in)
+		require.NoError(t, err, c.in)
+		require.Equal(t, c.out, out, c.in)
+	}
+	_, err := splitExtension("a b=1")
+	require.EqualError(t, err, `invalid extension key "a b"`)
+	_, err = splitExtension("novalue")
+	require.EqualError(t, err, `invalid extension "novalue"`)
+}
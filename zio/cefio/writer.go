@@ -0,0 +1,117 @@
+package cefio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/zson"
+)
+
+// Writer is a zio.Writer for CEF events.  The header is taken from the
+// record fields named in headerFields, and the extension comprises the
+// fields of the extension record and any other fields, with nested
+// records flattened.  Times are written as milliseconds since the Unix epoch.
+type Writer struct {
+	writer    io.WriteCloser
+	flattener *expr.Flattener
+	buf       bytes.Buffer
+}
+
+func NewWriter(w io.WriteCloser) *Writer {
+	return &Writer{
+		writer:    w,
+		flattener: expr.NewFlattener(zed.NewContext()),
+	}
+}
+
+func (w *Writer) Close() error {
+	return w.writer.Close()
+}
+
+func (w *Writer) Write(rec *zed.Value) error {
+	if rec.Type.Kind() != zed.RecordKind {
+		return fmt.Errorf("CEF output encountered non-record value: %s", zson.MustFormatValue(rec))
+	}
+	rec, err := w.flattener.Flatten(rec)
+	if err != nil {
+		return err
+	}
+	w.buf.Reset()
+	w.buf.WriteString("CEF:")
+	for k, name := range headerFields {
+		s := formatValue(rec.Deref(name))
+		if k == 0 && s == "" {
+			s = "0"
+		}
+		w.buf.WriteString(escapeHeader(s))
+		w.buf.WriteByte('|')
+	}
+	var sep bool
+	fields := rec.Fields()
+	for i, it := 0, rec.Bytes.Iter(); i < len(fields) && !it.Done(); i++ {
+		zb := it.Next()
+		key := fields[i].Name
+		if zb == nil || isHeaderField(key) {
+			continue
+		}
+		key = strings.TrimPrefix(key, "extension.")
+		if !isKey(key) {
+			return fmt.Errorf("CEF output encountered invalid extension key %q", key)
+		}
+		if sep {
+			w.buf.WriteByte(' ')
+		}
+		sep = true
+		w.buf.WriteString(key)
+		w.buf.WriteByte('=')
+		w.buf.WriteString(escapeValue(formatValue(zed.NewValue(fields[i].Type, zb))))
+	}
+	w.buf.WriteByte('\n')
+	_, err = w.writer.Write(w.buf.Bytes())
+	return err
+}
+
+func isHeaderField(name string) bool {
+	for _, h := range headerFields {
+		if name == h {
+			return true
+		}
+	}
+	return false
+}
+
+// formatValue formats val without ZSON decoration, returning the empty
+// string for a missing or null value.
+func formatValue(val *zed.Value) string {
+	if val == nil || val.IsNull() {
+		return ""
+	}
+	val = val.Under()
+	switch id := val.Type.ID(); {
+	case id == zed.IDString:
+		return string(val.Bytes)
+	case id == zed.IDTime:
+		return strconv.FormatInt(int64(zed.DecodeTime(val.Bytes))/1_000_000, 10)
+	case id < zed.IDTypeComplex:
+		return zson.FormatPrimitive(val.Type, val.Bytes)
+	}
+	return zson.String(val)
+}
+
+var (
+	headerEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	valueEscaper  = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+func escapeHeader(s string) string {
+	return headerEscaper.Replace(s)
+}
+
+func escapeValue(s string) string {
+	return valueEscaper.Replace(s)
+}
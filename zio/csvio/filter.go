@@ -0,0 +1,65 @@
+package csvio
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// lineFilter is a reader that discards the first skip lines of its input
+// and any line beginning with comment at the start of a CSV record.  Skipped
+// lines are physical lines and need not be valid CSV.  A line that begins
+// inside a quoted field continues the previous record and is never treated as
+// a comment.
+type lineFilter struct {
+	reader  *bufio.Reader
+	skip    int
+	comment []byte
+	line    []byte
+	// partial is true if the last line read was truncated by the
+	// bufio.Reader's buffer size.
+	partial bool
+	// quoted is true if the lines passed through so far end inside a
+	// quoted field.  As in preprocess, every quote opens or closes a
+	// quoted field, and an escaped quote does both.
+	quoted bool
+	drop   bool
+	err    error
+}
+
+func newLineFilter(r io.Reader, skip int, comment string) *lineFilter {
+	return &lineFilter{
+		reader:  bufio.NewReader(r),
+		skip:    skip,
+		comment: []byte(comment),
+	}
+}
+
+func (l *lineFilter) Read(b []byte) (int, error) {
+	for len(l.line) == 0 {
+		if l.err != nil {
+			return 0, l.err
+		}
+		line, err := l.reader.ReadSlice('\n')
+		start := !l.partial
+		l.partial = err == bufio.ErrBufferFull
+		if !l.partial {
+			l.err = err
+		}
+		if start {
+			l.drop = l.skip > 0 || (!l.quoted && len(l.comment) > 0 && bytes.HasPrefix(line, l.comment))
+			if l.skip > 0 {
+				l.skip--
+			}
+		}
+		if !l.drop {
+			if bytes.Count(line, []byte{'"'})%2 != 0 {
+				l.quoted = !l.quoted
+			}
+			l.line = line
+		}
+	}
+	n := copy(b, l.line)
+	l.line = l.line[n:]
+	return n, nil
+}
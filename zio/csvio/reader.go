@@ -3,10 +3,13 @@ package csvio
 import (
 	"encoding/csv"
 	"errors"
+	"fmt"
 	"io"
 	"strconv"
+	"strings"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zcode"
 	"github.com/brimdata/zed/zson"
 	"golang.org/x/exp/slices"
 )
@@ -14,41 +17,110 @@ import (
 type Reader struct {
 	reader    *csv.Reader
 	marshaler *zson.MarshalZNGContext
+	zctx      *zed.Context
 	strings   bool
 	valid     bool
+	err       error
 	hdr       []string
 	vals      []interface{}
+	typ       zed.Type
+	fields    []zed.Field
+	builder   zcode.Builder
 }
 
 type ReaderOpts struct {
 	Delim rune
+	// Comment, if not empty, causes lines beginning with it to be ignored
+	// where they would start a record.  A line continuing a quoted field is
+	// never a comment.
+	Comment string
+	// Header, if not empty, lists the field names separated by Delim and
+	// means the input has no header line.
+	Header string
+	// Skip is the number of lines discarded at the start of the input.
+	// These are physical lines, discarded before CSV parsing, so a quoted
+	// field spanning lines is not treated specially.
+	Skip int
+	// StringsOnly disables type inference so that every field is a string.
+	StringsOnly bool
+	// Type, if not empty, is a ZSON record type with primitive fields
+	// giving the name and type of each CSV field.  As with Header, the
+	// input has no header line.
+	Type string
 }
 
-// XXX This is a placeholder for an option that will allow one to convert
-// all csv fields to strings and defer any type coercion presumably to
-// Zed shapers.  Currently, this causes an import cycle because the csvio
-// Writer depends on fuse.  We should refactor this so whatever logic wants
-// to tack on a fuse operator happens outside of zio.  See issue #2315
-//type ReaderOpts struct {
-//	StringsOnly bool
-//}
-
 func NewReader(zctx *zed.Context, r io.Reader, opts ReaderOpts) *Reader {
-	preprocess := newPreprocess(r)
-	reader := csv.NewReader(preprocess)
-	if opts.Delim != 0 {
-		reader.Comma = opts.Delim
+	if opts.Skip > 0 || opts.Comment != "" {
+		r = newLineFilter(r, opts.Skip, opts.Comment)
 	}
+	reader := newCSVReader(r, opts.Delim)
 	reader.ReuseRecord = true
-	reader.TrimLeadingSpace = true
-	return &Reader{
+	rr := &Reader{
 		reader:    reader,
 		marshaler: zson.NewZNGMarshalerWithContext(zctx),
-		//strings:   opts.StringsOnly,
+		zctx:      zctx,
+		strings:   opts.StringsOnly,
+	}
+	rr.err = rr.initOpts(opts)
+	return rr
+}
+
+func (r *Reader) initOpts(opts ReaderOpts) error {
+	if opts.Type != "" {
+		if opts.Header != "" || opts.StringsOnly {
+			return errors.New("CSV type cannot be combined with a header or strings-only option")
+		}
+		typ, err := zson.ParseType(r.zctx, opts.Type)
+		if err != nil {
+			return fmt.Errorf("CSV type: %w", err)
+		}
+		recType := zed.TypeRecordOf(typ)
+		if recType == nil {
+			return fmt.Errorf("CSV type must be a record type: %s", zson.FormatType(typ))
+		}
+		for _, f := range recType.Fields {
+			if id := zed.TypeUnder(f.Type).ID(); id == zed.IDType || id >= zed.IDTypeComplex {
+				return fmt.Errorf("CSV type field %q is not a primitive type: %s", f.Name, zson.FormatType(f.Type))
+			}
+			r.hdr = append(r.hdr, f.Name)
+		}
+		r.typ = typ
+		r.fields = recType.Fields
+		return nil
+	}
+	if opts.Header != "" {
+		hdr, err := SplitLine(opts.Header, opts.Delim)
+		if err != nil {
+			return fmt.Errorf("CSV header: %w", err)
+		}
+		return r.init(hdr)
+	}
+	return nil
+}
+
+func newCSVReader(r io.Reader, delim rune) *csv.Reader {
+	reader := csv.NewReader(newPreprocess(r))
+	if delim != 0 {
+		reader.Comma = delim
+	}
+	reader.TrimLeadingSpace = true
+	return reader
+}
+
+// SplitLine splits a line of CSV text into fields as Reader does.  If delim
+// is zero, fields are separated by commas.  An empty line has no fields.
+func SplitLine(line string, delim rune) ([]string, error) {
+	fields, err := newCSVReader(strings.NewReader(line), delim).Read()
+	if err == io.EOF {
+		err = nil
 	}
+	return fields, err
 }
 
 func (r *Reader) Read() (*zed.Value, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
 	for {
 		csvRec, err := r.reader.Read()
 		if err != nil {
@@ -62,7 +134,9 @@ func (r *Reader) Read() (*zed.Value, error) {
 			return nil, err
 		}
 		if r.hdr == nil {
-			r.init(csvRec)
+			if err := r.init(csvRec); err != nil {
+				return nil, err
+			}
 			continue
 		}
 		rec, err := r.translate(csvRec)
@@ -74,12 +148,29 @@ func (r *Reader) Read() (*zed.Value, error) {
 	}
 }
 
-func (r *Reader) init(hdr []string) {
+func (r *Reader) init(hdr []string) error {
 	r.hdr = slices.Clone(hdr)
-	r.vals = make([]interface{}, len(hdr))
+	if !r.strings {
+		r.vals = make([]interface{}, len(hdr))
+		return nil
+	}
+	fields := make([]zed.Field, 0, len(hdr))
+	for _, name := range hdr {
+		fields = append(fields, zed.NewField(name, zed.TypeString))
+	}
+	typ, err := r.zctx.LookupTypeRecord(fields)
+	if err != nil {
+		return err
+	}
+	r.typ = typ
+	r.fields = typ.Fields
+	return nil
 }
 
 func (r *Reader) translate(fields []string) (*zed.Value, error) {
+	if r.typ != nil {
+		return r.build(fields)
+	}
 	if len(fields) != len(r.vals) {
 		// This error shouldn't happen as it should be caught by the
 		// csv package but we check anyway.
@@ -87,16 +178,36 @@ func (r *Reader) translate(fields []string) (*zed.Value, error) {
 	}
 	vals := r.vals[:0]
 	for _, field := range fields {
-		if r.strings {
-			vals = append(vals, field)
-		} else {
-			vals = append(vals, convertString(field))
-		}
+		vals = append(vals, ConvertString(field))
 	}
 	return r.marshaler.MarshalCustom(r.hdr, vals)
 }
 
-func convertString(s string) interface{} {
+// build returns a value of type r.typ with each field parsed as its ZSON
+// primitive type.  Empty fields are null.
+func (r *Reader) build(fields []string) (*zed.Value, error) {
+	if len(fields) != len(r.fields) {
+		return nil, errors.New("length of record doesn't match heading")
+	}
+	b := &r.builder
+	b.Reset()
+	for k, field := range fields {
+		if field == "" {
+			b.Append(nil)
+			continue
+		}
+		p := zson.Primitive{Type: r.fields[k].Type, Text: field}
+		if err := zson.BuildPrimitive(b, p); err != nil {
+			return nil, fmt.Errorf("field %q: %w", r.fields[k].Name, err)
+		}
+	}
+	return zed.NewValue(r.typ, b.Bytes()), nil
+}
+
+// ConvertString returns the value of a CSV field with its type inferred
+// from s: nil if s is empty, a float64 if s is a number, a bool if s is a
+// Boolean, and s itself otherwise.
+func ConvertString(s string) interface{} {
 	if s == "" {
 		return nil
 	}
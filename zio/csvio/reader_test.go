@@ -1,6 +1,7 @@
 package csvio
 
 import (
+	"io"
 	"strings"
 	"testing"
 
@@ -16,3 +17,11 @@ func TestNewReaderUsesContextParameter(t *testing.T) {
 	require.NoError(t, err)
 	require.Exactly(t, rec.Type, typ)
 }
+
+func TestLineFilterLongLines(t *testing.T) {
+	long := strings.Repeat("x", 10000)
+	input := "#" + long + "\n" + long + "\nskip\nkeep\n#comment\n" + long
+	b, err := io.ReadAll(newLineFilter(strings.NewReader(input), 3, "#"))
+	require.NoError(t, err)
+	require.Equal(t, "keep\n"+long, string(b))
+}
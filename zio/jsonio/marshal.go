@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/bigfloat"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/zcode"
 	"github.com/brimdata/zed/zson"
 )
@@ -22,6 +24,10 @@ func marshalAny(typ zed.Type, bytes zcode.Bytes) interface{} {
 		return zed.DecodeUint(bytes)
 	case *zed.TypeOfInt8, *zed.TypeOfInt16, *zed.TypeOfInt32, *zed.TypeOfInt64:
 		return zed.DecodeInt(bytes)
+	case *zed.TypeOfUint128, *zed.TypeOfUint256:
+		return json.Number(zed.DecodeBigUint(bytes).String())
+	case *zed.TypeOfInt128, *zed.TypeOfInt256:
+		return json.Number(zed.DecodeBigInt(bytes).String())
 	case *zed.TypeOfDuration:
 		return zed.DecodeDuration(bytes).String()
 	case *zed.TypeOfTime:
@@ -32,6 +38,20 @@ func marshalAny(typ zed.Type, bytes zcode.Bytes) interface{} {
 		return zed.DecodeFloat32(bytes)
 	case *zed.TypeOfFloat64:
 		return zed.DecodeFloat64(bytes)
+	case *zed.TypeOfFloat128, *zed.TypeOfFloat256:
+		// As with decimals, a number keeps every digit.
+		f := zed.DecodeBigFloat(bytes)
+		if f == nil || f.IsInf() {
+			return bigfloat.String(f)
+		}
+		return json.Number(f.Text('g', -1))
+	case *zed.TypeOfDecimal32, *zed.TypeOfDecimal64, *zed.TypeOfDecimal128, *zed.TypeOfDecimal256:
+		// A number keeps every digit of a finite decimal.
+		d := zed.DecodeDecimal(bytes)
+		if d.Form != decimal.Finite {
+			return d.String()
+		}
+		return json.Number(d.String())
 	case *zed.TypeOfBool:
 		return zed.DecodeBool(bytes)
 	case *zed.TypeOfBytes:
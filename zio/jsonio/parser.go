@@ -0,0 +1,54 @@
+package jsonio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/jsonlexer"
+)
+
+// Parser converts JSON text held in memory to Zed values using the same
+// mapping of JSON to Zed types as Reader.
+type Parser struct {
+	reader *Reader
+	src    bytes.Reader
+}
+
+func NewParser(zctx *zed.Context) *Parser {
+	p := &Parser{}
+	p.reader = &Reader{
+		builder: builder{zctx: zctx},
+		lexer:   jsonlexer.New(&p.src),
+		buf:     make([]byte, 0, 64),
+	}
+	return p
+}
+
+// Parse returns the Zed value of the JSON value in b, which may be
+// surrounded by whitespace but must not be followed by another value.
+// The returned value is valid until the next call to Parse.
+func (p *Parser) Parse(b []byte) (*zed.Value, error) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil, errors.New("empty JSON input")
+	}
+	p.src.Reset(b)
+	p.reader.lexer.Reset(&p.src)
+	val, err := p.reader.Read()
+	if err == nil && val == nil || err == io.EOF {
+		// Read returns a nil value and error if the input ends in
+		// the first token.
+		err = errors.New("unexpected end of JSON input")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if t := p.reader.lexer.Token(); t != jsonlexer.TokenErr || p.reader.lexer.Err() != io.EOF {
+		if t == jsonlexer.TokenErr {
+			return nil, p.reader.lexer.Err()
+		}
+		return nil, p.reader.error(t, "after top-level value")
+	}
+	return val, nil
+}
@@ -0,0 +1,209 @@
+package leefio
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/nano"
+	"github.com/brimdata/zed/zcode"
+)
+
+// headerFields are the names of the fields holding the LEEF header, which
+// precede the attributes field.  The delimiter given in a LEEF 2.0 header
+// is not kept.
+var headerFields = []string{
+	"leef_version",
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"event_id",
+}
+
+// attributeTypes gives the types of the predefined LEEF attributes whose
+// values are not strings.  Values of these attributes that fail to parse
+// are kept as strings.
+var attributeTypes = map[string]zed.Type{
+	"dst":        zed.TypeIP,
+	"dstPostNAT": zed.TypeIP,
+	"dstPreNAT":  zed.TypeIP,
+	"identSrc":   zed.TypeIP,
+	"src":        zed.TypeIP,
+	"srcPostNAT": zed.TypeIP,
+	"srcPreNAT":  zed.TypeIP,
+
+	"dstBytes":       zed.TypeInt64,
+	"dstPackets":     zed.TypeInt64,
+	"dstPort":        zed.TypeInt64,
+	"dstPostNATPort": zed.TypeInt64,
+	"dstPreNATPort":  zed.TypeInt64,
+	"sev":            zed.TypeInt64,
+	"srcBytes":       zed.TypeInt64,
+	"srcPackets":     zed.TypeInt64,
+	"srcPort":        zed.TypeInt64,
+	"srcPostNATPort": zed.TypeInt64,
+	"srcPreNATPort":  zed.TypeInt64,
+	"totalBytes":     zed.TypeInt64,
+
+	"devTime": zed.TypeTime,
+}
+
+type parser struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	fields  []zed.Field
+	attrs   []zed.Field
+	val     zed.Value
+}
+
+// parse turns a LEEF event into a record with the fields in headerFields
+// followed by an attributes field holding a record of the event's
+// attributes.
+func (p *parser) parse(line string) (*zed.Value, error) {
+	i := strings.Index(line, "LEEF:")
+	if i < 0 {
+		return nil, errors.New("LEEF header not found")
+	}
+	header, attrs, err := splitHeader(line[i+len("LEEF:"):])
+	if err != nil {
+		return nil, err
+	}
+	delim := "\t"
+	switch header[0] {
+	case "1.0":
+	case "2.0":
+		// LEEF 2.0 adds the attribute delimiter to the header.
+		var d []string
+		if d, attrs, err = splitHeaderFields(attrs, 1); err != nil {
+			return nil, err
+		}
+		if delim, err = parseDelimiter(d[0]); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported LEEF version %q", header[0])
+	}
+	p.builder.Truncate()
+	p.fields = p.fields[:0]
+	for k, s := range header {
+		p.fields = append(p.fields, zed.NewField(headerFields[k], zed.TypeString))
+		p.builder.Append(zed.EncodeString(s))
+	}
+	p.attrs = p.attrs[:0]
+	p.builder.BeginContainer()
+	for _, attr := range strings.Split(attrs, delim) {
+		if attr == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(attr, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid attribute %q", attr)
+		}
+		typ, b := attributeValue(key, value)
+		p.attrs = append(p.attrs, zed.NewField(key, typ))
+		p.builder.Append(b)
+	}
+	p.builder.EndContainer()
+	attrsType, err := p.zctx.LookupTypeRecord(p.attrs)
+	if err != nil {
+		return nil, fmt.Errorf("attributes: %w", err)
+	}
+	p.fields = append(p.fields, zed.NewField("attributes", attrsType))
+	typ, err := p.zctx.LookupTypeRecord(p.fields)
+	if err != nil {
+		return nil, err
+	}
+	p.val = *zed.NewValue(typ, p.builder.Bytes())
+	return &p.val, nil
+}
+
+func splitHeader(s string) ([]string, string, error) {
+	fields, rest, err := splitHeaderFields(s, len(headerFields))
+	if err != nil {
+		return nil, "", fmt.Errorf("LEEF header has %d fields but should have at least %d", len(fields), len(headerFields))
+	}
+	return fields, rest, nil
+}
+
+// splitHeaderFields splits n pipe-delimited header fields from s and
+// unescapes them.
+func splitHeaderFields(s string, n int) ([]string, string, error) {
+	var fields []string
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) && (s[i+1] == '|' || s[i+1] == '\\') {
+				i++
+				c = s[i]
+			}
+			b.WriteByte(c)
+		case '|':
+			fields = append(fields, b.String())
+			b.Reset()
+			if len(fields) == n {
+				return fields, s[i+1:], nil
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return fields, "", errors.New("LEEF header is incomplete")
+}
+
+// parseDelimiter parses the delimiter in a LEEF 2.0 header, which is a
+// single character or a hexadecimal character code like "x09" or "0x09".
+// An empty delimiter means tab.
+func parseDelimiter(s string) (string, error) {
+	switch {
+	case s == "":
+		return "\t", nil
+	case len(s) == 1:
+		return s, nil
+	case strings.HasPrefix(s, "x") || strings.HasPrefix(s, "0x"):
+		hex := strings.TrimPrefix(strings.TrimPrefix(s, "0"), "x")
+		if n, err := strconv.ParseUint(hex, 16, 8); err == nil && n != 0 {
+			return string(rune(n)), nil
+		}
+	}
+	return "", fmt.Errorf("invalid LEEF delimiter %q", s)
+}
+
+// attributeValue returns the type and encoding of the value of the
+// attribute key.
+func attributeValue(key, s string) (zed.Type, zcode.Bytes) {
+	switch attributeTypes[key] {
+	case zed.TypeIP:
+		if a, err := netip.ParseAddr(s); err == nil {
+			return zed.TypeIP, zed.EncodeIP(a)
+		}
+	case zed.TypeInt64:
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return zed.TypeInt64, zed.EncodeInt(n)
+		}
+	case zed.TypeTime:
+		if ts, ok := parseTime(s); ok {
+			return zed.TypeTime, zed.EncodeTime(ts)
+		}
+	}
+	return zed.TypeString, zed.EncodeString(s)
+}
+
+// parseTime parses a devTime value, which is either milliseconds since the
+// Unix epoch or has the default LEEF form "Jan 02 2006 15:04:05" with
+// optional milliseconds and an optional UTC or GMT time zone.
+func parseTime(s string) (nano.Ts, bool) {
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return nano.Ts(ms * 1_000_000), true
+	}
+	s = strings.TrimSuffix(strings.TrimSuffix(s, " UTC"), " GMT")
+	t, err := time.Parse("Jan 2 2006 15:04:05", s)
+	if err != nil {
+		return 0, false
+	}
+	return nano.TimeToTs(t), true
+}
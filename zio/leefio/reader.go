@@ -0,0 +1,47 @@
+package leefio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/skim"
+)
+
+const (
+	ReadSize    = 64 * 1024
+	MaxLineSize = 50 * 1024 * 1024
+)
+
+// Reader is a zio.Reader for IBM QRadar Log Event Extended Format (LEEF)
+// events, one per line, in version 1.0 or 2.0.  Any text preceding the
+// "LEEF:" header, such as a syslog header, is ignored.
+type Reader struct {
+	scanner *skim.Scanner
+	parser  *parser
+}
+
+func NewReader(zctx *zed.Context, reader io.Reader) *Reader {
+	buffer := make([]byte, ReadSize)
+	return &Reader{
+		scanner: skim.NewScanner(reader, buffer, MaxLineSize),
+		parser:  &parser{zctx: zctx},
+	}
+}
+
+func (r *Reader) Read() (*zed.Value, error) {
+	line, err := r.scanner.ScanLine()
+	if line == nil {
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+		}
+		return nil, nil
+	}
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	val, err := r.parser.parse(string(line))
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+	}
+	return val, nil
+}
@@ -0,0 +1,121 @@
+package leefio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/zson"
+)
+
+// Writer is a zio.Writer for LEEF events.  The header is taken from the
+// record fields named in headerFields, and the attributes comprise the
+// fields of the attributes record and any other fields, with nested records
+// flattened.  Events are written in LEEF 2.0 with a tab delimiter unless
+// leef_version is "1.0".  Times are written as milliseconds since the Unix
+// epoch.
+type Writer struct {
+	writer    io.WriteCloser
+	flattener *expr.Flattener
+	buf       bytes.Buffer
+}
+
+func NewWriter(w io.WriteCloser) *Writer {
+	return &Writer{
+		writer:    w,
+		flattener: expr.NewFlattener(zed.NewContext()),
+	}
+}
+
+func (w *Writer) Close() error {
+	return w.writer.Close()
+}
+
+func (w *Writer) Write(rec *zed.Value) error {
+	if rec.Type.Kind() != zed.RecordKind {
+		return fmt.Errorf("LEEF output encountered non-record value: %s", zson.MustFormatValue(rec))
+	}
+	rec, err := w.flattener.Flatten(rec)
+	if err != nil {
+		return err
+	}
+	w.buf.Reset()
+	w.buf.WriteString("LEEF:")
+	version := "2.0"
+	if formatValue(rec.Deref(headerFields[0])) == "1.0" {
+		version = "1.0"
+	}
+	w.buf.WriteString(version)
+	for _, name := range headerFields[1:] {
+		w.buf.WriteByte('|')
+		w.buf.WriteString(escapeHeader(formatValue(rec.Deref(name))))
+	}
+	w.buf.WriteByte('|')
+	if version == "2.0" {
+		w.buf.WriteString("x09|")
+	}
+	var sep bool
+	fields := rec.Fields()
+	for i, it := 0, rec.Bytes.Iter(); i < len(fields) && !it.Done(); i++ {
+		zb := it.Next()
+		key := fields[i].Name
+		if zb == nil || isHeaderField(key) {
+			continue
+		}
+		key = strings.TrimPrefix(key, "attributes.")
+		if key == "" || strings.ContainsAny(key, "=\t\n\r") {
+			return fmt.Errorf("LEEF output encountered invalid attribute key %q", key)
+		}
+		value := formatValue(zed.NewValue(fields[i].Type, zb))
+		if strings.ContainsAny(value, "\t\n\r") {
+			return fmt.Errorf("LEEF output encountered attribute %q with tab or newline in value", key)
+		}
+		if sep {
+			w.buf.WriteByte('\t')
+		}
+		sep = true
+		w.buf.WriteString(key)
+		w.buf.WriteByte('=')
+		w.buf.WriteString(value)
+	}
+	w.buf.WriteByte('\n')
+	_, err = w.writer.Write(w.buf.Bytes())
+	return err
+}
+
+func isHeaderField(name string) bool {
+	for _, h := range headerFields {
+		if name == h {
+			return true
+		}
+	}
+	return false
+}
+
+// formatValue formats val without ZSON decoration, returning the empty
+// string for a missing or null value.
+func formatValue(val *zed.Value) string {
+	if val == nil || val.IsNull() {
+		return ""
+	}
+	val = val.Under()
+	switch id := val.Type.ID(); {
+	case id == zed.IDString:
+		return string(val.Bytes)
+	case id == zed.IDTime:
+		return strconv.FormatInt(int64(zed.DecodeTime(val.Bytes))/1_000_000, 10)
+	case id < zed.IDTypeComplex:
+		return zson.FormatPrimitive(val.Type, val.Bytes)
+	}
+	return zson.String(val)
+}
+
+var headerEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+
+func escapeHeader(s string) string {
+	return headerEscaper.Replace(s)
+}
@@ -13,6 +13,18 @@ type builder struct {
 }
 
 func (b *builder) appendValue(typ zed.Type, v interface{}) {
+	if v != nil && zed.IsDecimal(typ.ID()) {
+		_, scale := decimalParams(typ)
+		d := decodeDecimal(v, scale)
+		if _, ok := typ.(*zed.TypeNamed); !ok {
+			// The scale is the default for typ rather than that of
+			// the value written, so drop the zeros it added.
+			d = d.TrimFraction()
+		}
+		b.buf = zed.AppendDecimal(b.buf[:0], typ.ID(), d)
+		b.Append(b.buf)
+		return
+	}
 	switch v := v.(type) {
 	case nil:
 		b.Append(nil)
@@ -14,6 +14,10 @@ func newData(typ zed.Type, zb zcode.Bytes) (interface{}, error) {
 	if zb == nil {
 		return nil, nil
 	}
+	if zed.IsDecimal(typ.ID()) {
+		precision, scale := decimalParams(typ)
+		return newDecimalData(precision, scale, zb)
+	}
 	switch typ := zed.TypeUnder(typ).(type) {
 	case *zed.TypeOfUint8, *zed.TypeOfUint16, *zed.TypeOfUint32:
 		return int32(zed.DecodeUint(zb)), nil
@@ -29,7 +33,6 @@ func newData(typ zed.Type, zb zcode.Bytes) (interface{}, error) {
 		return zed.DecodeFloat32(zb), nil
 	case *zed.TypeOfFloat64:
 		return zed.DecodeFloat64(zb), nil
-	// XXX add TypeDecimal
 	case *zed.TypeOfBool:
 		return zed.DecodeBool(zb), nil
 	case *zed.TypeOfBytes, *zed.TypeOfString:
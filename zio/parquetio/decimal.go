@@ -0,0 +1,111 @@
+package parquetio
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/decimal"
+	"github.com/fraugster/parquet-go/parquet"
+)
+
+// A Parquet DECIMAL with precision p and scale s is read as the narrowest Zed
+// decimal type with a precision of at least p.  That type is named
+// decimal_<p>_<s> unless p and s are the defaults for it, which are its
+// precision and half that.
+
+const decimalPrefix = "decimal_"
+
+func newDecimalType(zctx *zed.Context, precision, scale int) (zed.Type, error) {
+	typ := zed.DecimalTypeOfPrecision(precision)
+	if typ == nil {
+		return nil, fmt.Errorf("DECIMAL(%d, %d) type is unsupported", precision, scale)
+	}
+	if p, s := decimalParams(typ); p == precision && s == scale {
+		return typ, nil
+	}
+	return zctx.LookupTypeNamed(fmt.Sprintf("%s%d_%d", decimalPrefix, precision, scale), typ)
+}
+
+// decimalParams returns the precision and scale of the Parquet DECIMAL for
+// the Zed decimal type typ.
+func decimalParams(typ zed.Type) (int, int) {
+	if named, ok := typ.(*zed.TypeNamed); ok && strings.HasPrefix(named.Name, decimalPrefix) {
+		p, s, _ := strings.Cut(strings.TrimPrefix(named.Name, decimalPrefix), "_")
+		precision, err1 := strconv.Atoi(p)
+		scale, err2 := strconv.Atoi(s)
+		if err1 == nil && err2 == nil {
+			return precision, scale
+		}
+	}
+	precision := zed.DecimalFormat(zed.TypeUnder(typ).ID()).Precision()
+	return precision, precision / 2
+}
+
+// decimalPhysicalType returns the physical type used to write a DECIMAL of
+// the given precision along with its length if it is FIXED_LEN_BYTE_ARRAY.
+func decimalPhysicalType(precision int) (parquet.Type, int) {
+	switch {
+	case precision <= 9:
+		return parquet.Type_INT32, 0
+	case precision <= 18:
+		return parquet.Type_INT64, 0
+	}
+	// The length is the fewest bytes holding 10^precision-1 in two's
+	// complement.
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	return parquet.Type_FIXED_LEN_BYTE_ARRAY, max.BitLen()/8 + 1
+}
+
+// newDecimalData returns the unscaled value of the decimal in zb as the
+// physical type for a DECIMAL of the given precision and scale.  It returns
+// an error instead of rounding if the decimal has more digits after the
+// decimal point than scale.
+func newDecimalData(precision, scale int, zb []byte) (interface{}, error) {
+	d, ok := zed.DecodeDecimal(zb).Rescale(-scale)
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	if !ok || d.Coef.Cmp(max) >= 0 {
+		return nil, fmt.Errorf("%w %s for DECIMAL(%d, %d)",
+			ErrDecimalValue, zed.DecodeDecimal(zb), precision, scale)
+	}
+	unscaled := d.BigInt()
+	switch physical, length := decimalPhysicalType(precision); physical {
+	case parquet.Type_INT32:
+		return int32(unscaled.Int64()), nil
+	case parquet.Type_INT64:
+		return unscaled.Int64(), nil
+	default:
+		return twosComplement(unscaled, length), nil
+	}
+}
+
+// twosComplement returns the big-endian two's complement representation of
+// x in n bytes.
+func twosComplement(x *big.Int, n int) []byte {
+	if x.Sign() < 0 {
+		x = new(big.Int).Add(x, new(big.Int).Lsh(big.NewInt(1), uint(8*n)))
+	}
+	return x.FillBytes(make([]byte, n))
+}
+
+// decodeDecimal returns the decimal whose unscaled value is v, which is an
+// int32, int64, or big-endian two's complement []byte.
+func decodeDecimal(v interface{}, scale int) *decimal.Decimal {
+	var unscaled big.Int
+	switch v := v.(type) {
+	case int32:
+		unscaled.SetInt64(int64(v))
+	case int64:
+		unscaled.SetInt64(v)
+	case []byte:
+		unscaled.SetBytes(v)
+		if len(v) > 0 && v[0]&0x80 != 0 {
+			unscaled.Sub(&unscaled, new(big.Int).Lsh(big.NewInt(1), uint(8*len(v))))
+		}
+	default:
+		panic(fmt.Sprintf("unknown DECIMAL physical type %T", v))
+	}
+	return decimal.NewFromBigInt(&unscaled, -scale)
+}
@@ -1,27 +1,72 @@
 package parquetio
 
 import (
-	"errors"
+	"context"
 	"io"
+	"os"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/pkg/storage"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zio"
 	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquet"
 )
 
+// Magic is the first four bytes of a Parquet file.
+const Magic = "PAR1"
+
+// Reader is a zio.Reader for Parquet files.  Since Parquet metadata is at
+// the end of a file, a Reader needs random access to its input.  When used
+// as a zbuf.ScannerAble with a zbuf.Pushdown, a Reader decodes only the
+// columns in the pushdown's projection and skips row groups ruled out by
+// the pushdown's statistics pruner.
 type Reader struct {
-	fr  *goparquet.FileReader
-	typ *zed.TypeRecord
+	zctx *zed.Context
+	fr   *goparquet.FileReader
+	meta *parquet.FileMetaData
+	typ  *zed.TypeRecord
+	file *os.File
+
+	group     int
+	remaining int64
+	pruner    expr.Evaluator
+	ectx      expr.Context
 
 	builder builder
 	val     zed.Value
 }
 
+var _ zbuf.ScannerAble = (*Reader)(nil)
+
+// NewReader returns a Reader for r.  If r is neither an io.ReadSeeker nor a
+// storage.Reader of known size, NewReader copies r to a temporary file,
+// which is removed by Close.
 func NewReader(zctx *zed.Context, r io.Reader) (*Reader, error) {
-	rs, ok := r.(io.ReadSeeker)
-	if !ok {
-		return nil, errors.New("reader cannot seek")
+	rs, file, err := seekable(r)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := newReader(zctx, rs)
+	if err != nil {
+		if file != nil {
+			file.Close()
+			os.Remove(file.Name())
+		}
+		return nil, err
+	}
+	reader.file = file
+	return reader, nil
+}
+
+func newReader(zctx *zed.Context, rs io.ReadSeeker) (*Reader, error) {
+	meta, err := goparquet.ReadFileMetaData(rs, true)
+	if err != nil {
+		return nil, err
 	}
-	fr, err := goparquet.NewFileReader(rs)
+	fr, err := goparquet.NewFileReaderWithOptions(rs, goparquet.WithFileMetaData(meta))
 	if err != nil {
 		return nil, err
 	}
@@ -30,19 +75,118 @@ func NewReader(zctx *zed.Context, r io.Reader) (*Reader, error) {
 		return nil, err
 	}
 	return &Reader{
-		fr:  fr,
-		typ: typ,
+		zctx: zctx,
+		fr:   fr,
+		meta: meta,
+		typ:  typ,
 	}, nil
 }
 
-func (r *Reader) Read() (*zed.Value, error) {
-	data, err := r.fr.NextRow()
+// seekable returns an io.ReadSeeker for r.  If it must copy r to a temporary
+// file to do so, it also returns the file.
+func seekable(r io.Reader) (io.ReadSeeker, *os.File, error) {
+	if rs, ok := r.(io.ReadSeeker); ok {
+		return rs, nil, nil
+	}
+	if sr, ok := r.(storage.Reader); ok {
+		if s, err := storage.NewSeeker(sr); err == nil {
+			return s, nil, nil
+		}
+	}
+	f, err := os.CreateTemp("", "zed-parquet-")
 	if err != nil {
-		if err == io.EOF {
+		return nil, nil, err
+	}
+	if _, err = io.Copy(f, r); err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, nil, err
+	}
+	return f, f, nil
+}
+
+func (r *Reader) Close() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	if err2 := os.Remove(r.file.Name()); err == nil {
+		err = err2
+	}
+	r.file = nil
+	return err
+}
+
+func (r *Reader) NewScanner(ctx context.Context, filter zbuf.Filter) (zbuf.Scanner, error) {
+	if pushdown, ok := filter.(zbuf.Pushdown); ok {
+		if err := r.project(pushdown.Projection()); err != nil {
+			return nil, err
+		}
+		pruner, err := pushdown.AsStatsPruner()
+		if err != nil {
+			return nil, err
+		}
+		r.pruner = pruner
+		r.ectx = expr.NewContext()
+	}
+	// Hide r's NewScanner method so zbuf.NewScanner uses Read.
+	return zbuf.NewScanner(ctx, struct{ zio.Reader }{r}, filter)
+}
+
+// project limits the columns read to those holding the top-level fields in
+// fields.  If fields is nil, every column is read.
+func (r *Reader) project(fields field.List) error {
+	if fields == nil {
+		return nil
+	}
+	var cols []string
+	var projected []zed.Field
+	for _, f := range r.typ.Fields {
+		for _, path := range fields {
+			if len(path) > 0 && path[0] == f.Name {
+				cols = append(cols, f.Name)
+				projected = append(projected, f)
+				break
+			}
+		}
+	}
+	if len(cols) == 0 || len(cols) == len(r.typ.Fields) {
+		// Selecting no columns would select every column.
+		return nil
+	}
+	typ, err := r.zctx.LookupTypeRecord(projected)
+	if err != nil {
+		return err
+	}
+	r.fr.SetSelectedColumns(cols...)
+	r.typ = typ
+	return nil
+}
+
+func (r *Reader) Read() (*zed.Value, error) {
+	for r.remaining == 0 {
+		if r.group == len(r.meta.RowGroups) {
 			return nil, nil
 		}
+		rg := r.meta.RowGroups[r.group]
+		r.group++
+		if rg.NumRows == 0 || r.prune(rg) {
+			continue
+		}
+		// SeekToRowGroup numbers row groups from one.
+		if err := r.fr.SeekToRowGroup(r.group); err != nil {
+			return nil, err
+		}
+		r.remaining = rg.NumRows
+	}
+	data, err := r.fr.NextRow()
+	if err != nil {
 		return nil, err
 	}
+	r.remaining--
 	r.builder.Truncate()
 	for _, f := range r.typ.Fields {
 		r.builder.appendValue(f.Type, data[f.Name])
@@ -50,3 +194,16 @@ func (r *Reader) Read() (*zed.Value, error) {
 	r.val = *zed.NewValue(r.typ, r.builder.Bytes())
 	return &r.val, nil
 }
+
+// prune returns true if r.pruner rules out every row in rg.
+func (r *Reader) prune(rg *parquet.RowGroup) bool {
+	if r.pruner == nil {
+		return false
+	}
+	val := r.stats(rg)
+	if val == nil {
+		return false
+	}
+	result := r.pruner.Eval(r.ectx, val)
+	return result.Type == zed.TypeBool && zed.IsTrue(result.Bytes)
+}
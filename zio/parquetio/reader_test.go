@@ -0,0 +1,127 @@
+package parquetio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/field"
+	"github.com/brimdata/zed/runtime/expr"
+	"github.com/brimdata/zed/zbuf"
+	"github.com/brimdata/zed/zio"
+	"github.com/brimdata/zed/zson"
+	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquetschema"
+	"github.com/stretchr/testify/require"
+)
+
+// testFile returns a Parquet file with three row groups, each holding three
+// rows of {a:int64,s:string,b:float64}, where a is 1 through 9.
+func testFile(t *testing.T) []byte {
+	sd, err := parquetschema.ParseSchemaDefinition(`message m {
+		required int64 a;
+		required binary s (STRING);
+		required double b;
+	}`)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	fw := goparquet.NewFileWriter(&buf, goparquet.WithSchemaDefinition(sd))
+	for a := int64(1); a <= 9; a++ {
+		data := map[string]interface{}{"a": a, "s": []byte{byte('a' + a - 1)}, "b": float64(a) / 2}
+		require.NoError(t, fw.AddData(data))
+		if a%3 == 0 {
+			require.NoError(t, fw.FlushRowGroup())
+		}
+	}
+	require.NoError(t, fw.Close())
+	return buf.Bytes()
+}
+
+func readAll(t *testing.T, r zio.Reader) string {
+	var b bytes.Buffer
+	for {
+		val, err := r.Read()
+		require.NoError(t, err)
+		if val == nil {
+			return b.String()
+		}
+		b.WriteString(zson.String(val))
+		b.WriteByte('\n')
+	}
+}
+
+type testPushdown struct {
+	projection field.List
+	pruner     expr.Evaluator
+}
+
+func (*testPushdown) AsEvaluator() (expr.Evaluator, error)        { return nil, nil }
+func (*testPushdown) AsBufferFilter() (*expr.BufferFilter, error) { return nil, nil }
+func (p *testPushdown) Projection() field.List                    { return p.projection }
+func (p *testPushdown) AsStatsPruner() (expr.Evaluator, error)    { return p.pruner, nil }
+
+// maxBelow prunes a block when the maximum value of the field is less
+// than the value.
+type maxBelow struct {
+	field string
+	value int64
+}
+
+func (m *maxBelow) Eval(_ expr.Context, val *zed.Value) *zed.Value {
+	max := val.Deref("max").Deref(m.field)
+	if max != nil && zed.DecodeInt(max.Bytes) < m.value {
+		return zed.True
+	}
+	return zed.False
+}
+
+func TestReaderNonSeekable(t *testing.T) {
+	r, err := NewReader(zed.NewContext(), struct{ io.Reader }{bytes.NewReader(testFile(t))})
+	require.NoError(t, err)
+	require.NotNil(t, r.file)
+	name := r.file.Name()
+	const expected = `{a:1,s:"a",b:0.5}
+{a:2,s:"b",b:1.}
+{a:3,s:"c",b:1.5}
+{a:4,s:"d",b:2.}
+{a:5,s:"e",b:2.5}
+{a:6,s:"f",b:3.}
+{a:7,s:"g",b:3.5}
+{a:8,s:"h",b:4.}
+{a:9,s:"i",b:4.5}
+`
+	require.Equal(t, expected, readAll(t, r))
+	require.NoError(t, r.Close())
+	_, err = os.Stat(name)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestReaderPushdown(t *testing.T) {
+	r, err := NewReader(zed.NewContext(), bytes.NewReader(testFile(t)))
+	require.NoError(t, err)
+	pushdown := &testPushdown{
+		projection: field.DottedList("b,a.x"),
+		pruner:     &maxBelow{"a", 5},
+	}
+	s, err := r.NewScanner(context.Background(), pushdown)
+	require.NoError(t, err)
+	const expected = `{a:4,b:2.}
+{a:5,b:2.5}
+{a:6,b:3.}
+{a:7,b:3.5}
+{a:8,b:4.}
+{a:9,b:4.5}
+`
+	require.Equal(t, expected, readAll(t, zbuf.PullerReader(s)))
+}
+
+func TestStats(t *testing.T) {
+	r, err := NewReader(zed.NewContext(), bytes.NewReader(testFile(t)))
+	require.NoError(t, err)
+	// The writer records empty statistics for s, so it's left out.
+	val := r.stats(r.meta.RowGroups[1])
+	require.Equal(t, `{min:{a:4,b:2.},max:{a:6,b:3.}}`, zson.String(val))
+}
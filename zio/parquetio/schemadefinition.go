@@ -14,6 +14,8 @@ var (
 	ErrEmptyRecordType = errors.New("empty record type unsupported")
 	ErrNullType        = errors.New("null type unimplemented")
 	ErrUnionType       = errors.New("union type unsupported")
+	ErrDecimalValue    = errors.New("unrepresentable decimal value")
+	ErrUnsupportedType = errors.New("unsupported type")
 )
 
 var (
@@ -42,6 +44,7 @@ var (
 	convertedJSON            = parquet.ConvertedTypePtr(parquet.ConvertedType_JSON)
 	convertedBSON            = parquet.ConvertedTypePtr(parquet.ConvertedType_BSON)
 	convertedInterval        = parquet.ConvertedTypePtr(parquet.ConvertedType_INTERVAL)
+	convertedDecimal         = parquet.ConvertedTypePtr(parquet.ConvertedType_DECIMAL)
 
 	logicalString          = &parquet.LogicalType{STRING: &parquet.StringType{}}
 	logicalMap             = &parquet.LogicalType{MAP: &parquet.MapType{}}
@@ -91,6 +94,8 @@ func newColumnDefinition(name string, typ zed.Type) (*parquetschema.ColumnDefini
 	switch typ := typ.(type) {
 	case *zed.TypeNamed:
 		switch id := typ.Type.ID(); {
+		case zed.IsDecimal(id):
+			return newDecimalColumnDefinition(name, typ)
 		case typ.Name == "date" && id == zed.IDInt32:
 			return newPrimitiveColumnDefinition(name, parquet.Type_INT32, convertedDate, logicalDate)
 		case typ.Name == "bson" && id == zed.IDBytes:
@@ -145,7 +150,11 @@ func newColumnDefinition(name string, typ zed.Type) (*parquetschema.ColumnDefini
 		return newPrimitiveColumnDefinition(name, parquet.Type_FLOAT, nil, nil)
 	case *zed.TypeOfFloat64:
 		return newPrimitiveColumnDefinition(name, parquet.Type_DOUBLE, nil, nil)
-	// XXX add TypeDecimal
+	case *zed.TypeOfDecimal32, *zed.TypeOfDecimal64, *zed.TypeOfDecimal128, *zed.TypeOfDecimal256:
+		return newDecimalColumnDefinition(name, typ)
+	case *zed.TypeOfUint128, *zed.TypeOfUint256, *zed.TypeOfInt128, *zed.TypeOfInt256,
+		*zed.TypeOfFloat128, *zed.TypeOfFloat256:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, zed.PrimitiveName(typ))
 	case *zed.TypeOfBool:
 		return newPrimitiveColumnDefinition(name, parquet.Type_BOOLEAN, nil, nil)
 	case *zed.TypeOfBytes:
@@ -186,6 +195,23 @@ func newPrimitiveColumnDefinition(name string, t parquet.Type, c *parquet.Conver
 	}, nil
 }
 
+func newDecimalColumnDefinition(name string, typ zed.Type) (*parquetschema.ColumnDefinition, error) {
+	precision, scale := decimalParams(typ)
+	physical, length := decimalPhysicalType(precision)
+	d := &parquet.DecimalType{Precision: int32(precision), Scale: int32(scale)}
+	c, err := newPrimitiveColumnDefinition(name, physical, convertedDecimal, &parquet.LogicalType{DECIMAL: d})
+	if err != nil {
+		return nil, err
+	}
+	c.SchemaElement.Precision = &d.Precision
+	c.SchemaElement.Scale = &d.Scale
+	if physical == parquet.Type_FIXED_LEN_BYTE_ARRAY {
+		typeLength := int32(length)
+		c.SchemaElement.TypeLength = &typeLength
+	}
+	return c, nil
+}
+
 func newListColumnDefinition(name string, typ zed.Type) (*parquetschema.ColumnDefinition, error) {
 	element, err := newColumnDefinition("element", typ)
 	if err != nil {
@@ -0,0 +1,90 @@
+package parquetio
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/brimdata/zed"
+	"github.com/fraugster/parquet-go/parquet"
+)
+
+// stats returns a value of the form {min:{...},max:{...}} holding the
+// minimum and maximum values of the top-level columns in rg that have
+// statistics Zed can decode, or nil if there are no such columns.
+func (r *Reader) stats(rg *parquet.RowGroup) *zed.Value {
+	var fields []zed.Field
+	var mins, maxes []interface{}
+	for _, f := range r.typ.Fields {
+		md := columnMetaData(rg, f.Name)
+		if md == nil || md.Statistics == nil {
+			continue
+		}
+		min := decodeStat(f.Type, md.Type, md.Statistics.MinValue)
+		max := decodeStat(f.Type, md.Type, md.Statistics.MaxValue)
+		if min == nil || max == nil {
+			continue
+		}
+		fields = append(fields, f)
+		mins = append(mins, min)
+		maxes = append(maxes, max)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	colsType, err := r.zctx.LookupTypeRecord(fields)
+	if err != nil {
+		return nil
+	}
+	typ, err := r.zctx.LookupTypeRecord([]zed.Field{
+		zed.NewField("min", colsType),
+		zed.NewField("max", colsType),
+	})
+	if err != nil {
+		return nil
+	}
+	r.builder.Truncate()
+	for _, vals := range [][]interface{}{mins, maxes} {
+		r.builder.BeginContainer()
+		for k, v := range vals {
+			r.builder.appendValue(fields[k].Type, v)
+		}
+		r.builder.EndContainer()
+	}
+	return zed.NewValue(typ, r.builder.Bytes())
+}
+
+func columnMetaData(rg *parquet.RowGroup, name string) *parquet.ColumnMetaData {
+	for _, c := range rg.Columns {
+		if md := c.MetaData; md != nil && len(md.PathInSchema) == 1 && md.PathInSchema[0] == name {
+			return md
+		}
+	}
+	return nil
+}
+
+// decodeStat decodes a statistic in the plain encoding of physical type
+// ptyp for a column of type typ.  It returns nil unless typ is a signed
+// integer, float, string, or bytes type, which are the types whose statistics
+// can be decoded without regard to the column's sort order.  (Unsigned
+// integers are stored in signed physical types but ordered as unsigned, so
+// values at or above 2^31 or 2^63 would make the decoded range wrong.)  It
+// also returns nil for an empty string or bytes statistic since some writers,
+// including the one used by parquetio.Writer, record empty statistics for
+// those types.
+func decodeStat(typ zed.Type, ptyp parquet.Type, b []byte) interface{} {
+	id := typ.ID()
+	signed := zed.IsInteger(id) && zed.IsSigned(id)
+	switch {
+	case signed && ptyp == parquet.Type_INT32 && len(b) == 4:
+		return int32(binary.LittleEndian.Uint32(b))
+	case signed && ptyp == parquet.Type_INT64 && len(b) == 8:
+		return int64(binary.LittleEndian.Uint64(b))
+	case id == zed.IDFloat32 && ptyp == parquet.Type_FLOAT && len(b) == 4:
+		return math.Float32frombits(binary.LittleEndian.Uint32(b))
+	case id == zed.IDFloat64 && ptyp == parquet.Type_DOUBLE && len(b) == 8:
+		return math.Float64frombits(binary.LittleEndian.Uint64(b))
+	case (id == zed.IDString || id == zed.IDBytes) && ptyp == parquet.Type_BYTE_ARRAY && len(b) > 0:
+		return b
+	}
+	return nil
+}
@@ -1,7 +1,6 @@
 package parquetio
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/brimdata/zed"
@@ -55,9 +54,11 @@ func newType(zctx *zed.Context, cd *parquetschema.ColumnDefinition) (zed.Type, e
 }
 
 func newPrimitiveType(zctx *zed.Context, s *parquet.SchemaElement) (zed.Type, error) {
-	if s.IsSetLogicalType() && s.LogicalType.IsSetDECIMAL() ||
-		s.GetConvertedType() == parquet.ConvertedType_DECIMAL {
-		return nil, errors.New("DECIMAL type is unimplemented")
+	if s.IsSetLogicalType() && s.LogicalType.IsSetDECIMAL() {
+		return newDecimalType(zctx, int(s.LogicalType.DECIMAL.Precision), int(s.LogicalType.DECIMAL.Scale))
+	}
+	if s.GetConvertedType() == parquet.ConvertedType_DECIMAL {
+		return newDecimalType(zctx, int(s.GetPrecision()), int(s.GetScale()))
 	}
 	switch *s.Type {
 	case parquet.Type_BOOLEAN:
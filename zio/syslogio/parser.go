@@ -0,0 +1,377 @@
+package syslogio
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/nano"
+	"github.com/brimdata/zed/zcode"
+)
+
+var facilities = [...]string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news",
+	"uucp", "cron", "authpriv", "ftp", "ntp", "security", "console", "solaris-cron",
+	"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+var severities = [...]string{
+	"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug",
+}
+
+// parser turns a syslog message into a Zed record.  RFC 5424 messages
+// become records with fields priority, facility, severity, version, ts,
+// hostname, app_name, procid, msgid, structured_data, and message.  RFC 3164
+// messages, which may lack a priority, become records with fields priority,
+// facility, severity, ts, hostname, app_name, procid, and message.
+type parser struct {
+	zctx *zed.Context
+	// now returns the current time and is used to infer the year of
+	// RFC 3164 timestamps, which have none.
+	now     func() time.Time
+	builder zcode.Builder
+	fields  []zed.Field
+	val     zed.Value
+}
+
+func newParser(zctx *zed.Context, now func() time.Time) *parser {
+	return &parser{zctx: zctx, now: now}
+}
+
+func (p *parser) parse(line []byte) (*zed.Value, error) {
+	p.builder.Truncate()
+	p.fields = p.fields[:0]
+	s := string(line)
+	pri, rest, err := parsePriority(s)
+	if err != nil {
+		return nil, err
+	}
+	p.appendPriority(pri)
+	if version, msg, ok := cutVersion(rest); ok && pri >= 0 {
+		err = p.parseRFC5424(version, msg)
+	} else {
+		err = p.parseRFC3164(rest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	typ, err := p.zctx.LookupTypeRecord(p.fields)
+	if err != nil {
+		return nil, err
+	}
+	p.val = *zed.NewValue(typ, p.builder.Bytes())
+	return &p.val, nil
+}
+
+// parsePriority parses the PRI part of a message.  If there is none, it
+// returns -1.
+func parsePriority(s string) (int, string, error) {
+	if !strings.HasPrefix(s, "<") {
+		return -1, s, nil
+	}
+	end := strings.IndexByte(s, '>')
+	if end < 2 || end > 4 {
+		return 0, "", errors.New("invalid priority")
+	}
+	pri, err := strconv.Atoi(s[1:end])
+	if err != nil || pri > 191 || (s[1] == '0' && end > 2) {
+		return 0, "", fmt.Errorf("invalid priority %q", s[1:end])
+	}
+	return pri, s[end+1:], nil
+}
+
+// cutVersion returns the RFC 5424 version at the start of s, which is a
+// nonzero number of at most three digits followed by a space.
+func cutVersion(s string) (int, string, bool) {
+	before, after, ok := strings.Cut(s, " ")
+	if !ok || len(before) == 0 || len(before) > 3 || before[0] == '0' {
+		return 0, "", false
+	}
+	version, err := strconv.Atoi(before)
+	if err != nil {
+		return 0, "", false
+	}
+	return version, after, true
+}
+
+func (p *parser) appendPriority(pri int) {
+	if pri < 0 {
+		p.appendNull("priority", zed.TypeUint8)
+		p.appendNull("facility", zed.TypeString)
+		p.appendNull("severity", zed.TypeString)
+		return
+	}
+	p.append("priority", zed.TypeUint8, zed.EncodeUint(uint64(pri)))
+	p.appendString("facility", facilities[pri/8])
+	p.appendString("severity", severities[pri%8])
+}
+
+func (p *parser) parseRFC5424(version int, s string) error {
+	p.append("version", zed.TypeUint8, zed.EncodeUint(uint64(version)))
+	ts, s := cutField(s)
+	if ts == "-" {
+		p.appendNull("ts", zed.TypeTime)
+	} else {
+		t, err := time.Parse(time.RFC3339Nano, ts)
+		if err != nil {
+			return fmt.Errorf("invalid timestamp %q", ts)
+		}
+		p.append("ts", zed.TypeTime, zed.EncodeTime(nano.TimeToTs(t)))
+	}
+	hostname, s := cutField(s)
+	p.appendHostname(nilValue(hostname))
+	for _, name := range []string{"app_name", "procid", "msgid"} {
+		var f string
+		f, s = cutField(s)
+		if f == "" {
+			return fmt.Errorf("missing %s", name)
+		}
+		if f == "-" {
+			p.appendNull(name, zed.TypeString)
+		} else {
+			p.appendString(name, f)
+		}
+	}
+	s, err := p.parseStructuredData(s)
+	if err != nil {
+		return err
+	}
+	if s == "" {
+		p.appendNull("message", zed.TypeString)
+		return nil
+	}
+	if s[0] != ' ' {
+		return errors.New("invalid structured data")
+	}
+	p.appendString("message", strings.TrimPrefix(s[1:], "\ufeff"))
+	return nil
+}
+
+// parseStructuredData parses the STRUCTURED-DATA part of an RFC 5424
+// message into a record whose fields are records of string parameters
+// keyed by SD-ID.
+func (p *parser) parseStructuredData(s string) (string, error) {
+	if strings.HasPrefix(s, "-") {
+		p.appendNull("structured_data", zed.TypeNull)
+		return s[1:], nil
+	}
+	if !strings.HasPrefix(s, "[") {
+		return "", errors.New("invalid structured data")
+	}
+	var elems []zed.Field
+	p.builder.BeginContainer()
+	for strings.HasPrefix(s, "[") {
+		end := strings.IndexAny(s, " ]")
+		if end < 2 {
+			return "", errors.New("invalid structured data")
+		}
+		id := s[1:end]
+		s = s[end:]
+		var params []zed.Field
+		p.builder.BeginContainer()
+		for strings.HasPrefix(s, " ") {
+			eq := strings.IndexByte(s, '=')
+			if eq < 2 || len(s) < eq+2 || s[eq+1] != '"' {
+				return "", fmt.Errorf("invalid structured data parameter in %q", id)
+			}
+			name := s[1:eq]
+			value, rest, ok := cutParamValue(s[eq+2:])
+			if !ok {
+				return "", fmt.Errorf("invalid structured data parameter in %q", id)
+			}
+			params = append(params, zed.NewField(name, zed.TypeString))
+			p.builder.Append(zed.EncodeString(value))
+			s = rest
+		}
+		if !strings.HasPrefix(s, "]") {
+			return "", fmt.Errorf("invalid structured data element %q", id)
+		}
+		s = s[1:]
+		p.builder.EndContainer()
+		typ, err := p.zctx.LookupTypeRecord(params)
+		if err != nil {
+			return "", fmt.Errorf("structured data element %q: %w", id, err)
+		}
+		elems = append(elems, zed.NewField(id, typ))
+	}
+	p.builder.EndContainer()
+	typ, err := p.zctx.LookupTypeRecord(elems)
+	if err != nil {
+		return "", fmt.Errorf("structured data: %w", err)
+	}
+	p.fields = append(p.fields, zed.NewField("structured_data", typ))
+	return s, nil
+}
+
+// cutParamValue returns the unescaped parameter value at the start of s,
+// which follows an opening quote, and the remainder of s following the
+// closing quote.
+func cutParamValue(s string) (string, string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			return b.String(), s[i+1:], true
+		case '\\':
+			if i+1 < len(s) {
+				if next := s[i+1]; next == '"' || next == '\\' || next == ']' {
+					b.WriteByte(next)
+					i++
+					continue
+				}
+			}
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", false
+}
+
+func (p *parser) parseRFC3164(s string) error {
+	ts, s, err := p.cutRFC3164Timestamp(s)
+	if err != nil {
+		return err
+	}
+	p.append("ts", zed.TypeTime, zed.EncodeTime(ts))
+	hostname, rest := cutField(s)
+	if hostname == "" || strings.HasSuffix(hostname, ":") || strings.Contains(hostname, "[") {
+		// The hostname is absent.
+		hostname, rest = "", s
+	}
+	p.appendHostname(hostname)
+	app, procid, msg, ok := cutTag(rest)
+	if !ok {
+		p.appendNull("app_name", zed.TypeString)
+		p.appendNull("procid", zed.TypeString)
+		p.appendString("message", rest)
+		return nil
+	}
+	p.appendString("app_name", app)
+	if procid == "" {
+		p.appendNull("procid", zed.TypeString)
+	} else {
+		p.appendString("procid", procid)
+	}
+	p.appendString("message", msg)
+	return nil
+}
+
+// cutRFC3164Timestamp parses a timestamp of the form "Jan _2 15:04:05",
+// "Jan _2 2006 15:04:05", or RFC 3339.  Timestamps without a time zone are
+// in UTC.  If the year is absent, it is taken to be the year of p.now
+// unless that would put the timestamp more than a month in the future, in
+// which case it is the preceding year.
+func (p *parser) cutRFC3164Timestamp(s string) (nano.Ts, string, error) {
+	first, rest := cutField(s)
+	if len(first) > 3 && first[0] >= '0' && first[0] <= '9' {
+		t, err := time.Parse(time.RFC3339Nano, first)
+		if err != nil {
+			return 0, "", fmt.Errorf("invalid timestamp %q", first)
+		}
+		return nano.TimeToTs(t), rest, nil
+	}
+	// Days before the tenth are padded with a space.
+	day, rest := cutField(strings.TrimPrefix(rest, " "))
+	clock, rest := cutField(rest)
+	var year string
+	if len(clock) == 4 {
+		year = clock
+		clock, rest = cutField(rest)
+	}
+	layout, ts := "Jan 2 15:04:05", first+" "+day+" "+clock
+	if year != "" {
+		layout, ts = "Jan 2 2006 15:04:05", first+" "+day+" "+year+" "+clock
+	}
+	t, err := time.Parse(layout, ts)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid timestamp %q", strings.TrimSpace(ts))
+	}
+	if year == "" {
+		t = inferYear(t, p.now().UTC())
+	}
+	return nano.TimeToTs(t), rest, nil
+}
+
+// inferYear returns t, which was parsed without a year, in the latest year
+// that doesn't put it more than a month after now.  A timestamp on February
+// 29 is put in the latest such leap year.
+func inferYear(t, now time.Time) time.Time {
+	date := func(year int) time.Time {
+		return time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	}
+	year := now.Year()
+	if date(year).After(now.AddDate(0, 1, 0)) {
+		year--
+	}
+	// time.Date normalizes February 29 to March 1 in a common year.
+	for date(year).Day() != t.Day() {
+		year--
+	}
+	return date(year)
+}
+
+// cutTag parses an RFC 3164 tag of the form "app[procid]: " or "app: " at the
+// start of s.
+func cutTag(s string) (string, string, string, bool) {
+	end := strings.IndexAny(s, "[: ")
+	if end < 1 || s[end] == ' ' {
+		return "", "", "", false
+	}
+	app, rest := s[:end], s[end:]
+	var procid string
+	if rest[0] == '[' {
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			return "", "", "", false
+		}
+		procid, rest = rest[1:end], rest[end+1:]
+	}
+	if !strings.HasPrefix(rest, ":") {
+		return "", "", "", false
+	}
+	return app, procid, strings.TrimPrefix(rest[1:], " "), true
+}
+
+// cutField returns the text of s up to its first space and the remainder of
+// s following the space.
+func cutField(s string) (string, string) {
+	before, after, _ := strings.Cut(s, " ")
+	return before, after
+}
+
+func nilValue(s string) string {
+	if s == "-" {
+		return ""
+	}
+	return s
+}
+
+// appendHostname appends hostname as an ip if it is an IP address and as a
+// string otherwise.
+func (p *parser) appendHostname(hostname string) {
+	if hostname == "" {
+		p.appendNull("hostname", zed.TypeString)
+	} else if addr, err := netip.ParseAddr(hostname); err == nil {
+		p.append("hostname", zed.TypeIP, zed.EncodeIP(addr))
+	} else {
+		p.appendString("hostname", hostname)
+	}
+}
+
+func (p *parser) append(name string, typ zed.Type, b zcode.Bytes) {
+	p.fields = append(p.fields, zed.NewField(name, typ))
+	p.builder.Append(b)
+}
+
+func (p *parser) appendNull(name string, typ zed.Type) {
+	p.append(name, typ, nil)
+}
+
+func (p *parser) appendString(name, s string) {
+	p.append(name, zed.TypeString, zed.EncodeString(s))
+}
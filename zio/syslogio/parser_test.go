@@ -0,0 +1,30 @@
+package syslogio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/zson"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRFC3164YearInference(t *testing.T) {
+	now := func() time.Time { return time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC) }
+	p := newParser(zed.NewContext(), now)
+	cases := []struct {
+		line string
+		ts   string
+	}{
+		{"Jan 14 10:00:00 host app: x", "2023-01-14T10:00:00Z"},
+		{"Feb  3 10:00:00 host app: x", "2023-02-03T10:00:00Z"},
+		{"Dec 31 23:59:59 host app: x", "2022-12-31T23:59:59Z"},
+		{"Jan 14 10:00:00.25 host app: x", "2023-01-14T10:00:00.25Z"},
+		{"Feb 29 10:00:00 host app: x", "2020-02-29T10:00:00Z"},
+	}
+	for _, c := range cases {
+		val, err := p.parse([]byte(c.line))
+		require.NoError(t, err, c.line)
+		require.Equal(t, c.ts, zson.String(val.Deref("ts")), c.line)
+	}
+}
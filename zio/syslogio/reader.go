@@ -0,0 +1,47 @@
+package syslogio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/skim"
+)
+
+const (
+	ReadSize    = 64 * 1024
+	MaxLineSize = 50 * 1024 * 1024
+)
+
+// Reader is a zio.Reader for syslog messages, one per line, in either the
+// RFC 5424 or the RFC 3164 (BSD) format.
+type Reader struct {
+	scanner *skim.Scanner
+	parser  *parser
+}
+
+func NewReader(zctx *zed.Context, reader io.Reader) *Reader {
+	buffer := make([]byte, ReadSize)
+	return &Reader{
+		scanner: skim.NewScanner(reader, buffer, MaxLineSize),
+		parser:  newParser(zctx, time.Now),
+	}
+}
+
+func (r *Reader) Read() (*zed.Value, error) {
+	line, err := r.scanner.ScanLine()
+	if line == nil {
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+		}
+		return nil, nil
+	}
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	val, err := r.parser.parse(line)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+	}
+	return val, nil
+}
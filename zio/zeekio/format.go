@@ -10,6 +10,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/bigfloat"
 	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/zcode"
 	"github.com/brimdata/zed/zson"
@@ -38,10 +39,22 @@ func formatAny(val *zed.Value, inContainer bool) string {
 		return strconv.FormatFloat(float64(zed.DecodeFloat32(val.Bytes)), 'f', -1, 32)
 	case *zed.TypeOfFloat64:
 		return strconv.FormatFloat(zed.DecodeFloat64(val.Bytes), 'f', -1, 64)
+	case *zed.TypeOfFloat128, *zed.TypeOfFloat256:
+		f := zed.DecodeBigFloat(val.Bytes)
+		if f == nil || f.IsInf() {
+			return bigfloat.String(f)
+		}
+		return f.Text('f', -1)
+	case *zed.TypeOfDecimal32, *zed.TypeOfDecimal64, *zed.TypeOfDecimal128, *zed.TypeOfDecimal256:
+		return zed.DecodeDecimal(val.Bytes).String()
 	case *zed.TypeOfInt8, *zed.TypeOfInt16, *zed.TypeOfInt32, *zed.TypeOfInt64:
 		return strconv.FormatInt(zed.DecodeInt(val.Bytes), 10)
 	case *zed.TypeOfUint8, *zed.TypeOfUint16, *zed.TypeOfUint32, *zed.TypeOfUint64:
 		return strconv.FormatUint(zed.DecodeUint(val.Bytes), 10)
+	case *zed.TypeOfInt128, *zed.TypeOfInt256:
+		return zed.DecodeBigInt(val.Bytes).String()
+	case *zed.TypeOfUint128, *zed.TypeOfUint256:
+		return zed.DecodeBigUint(val.Bytes).String()
 	case *zed.TypeOfIP:
 		return zed.DecodeIP(val.Bytes).String()
 	case *zed.TypeMap:
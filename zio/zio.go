@@ -30,6 +30,12 @@ func Extension(format string) string {
 		return ".vng"
 	case "parquet":
 		return ".parquet"
+	case "avro":
+		return ".avro"
+	case "cef":
+		return ".cef"
+	case "leef":
+		return ".leef"
 	default:
 		return ""
 	}
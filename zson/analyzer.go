@@ -270,7 +270,12 @@ func castType(typ, cast zed.Type) (zed.Type, error) {
 	typID, castID := typ.ID(), cast.ID()
 	if typID == castID || typID == zed.IDNull ||
 		zed.IsInteger(typID) && zed.IsInteger(castID) ||
-		zed.IsFloat(typID) && zed.IsFloat(castID) {
+		zed.IsFloat(typID) && zed.IsFloat(castID) ||
+		// An integer literal too large for int64 or uint64 is
+		// parsed as a float64 but may be decorated with a wide
+		// integer type.
+		zed.IsFloat(typID) && zed.IsBigInt(castID) ||
+		zed.IsDecimal(castID) && (zed.IsInteger(typID) || zed.IsFloat(typID)) {
 		return cast, nil
 	}
 	return nil, fmt.Errorf("type mismatch: %q cannot be used as %q", FormatType(typ), FormatType(cast))
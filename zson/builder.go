@@ -4,12 +4,14 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/netip"
 	"strconv"
 	"time"
 	"unicode/utf8"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/decimal"
 	"github.com/brimdata/zed/pkg/nano"
 	"github.com/brimdata/zed/zcode"
 	"golang.org/x/text/unicode/norm"
@@ -52,7 +54,7 @@ func buildValue(b *zcode.Builder, val Value) error {
 }
 
 func BuildPrimitive(b *zcode.Builder, val Primitive) error {
-	switch zed.TypeUnder(val.Type).(type) {
+	switch typ := zed.TypeUnder(val.Type).(type) {
 	case *zed.TypeOfUint8, *zed.TypeOfUint16, *zed.TypeOfUint32, *zed.TypeOfUint64:
 		v, err := strconv.ParseUint(val.Text, 10, 64)
 		if err != nil {
@@ -67,6 +69,20 @@ func BuildPrimitive(b *zcode.Builder, val Primitive) error {
 		}
 		b.Append(zed.EncodeInt(v))
 		return nil
+	case *zed.TypeOfUint128, *zed.TypeOfUint256:
+		v, ok := new(big.Int).SetString(val.Text, 10)
+		if !ok || !zed.IntInRange(typ.ID(), v) {
+			return fmt.Errorf("invalid unsigned integer: %s", val.Text)
+		}
+		b.Append(zed.EncodeBigUint(v))
+		return nil
+	case *zed.TypeOfInt128, *zed.TypeOfInt256:
+		v, ok := new(big.Int).SetString(val.Text, 10)
+		if !ok || !zed.IntInRange(typ.ID(), v) {
+			return fmt.Errorf("invalid integer: %s", val.Text)
+		}
+		b.Append(zed.EncodeBigInt(v))
+		return nil
 	case *zed.TypeOfDuration:
 		d, err := nano.ParseDuration(val.Text)
 		if err != nil {
@@ -106,6 +122,20 @@ func BuildPrimitive(b *zcode.Builder, val Primitive) error {
 		}
 		b.Append(zed.EncodeFloat64(v))
 		return nil
+	case *zed.TypeOfFloat128, *zed.TypeOfFloat256:
+		v, err := zed.BigFloatFormat(typ.ID()).Parse(val.Text)
+		if err != nil {
+			return fmt.Errorf("invalid floating point: %s", val.Text)
+		}
+		b.Append(zed.EncodeBigFloat(typ.ID(), v))
+		return nil
+	case *zed.TypeOfDecimal32, *zed.TypeOfDecimal64, *zed.TypeOfDecimal128, *zed.TypeOfDecimal256:
+		v, err := decimal.Parse(val.Text)
+		if err != nil {
+			return fmt.Errorf("invalid decimal: %s", val.Text)
+		}
+		b.Append(zed.EncodeDecimal(typ.ID(), v))
+		return nil
 	case *zed.TypeOfBool:
 		var v bool
 		if val.Text == "true" {
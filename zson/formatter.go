@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/brimdata/zed"
+	"github.com/brimdata/zed/pkg/bigfloat"
 	"github.com/brimdata/zed/pkg/terminal/color"
 	"github.com/brimdata/zed/zcode"
 )
@@ -837,6 +838,10 @@ func formatPrimitive(b *strings.Builder, typ zed.Type, bytes zcode.Bytes) {
 		b.WriteString(strconv.FormatUint(zed.DecodeUint(bytes), 10))
 	case *zed.TypeOfInt8, *zed.TypeOfInt16, *zed.TypeOfInt32, *zed.TypeOfInt64:
 		b.WriteString(strconv.FormatInt(zed.DecodeInt(bytes), 10))
+	case *zed.TypeOfUint128, *zed.TypeOfUint256:
+		b.WriteString(zed.DecodeBigUint(bytes).String())
+	case *zed.TypeOfInt128, *zed.TypeOfInt256:
+		b.WriteString(zed.DecodeBigInt(bytes).String())
 	case *zed.TypeOfDuration:
 		b.WriteString(zed.DecodeDuration(bytes).String())
 	case *zed.TypeOfTime:
@@ -862,6 +867,10 @@ func formatPrimitive(b *strings.Builder, typ zed.Type, bytes zcode.Bytes) {
 		} else {
 			b.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
 		}
+	case *zed.TypeOfFloat128, *zed.TypeOfFloat256:
+		b.WriteString(bigfloat.String(zed.DecodeBigFloat(bytes)))
+	case *zed.TypeOfDecimal32, *zed.TypeOfDecimal64, *zed.TypeOfDecimal128, *zed.TypeOfDecimal256:
+		b.WriteString(zed.DecodeDecimal(bytes).String())
 	case *zed.TypeOfBool:
 		if zed.IsTrue(bytes) {
 			b.WriteString("true")
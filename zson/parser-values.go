@@ -192,7 +192,9 @@ func (p *Parser) matchPrimitive() (*astzed.Primitive, error) {
 		typ = "int64"
 	} else if _, err := strconv.ParseUint(s, 10, 64); err == nil {
 		typ = "uint64"
-	} else if _, err := strconv.ParseFloat(s, 64); err == nil {
+	} else if _, err := strconv.ParseFloat(s, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+		// A value out of range for float64 may be decorated with
+		// float128 or float256.
 		typ = "float64"
 	} else if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
 		typ = "time"
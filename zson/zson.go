@@ -7,6 +7,7 @@
 package zson
 
 import (
+	"io"
 	"strings"
 
 	"github.com/brimdata/zed"
@@ -62,7 +63,10 @@ func SelfDescribing(typ zed.Type) bool {
 func ParseType(zctx *zed.Context, zson string) (zed.Type, error) {
 	zp := NewParser(strings.NewReader(zson))
 	ast, err := zp.parseType()
-	if ast == nil || noEOF(err) != nil {
+	if err == io.EOF {
+		err = zp.error("unexpected end of type")
+	}
+	if err != nil {
 		return nil, err
 	}
 	return NewAnalyzer().convertType(zctx, ast)